@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankAppModule is the bank module serving its messages with the keeper of the app, which updates the clp liquidity
+// providers of the tokens sent. The sdk bank module only accepts the base keeper when registering its services.
+type bankAppModule struct {
+	bank.AppModule
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+func newBankAppModule(cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper, accountKeeper banktypes.AccountKeeper) bankAppModule {
+	return bankAppModule{
+		AppModule:  bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers the bank services, as the sdk bank module does
+func (am bankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+}
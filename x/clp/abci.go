@@ -23,6 +23,13 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
 			panic(err)
 		}
 	}
+	keeper.ClearBatchSwaps(ctx, keeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
+	err := keeper.ExpireLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+	keeper.SettleWoundDownPools(ctx)
+	keeper.RecordPoolSnapshots(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -30,6 +37,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	// get current block height
 	currentHeight := ctx.BlockHeight()
+	// Copy the policy queued for this block to the PMTP params
+	k.ActivatePmtpPolicy(ctx)
 	// get PMTP period params
 	pmtpPeriodStartBlock := k.GetPmtpParams(ctx).PmtpPeriodStartBlock
 	pmtpPeriodEndBlock := k.GetPmtpParams(ctx).PmtpPeriodEndBlock
@@ -79,6 +88,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		})
 		// Set inter policy rate to running rate
 		k.SetPmtpInterPolicyRate(ctx, pmtpCurrentRunningRate)
+		k.CompleteActivePmtpPolicy(ctx, pmtpCurrentRunningRate)
 		_ = ctx.EventManager().EmitTypedEvent(&types.EventPolicy{
 			EventType:            "policy_end",
 			PmtpPeriodStartBlock: strconv.Itoa(int(pmtpPeriodStartBlock)),
@@ -91,4 +101,8 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+	// Accumulate the swap prices computed above for time weighted average prices
+	k.UpdateTwapRecords(ctx)
+	// Execute limit orders against the swap prices computed above
+	k.ExecuteLimitOrders(ctx, pmtpCurrentRunningRate)
 }
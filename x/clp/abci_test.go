@@ -22,11 +22,14 @@ func TestEndBlocker(t *testing.T) {
 
 	_ = clp.EndBlocker(ctx, app.ClpKeeper)
 
-	pooldash, err := app.ClpKeeper.GetPool(ctx, "cdash")
-	assert.NoError(t, err)
-	poolceth, err := app.ClpKeeper.GetPool(ctx, "ceth")
-	assert.NoError(t, err)
-	assert.True(t, poolceth.NativeAssetBalance.GT(pooldash.NativeAssetBalance))
+	// Rewards are accumulated for the liquidity providers instead of being added to the pool depth
+	rewardsdash, found := app.ClpKeeper.GetPoolRewardAccumulator(ctx, "cdash", "1")
+	assert.True(t, found)
+	rewardsceth, found := app.ClpKeeper.GetPoolRewardAccumulator(ctx, "ceth", "1")
+	assert.True(t, found)
+	assert.Equal(t, "rowan", rewardsceth.Denom)
+	assert.True(t, rewardsceth.Distributed.GT(rewardsdash.Distributed))
+	assert.True(t, rewardsceth.RewardPerUnit.IsPositive())
 
 }
 
@@ -184,21 +187,15 @@ func TestBeginBlocker(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -210,13 +207,14 @@ func TestBeginBlocker(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -227,6 +225,10 @@ func TestBeginBlocker(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -316,11 +318,11 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 						PoolUnits:                     sdk.NewUint(1000),
 						RewardPeriodNativeDistributed: sdk.ZeroUint(),
 					},
-					SwapPriceNative:   sdk.MustNewDecFromStr("1.097803295605500089"),
-					SwapPriceExternal: sdk.MustNewDecFromStr("0.907275450913636290"),
+					SwapPriceNative:   sdk.MustNewDecFromStr("1.097803295605500000"),
+					SwapPriceExternal: sdk.MustNewDecFromStr("0.907275450913636364"),
 					pmtpRateParams: types.PmtpRateParams{
-						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000089"),
-						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.100000000000000089"),
+						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000000"),
+						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.100000000000000000"),
 						PmtpInterPolicyRate:    sdk.MustNewDecFromStr("0.000000000000000000"),
 					},
 				},
@@ -333,11 +335,11 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 						PoolUnits:                     sdk.NewUint(1000),
 						RewardPeriodNativeDistributed: sdk.ZeroUint(),
 					},
-					SwapPriceNative:   sdk.MustNewDecFromStr("1.207583625166050196"),
-					SwapPriceExternal: sdk.MustNewDecFromStr("0.824795864466942015"),
+					SwapPriceNative:   sdk.MustNewDecFromStr("1.207583625166050000"),
+					SwapPriceExternal: sdk.MustNewDecFromStr("0.824795864466942149"),
 					pmtpRateParams: types.PmtpRateParams{
-						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000089"),
-						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.210000000000000196"),
+						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000000"),
+						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.210000000000000000"),
 						PmtpInterPolicyRate:    sdk.MustNewDecFromStr("0.000000000000000000"),
 					},
 				},
@@ -350,11 +352,11 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 						PoolUnits:                     sdk.NewUint(1000),
 						RewardPeriodNativeDistributed: sdk.ZeroUint(),
 					},
-					SwapPriceNative:   sdk.MustNewDecFromStr("1.328341987682655322"),
-					SwapPriceExternal: sdk.MustNewDecFromStr("0.749814422242674499"),
+					SwapPriceNative:   sdk.MustNewDecFromStr("1.328341987682655000"),
+					SwapPriceExternal: sdk.MustNewDecFromStr("0.749814422242674681"),
 					pmtpRateParams: types.PmtpRateParams{
-						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000089"),
-						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.331000000000000323"),
+						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000000"),
+						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.331000000000000000"),
 						PmtpInterPolicyRate:    sdk.MustNewDecFromStr("0.000000000000000000"),
 					},
 				},
@@ -367,11 +369,11 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 						PoolUnits:                     sdk.NewUint(1000),
 						RewardPeriodNativeDistributed: sdk.ZeroUint(),
 					},
-					SwapPriceNative:   sdk.MustNewDecFromStr("1.461176186450920973"),
-					SwapPriceExternal: sdk.MustNewDecFromStr("0.681649474766067671"),
+					SwapPriceNative:   sdk.MustNewDecFromStr("1.461176186450920500"),
+					SwapPriceExternal: sdk.MustNewDecFromStr("0.681649474766067892"),
 					pmtpRateParams: types.PmtpRateParams{
-						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000089"),
-						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.464100000000000474"),
+						PmtpPeriodBlockRate:    sdk.MustNewDecFromStr("0.100000000000000000"),
+						PmtpCurrentRunningRate: sdk.MustNewDecFromStr("0.464100000000000000"),
 						PmtpInterPolicyRate:    sdk.MustNewDecFromStr("0.000000000000000000"),
 					},
 				},
@@ -395,21 +397,15 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -421,13 +417,14 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -440,6 +437,10 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -468,3 +469,52 @@ func TestBeginBlocker_Incremental(t *testing.T) {
 		})
 	}
 }
+
+func TestBeginBlocker_PmtpPolicyQueue(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	ctx = ctx.WithBlockHeight(1)
+	app.ClpKeeper.SetPmtpParams(ctx, types.GetDefaultPmtpParams())
+	app.ClpKeeper.SetPmtpRateParams(ctx, types.PmtpRateParams{
+		PmtpPeriodBlockRate:    sdk.ZeroDec(),
+		PmtpCurrentRunningRate: sdk.ZeroDec(),
+		PmtpInterPolicyRate:    sdk.ZeroDec(),
+	})
+	rate := sdk.MustNewDecFromStr("0.1")
+	first, err := app.ClpKeeper.SchedulePmtpPolicy(ctx, types.PmtpParams{
+		PmtpPeriodGovernanceRate: rate,
+		PmtpPeriodEpochLength:    1,
+		PmtpPeriodStartBlock:     3,
+		PmtpPeriodEndBlock:       6,
+	})
+	require.NoError(t, err)
+	second, err := app.ClpKeeper.SchedulePmtpPolicy(ctx, types.PmtpParams{
+		PmtpPeriodGovernanceRate: rate,
+		PmtpPeriodEpochLength:    1,
+		PmtpPeriodStartBlock:     7,
+		PmtpPeriodEndBlock:       8,
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		clp.BeginBlocker(ctx, app.ClpKeeper)
+		if height == 4 {
+			policy, err := app.ClpKeeper.GetPmtpPolicy(ctx, first.Id)
+			require.NoError(t, err)
+			require.Equal(t, types.PmtpPolicyStatus_PMTP_POLICY_STATUS_ACTIVE, policy.Status)
+			require.Equal(t, int64(3), app.ClpKeeper.GetPmtpParams(ctx).PmtpPeriodStartBlock)
+		}
+	}
+
+	policy, err := app.ClpKeeper.GetPmtpPolicy(ctx, first.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED, policy.Status)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4641"), policy.FinalRunningRate)
+	// The second policy starts from the running rate the first one ended with
+	policy, err = app.ClpKeeper.GetPmtpPolicy(ctx, second.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED, policy.Status)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6741"), policy.FinalRunningRate)
+	require.Equal(t, int64(8), app.ClpKeeper.GetPmtpParams(ctx).PmtpPeriodEndBlock)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6741"), app.ClpKeeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
+}
@@ -30,6 +30,35 @@ const (
 	FlagNewPolicy                    = "newPolicy"
 	FlagMintParams                   = "mint-params"
 	FlagMinter                       = "minter"
+	FlagSwapRoute                    = "route"
+	FlagLimitPrice                   = "limitPrice"
+	FlagExpiryHeight                 = "expiryHeight"
+	FlagLimitOrderID                 = "orderId"
+	FlagStartHeight                  = "startHeight"
+	FlagStartTime                    = "startTime"
+	FlagRecipient                    = "recipient"
+	FlagSwapFeeRate                  = "swapFeeRate"
+	FlagProtocolFeeRate              = "protocolFeeRate"
+	FlagSwapsPaused                  = "swapsPaused"
+	FlagAddsPaused                   = "addsPaused"
+	FlagRemovesPaused                = "removesPaused"
+	FlagMaxPriceImpact               = "maxPriceImpact"
+	FlagMaxPriceChange               = "maxPriceChange"
+	FlagPmtpPolicyID                 = "policyId"
+	FlagPmtpPolicyStatus             = "status"
+	FlagHeights                      = "heights"
+	FlagRewardPeriodID               = "rewardPeriodId"
+	FlagEscrowAmount                 = "escrowAmount"
+	FlagFromHeight                   = "fromHeight"
+	FlagToHeight                     = "toHeight"
+	FlagMinPoolUnits                 = "minPoolUnits"
+	FlagMinNativeOut                 = "minNativeOut"
+	FlagMinExternalOut               = "minExternalOut"
+	FlagDeadlineHeight               = "deadlineHeight"
+	FlagSwapMode                     = "swapMode"
+	FlagGracePeriod                  = "gracePeriod"
+	FlagFundingModules               = "fundingModules"
+	FlagPayoutSymbol                 = "payoutSymbol"
 )
 
 // common flagsets to add to various functions
@@ -49,6 +78,9 @@ var (
 	FsLiquidityRemovalCancelPeriod = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDefaultMultiplier            = flag.NewFlagSet("", flag.ContinueOnError)
 	FsFlagRewardPeriods            = flag.NewFlagSet("", flag.ContinueOnError)
+	FsFlagRewardPeriod             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRewardPeriodID               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsEscrowAmount                 = flag.NewFlagSet("", flag.ContinueOnError)
 	FsBlockRate                    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsRunningRate                  = flag.NewFlagSet("", flag.ContinueOnError)
 	FsEndCurrentPolicy             = flag.NewFlagSet("", flag.ContinueOnError)
@@ -59,6 +91,21 @@ var (
 	FsFlagNewPolicy                = flag.NewFlagSet("", flag.ContinueOnError)
 	FsFlagMintParams               = flag.NewFlagSet("", flag.ContinueOnError)
 	FsFlagMinter                   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSwapRoute                    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsLimitPrice                   = flag.NewFlagSet("", flag.ContinueOnError)
+	FsExpiryHeight                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsLimitOrderID                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRecipient                    = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSwapFeeRate                  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsProtocolFeeRate              = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPoolPauseState               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCircuitBreakerParams         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPmtpPolicyID                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMinPoolUnits                 = flag.NewFlagSet("", flag.ContinueOnError)
+	FsMinOut                       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsDeadlineHeight               = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSwapMode                     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGracePeriod                  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -86,6 +133,29 @@ func init() {
 	FsLiquidityRemovalCancelPeriod.String(FlagLiquidityRemovalCancelPeriod, "", "Unlock Period")
 	FsDefaultMultiplier.String(FlagDefaultMultiplier, "", "Pool Multiplier")
 	FsFlagRewardPeriods.String(FlagRewardPeriods, "", "Path to Json File containing reward periods")
+	FsFlagRewardPeriod.String(FlagRewardPeriods, "", "Path to Json File containing a reward period")
+	FsRewardPeriodID.String(FlagRewardPeriodID, "", "Id of the reward period")
+	FsEscrowAmount.String(FlagEscrowAmount, "", "Amount of the reward denom sent to the reward escrow")
 	FsFlagMintParams.String(FlagMintParams, "", "Inflation")
 	FsFlagMinter.String(FlagMinter, "", "Inflation Max")
+	FsSwapRoute.String(FlagSwapRoute, "", "Comma separated list of asset symbols to swap through, e.g. ceth,rowan,cusdc")
+	FsLimitPrice.String(FlagLimitPrice, "", "Minimum price of the sent asset in received asset")
+	FsExpiryHeight.Int64(FlagExpiryHeight, 0, "Last block height at which the order can execute, 0 for no expiry")
+	FsLimitOrderID.Uint64(FlagLimitOrderID, 0, "Id of the limit order")
+	FsRecipient.String(FlagRecipient, "", "Address receiving the liquidity position")
+	FsSwapFeeRate.String(FlagSwapFeeRate, "", "Share of the swap output kept by the pool, e.g. 0.003")
+	FsProtocolFeeRate.String(FlagProtocolFeeRate, "", "Share of the swap fees sent to the fee collector, e.g. 0.1")
+	FsPoolPauseState.Bool(FlagSwapsPaused, false, "Pause swaps of the pool")
+	FsPoolPauseState.Bool(FlagAddsPaused, false, "Pause liquidity additions to the pool")
+	FsPoolPauseState.Bool(FlagRemovesPaused, false, "Pause liquidity removals from the pool")
+	FsCircuitBreakerParams.String(FlagMaxPriceImpact, "0", "Largest share of a pool a single swap may trade against, 0 to disable")
+	FsCircuitBreakerParams.String(FlagMaxPriceChange, "0", "Largest relative native price change between blocks before swaps are paused, 0 to disable")
+	FsPmtpPolicyID.Uint64(FlagPmtpPolicyID, 0, "Id of the pmtp policy")
+	FsMinPoolUnits.String(FlagMinPoolUnits, "0", "Min threshold for the liquidity units received")
+	FsMinOut.String(FlagMinNativeOut, "0", "Min threshold for the native amount received")
+	FsMinOut.String(FlagMinExternalOut, "0", "Min threshold for the external amount received")
+	FsDeadlineHeight.Int64(FlagDeadlineHeight, 0, "Last block height at which the transaction can execute, 0 for no deadline")
+	FsSwapMode.String(FlagSwapMode, "sequential", "How swaps of the pool execute: sequential or batch")
+	FsGracePeriod.Int64(FlagGracePeriod, 0, "Blocks liquidity providers can withdraw before the pool is settled, 0 to decommission right away")
+	FsGracePeriod.String(FlagPayoutSymbol, "", "Single asset liquidity providers are paid in at settlement, rowan or the pool asset, empty for both")
 }
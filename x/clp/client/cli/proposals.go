@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// newSubmitProposalCmd returns a gov submit-proposal subcommand submitting the content built by
+// newContent from the title and description flags, with the deposit flag as initial deposit
+func newSubmitProposalCmd(use, short string, newContent func(cmd *cobra.Command, title, description string) (govtypes.Content, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			content, err := newContent(cmd, title, description)
+			if err != nil {
+				return err
+			}
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// readJSONFile unmarshals the json file at path into v
+func readJSONFile(path string, v interface{}) error {
+	file, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	input, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(input, v)
+}
+
+func GetCmdSubmitUpdatePmtpParamsProposal() *cobra.Command {
+	cmd := newSubmitProposalCmd("clp-pmtp-params", "Submit a proposal to queue a new pmtp policy",
+		func(cmd *cobra.Command, title, description string) (govtypes.Content, error) {
+			governanceRate, err := cmd.Flags().GetString(FlagPeriodGovernanceRate)
+			if err != nil {
+				return nil, err
+			}
+			epochLength, err := cmd.Flags().GetInt64(FlagPmtpPeriodEpochLength)
+			if err != nil {
+				return nil, err
+			}
+			startBlock, err := cmd.Flags().GetInt64(FlagPmtpPeriodStartBlock)
+			if err != nil {
+				return nil, err
+			}
+			endBlock, err := cmd.Flags().GetInt64(FlagPmtpPeriodEndBlock)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewUpdatePmtpParamsProposal(title, description, governanceRate, epochLength, startBlock, endBlock), nil
+		})
+	cmd.Flags().String(FlagPeriodGovernanceRate, "", "Governance rate of the policy, empty to keep the current rate")
+	cmd.Flags().Int64(FlagPmtpPeriodEpochLength, 0, "Epoch length of the policy")
+	cmd.Flags().Int64(FlagPmtpPeriodStartBlock, 0, "Start block of the policy")
+	cmd.Flags().Int64(FlagPmtpPeriodEndBlock, 0, "End block of the policy")
+	return cmd
+}
+
+func GetCmdSubmitModifyPmtpRatesProposal() *cobra.Command {
+	cmd := newSubmitProposalCmd("clp-pmtp-rates", "Submit a proposal to modify the pmtp block and running rates",
+		func(cmd *cobra.Command, title, description string) (govtypes.Content, error) {
+			blockRate, err := cmd.Flags().GetString(FlagBlockRate)
+			if err != nil {
+				return nil, err
+			}
+			runningRate, err := cmd.Flags().GetString(FlagRunningRate)
+			if err != nil {
+				return nil, err
+			}
+			endPolicy, err := cmd.Flags().GetBool(FlagEndCurrentPolicy)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewModifyPmtpRatesProposal(title, description, blockRate, runningRate, endPolicy), nil
+		})
+	cmd.Flags().String(FlagBlockRate, "", "Block rate, empty to keep the current rate")
+	cmd.Flags().String(FlagRunningRate, "", "Running rate, empty to keep the current rate")
+	cmd.Flags().Bool(FlagEndCurrentPolicy, false, "End the current policy")
+	return cmd
+}
+
+func GetCmdSubmitAddRewardPeriodProposal() *cobra.Command {
+	cmd := newSubmitProposalCmd("clp-reward-periods", "Submit a proposal to replace the reward periods",
+		func(cmd *cobra.Command, title, description string) (govtypes.Content, error) {
+			path, err := cmd.Flags().GetString(FlagRewardPeriods)
+			if err != nil {
+				return nil, err
+			}
+			var rewardPeriods []*types.RewardPeriod
+			if err := readJSONFile(path, &rewardPeriods); err != nil {
+				return nil, err
+			}
+			return types.NewAddRewardPeriodProposal(title, description, rewardPeriods), nil
+		})
+	cmd.Flags().AddFlagSet(FsFlagRewardPeriods)
+	return cmd
+}
+
+func GetCmdSubmitUpdateRewardsParamsProposal() *cobra.Command {
+	cmd := newSubmitProposalCmd("clp-reward-params", "Submit a proposal to update the liquidity removal periods and the reward period funding modules",
+		func(cmd *cobra.Command, title, description string) (govtypes.Content, error) {
+			lockPeriod, err := cmd.Flags().GetUint64(FlagLiquidityRemovalLockPeriod)
+			if err != nil {
+				return nil, err
+			}
+			cancelPeriod, err := cmd.Flags().GetUint64(FlagLiquidityRemovalCancelPeriod)
+			if err != nil {
+				return nil, err
+			}
+			fundingModules, err := cmd.Flags().GetStringSlice(FlagFundingModules)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewUpdateRewardsParamsProposal(title, description, lockPeriod, cancelPeriod, fundingModules), nil
+		})
+	cmd.Flags().Uint64(FlagLiquidityRemovalLockPeriod, 0, "Lock Period")
+	cmd.Flags().Uint64(FlagLiquidityRemovalCancelPeriod, 0, "Unlock Period")
+	cmd.Flags().StringSlice(FlagFundingModules, []string{}, "Module accounts allowed to fund reward periods")
+	return cmd
+}
+
+func GetCmdSubmitUpdateStakingRewardParamsProposal() *cobra.Command {
+	cmd := newSubmitProposalCmd("clp-staking-rewards", "Submit a proposal to update the params of staking rewards",
+		func(cmd *cobra.Command, title, description string) (govtypes.Content, error) {
+			paramsPath, err := cmd.Flags().GetString(FlagMintParams)
+			if err != nil {
+				return nil, err
+			}
+			params := minttypes.Params{}
+			if err := readJSONFile(paramsPath, &params); err != nil {
+				return nil, err
+			}
+			// Minter is an optional flag
+			minterPath, err := cmd.Flags().GetString(FlagMinter)
+			if err != nil {
+				return nil, err
+			}
+			minter := minttypes.Minter{}
+			if minterPath != "" {
+				if err := readJSONFile(minterPath, &minter); err != nil {
+					return nil, err
+				}
+			}
+			return types.NewUpdateStakingRewardParamsProposal(title, description, minter, params), nil
+		})
+	cmd.Flags().String(FlagMintParams, "", "Path to Json File containing the mint params")
+	cmd.Flags().String(FlagMinter, "", "Path to Json File containing the minter, empty to keep the current minter")
+	return cmd
+}
@@ -11,6 +11,7 @@ import (
 
 	//"github.com/Sifchain/sifnode/x/clp"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -33,6 +34,21 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdParams(queryRoute),
 		GetCmdRewardsParams(queryRoute),
 		GetCmdPmtpParams(queryRoute),
+		GetCmdSwapQuote(queryRoute),
+		GetCmdLimitOrdersByOwner(queryRoute),
+		GetCmdLimitOrdersByPool(queryRoute),
+		GetCmdTwap(queryRoute),
+		GetCmdPoolFees(queryRoute),
+		GetCmdCircuitBreakerParams(queryRoute),
+		GetCmdPmtpPolicies(queryRoute),
+		GetCmdSimulatePmtpPolicy(queryRoute),
+		GetCmdLiquidityProviderRewards(queryRoute),
+		GetCmdRewardPeriodDistributions(queryRoute),
+		GetCmdRewardPeriodAllocations(queryRoute),
+		GetCmdPoolHistory(queryRoute),
+		GetCmdPoolStats(queryRoute),
+		GetCmdSimulateAddLiquiditySingleSided(queryRoute),
+		GetCmdPendingPools(queryRoute),
 	)
 	return clpQueryCmd
 }
@@ -324,3 +340,568 @@ func GetCmdPmtpParams(queryRoute string) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdSwapQuote(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [Sent Asset symbol] [Received Asset symbol] [Sent amount]",
+		Short: "Get the expected result of a swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the expected output, liquidity fee and price impact of a swap against the current pool state.
+Example:
+$ %s q clp quote ceth cusdc 1000000000000000000`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.NewQueryReqSwapQuote(args[0], args[1], args[2])
+			result, err := queryClient.SwapQuote(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdLimitOrdersByOwner(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-owner [owner]",
+		Short: "Get open limit orders of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.GetLimitOrdersByOwner(cmd.Context(), &types.LimitOrdersByOwnerReq{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-owner")
+
+	return cmd
+}
+
+func GetCmdLimitOrdersByPool(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-pool [External Asset symbol]",
+		Short: "Get open limit orders of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.GetLimitOrdersByPool(cmd.Context(), &types.LimitOrdersByPoolReq{
+				Symbol:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-pool")
+
+	return cmd
+}
+
+func GetCmdTwap(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [External Asset symbol]",
+		Short: "Get the time weighted average prices of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time weighted average native and external prices of a pool from a start height or start time (unix seconds) until now.
+Example:
+$ %s q clp twap ceth --startHeight 1000
+$ %s q clp twap ceth --startTime 1640995200`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			startTime, err := cmd.Flags().GetInt64(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryReqTwap(args[0], startHeight, startTime)
+			result, err := queryClient.GetTwap(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "Height to average the prices from")
+	cmd.Flags().Int64(FlagStartTime, 0, "Time in unix seconds to average the prices from")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPoolFees(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fees [External Asset symbol]",
+		Short: "Get the swap fee rates and accrued swap fees of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap fee rate of a pool, the protocol share of swap fees and the fees the pool has accrued.
+Example:
+$ %s q clp pool-fees ceth`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryReqPoolFees(args[0])
+			result, err := queryClient.GetPoolFees(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCircuitBreakerParams(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker-params",
+		Short: "Get the circuit breaker limits of the pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.GetCircuitBreakerParams(cmd.Context(), &types.CircuitBreakerParamsReq{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPmtpPolicies(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pmtp-policies",
+		Short: "Get past, active and pending pmtp policies",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			statusFlag, err := cmd.Flags().GetString(FlagPmtpPolicyStatus)
+			if err != nil {
+				return err
+			}
+			policyStatus, err := types.ParsePmtpPolicyStatus(statusFlag)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.GetPmtpPolicies(cmd.Context(), &types.PmtpPoliciesReq{
+				Status:     policyStatus,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().String(FlagPmtpPolicyStatus, "", "Only list policies with this status: pending, active, completed or cancelled")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pmtp-policies")
+
+	return cmd
+}
+
+func GetCmdSimulatePmtpPolicy(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-pmtp-policy",
+		Short: "Project the running rate and pool swap prices of a pmtp policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Project the running rate at the end of each epoch of a hypothetical pmtp policy, and the swap prices of every pool at the given heights assuming the pool balances do not change.
+Example:
+$ %s q clp simulate-pmtp-policy --rGov 0.1 --epochLength 14400 --pmtp_start 1000 --pmtp_end 432999 --heights 15400,432999`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			rGov, err := cmd.Flags().GetString(FlagPeriodGovernanceRate)
+			if err != nil {
+				return err
+			}
+			governanceRate, err := sdk.NewDecFromStr(rGov)
+			if err != nil {
+				return err
+			}
+			epochLength, err := cmd.Flags().GetInt64(FlagPmtpPeriodEpochLength)
+			if err != nil {
+				return err
+			}
+			startBlock, err := cmd.Flags().GetInt64(FlagPmtpPeriodStartBlock)
+			if err != nil {
+				return err
+			}
+			endBlock, err := cmd.Flags().GetInt64(FlagPmtpPeriodEndBlock)
+			if err != nil {
+				return err
+			}
+			heights, err := cmd.Flags().GetInt64Slice(FlagHeights)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryReqSimulatePmtpPolicy(governanceRate, epochLength, startBlock, endBlock, heights)
+			result, err := queryClient.SimulatePmtpPolicy(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().String(FlagPeriodGovernanceRate, "", "Governance rate of the policy")
+	cmd.Flags().Int64(FlagPmtpPeriodEpochLength, 0, "Number of blocks in an epoch")
+	cmd.Flags().Int64(FlagPmtpPeriodStartBlock, 0, "Start block of the policy")
+	cmd.Flags().Int64(FlagPmtpPeriodEndBlock, 0, "End block of the policy")
+	cmd.Flags().Int64Slice(FlagHeights, nil, "Comma separated heights to project the pool swap prices at")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdLiquidityProviderRewards(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-rewards [External Asset symbol] [lpAddress]",
+		Short: "Get the liquidity mining rewards of a liquidity provider",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending rewards of a liquidity provider and the rewards it accrued in each reward period.
+Example:
+$ %s q clp lp-rewards ceth sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetLiquidityProviderRewards(context.Background(), &types.LiquidityProviderRewardsReq{
+				Symbol:    args[0],
+				LpAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRewardPeriodDistributions(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-period-distributions [reward period id]",
+		Short: "Get the liquidity mining rewards distributed to each pool during a reward period",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetRewardPeriodDistributions(context.Background(), &types.RewardPeriodDistributionsReq{
+				RewardPeriodId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRewardPeriodAllocations(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-period-allocations [reward period id]",
+		Short: "Get the allocation, distributed amount and available funds of reward periods",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			var rewardPeriodID string
+			if len(args) > 0 {
+				rewardPeriodID = args[0]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetRewardPeriodAllocations(context.Background(), &types.RewardPeriodAllocationsReq{
+				RewardPeriodId: rewardPeriodID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPoolHistory(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-history [External Asset symbol]",
+		Short: "Get the snapshots of a pool recorded every few blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balances, units, swap prices and pmtp rate of a pool recorded between two heights.
+Example:
+$ %s q clp pool-history ceth --fromHeight 1000 --toHeight 2000`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.GetPoolHistory(cmd.Context(), &types.PoolHistoryReq{
+				Symbol:     args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "First height of the snapshots, 0 for the oldest snapshot")
+	cmd.Flags().Int64(FlagToHeight, 0, "Last height of the snapshots, 0 for the latest snapshot")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pool-history")
+
+	return cmd
+}
+
+func GetCmdPoolStats(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-stats [External Asset symbol]",
+		Short: "Get the volume, fees and reward emissions of a pool over the last day and week",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap volume, liquidity fees and reward emissions of a pool over the last 24 hours and 7 days
+with the yearly fee and reward rates extrapolated from the last 7 days.
+Example:
+$ %s q clp pool-stats ceth`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.GetPoolStats(cmd.Context(), &types.PoolStatsReq{
+				Symbol: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSimulateAddLiquiditySingleSided(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-add-liquidity-single-sided [External Asset symbol] [Sent Asset symbol] [Sent amount]",
+		Short: "Get the expected result of adding liquidity with a single asset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the share of the sent asset swapped into the other pool asset, the liquidity fee of the swap
+and the liquidity units received, next to the units of adding the whole amount without the swap.
+Example:
+$ %s q clp simulate-add-liquidity-single-sided ceth rowan 1000000000000000000`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			amount, err := sdk.ParseUint(args[2])
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.SimulateAddLiquiditySingleSided(cmd.Context(), &types.SimulateAddLiquiditySingleSidedReq{
+				Symbol:     args[0],
+				SentSymbol: args[1],
+				Amount:     amount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdPendingPools(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-pools",
+		Short: "Get the pools waiting for approval",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			result, err := queryClient.GetPendingPools(cmd.Context(), &types.PendingPoolsReq{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(result)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-pools")
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"log"
 
@@ -31,16 +32,35 @@ func GetTxCmd() *cobra.Command {
 	clpTxCmd.AddCommand(
 		GetCmdCreatePool(),
 		GetCmdAddLiquidity(),
+		GetCmdAddLiquiditySingleSided(),
 		GetCmdRemoveLiquidity(),
 		GetCmdRemoveLiquidityUnits(),
+		GetCmdTransferLiquidityPosition(),
+		GetCmdClaimRewards(),
 		GetCmdSwap(),
+		GetCmdSwapRoute(),
+		GetCmdPlaceLimitOrder(),
+		GetCmdCancelLimitOrder(),
 		GetCmdDecommissionPool(),
 		GetCmdUnlockLiquidity(),
 		GetCmdUpdateRewardParams(),
 		GetCmdAddRewardPeriod(),
+		GetCmdAppendRewardPeriod(),
+		GetCmdEditRewardPeriod(),
+		GetCmdDeleteRewardPeriod(),
+		GetCmdFundRewardEscrow(),
+		GetCmdRefundRewardEscrow(),
 		GetCmdModifyPmtpRates(),
 		GetCmdUpdatePmtpParams(),
+		GetCmdCancelPmtpPolicy(),
 		GetCmdUpdateStakingRewards(),
+		GetCmdUpdateSwapFeeRate(),
+		GetCmdUpdateProtocolFeeRate(),
+		GetCmdUpdatePoolPauseState(),
+		GetCmdUpdatePoolSwapMode(),
+		GetCmdUpdateCircuitBreakerParams(),
+		GetCmdApprovePendingPool(),
+		GetCmdCancelPoolWindDown(),
 	)
 
 	return clpTxCmd
@@ -83,6 +103,155 @@ func GetCmdAddRewardPeriod() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// readRewardPeriod reads a reward period from the json file at the path flag
+func readRewardPeriod() (types.RewardPeriod, error) {
+	var rewardPeriod types.RewardPeriod
+	file, err := filepath.Abs(viper.GetString(FlagRewardPeriods))
+	if err != nil {
+		return rewardPeriod, err
+	}
+	input, err := ioutil.ReadFile(file)
+	if err != nil {
+		return rewardPeriod, err
+	}
+	err = json.Unmarshal(input, &rewardPeriod)
+	return rewardPeriod, err
+}
+
+func GetCmdAppendRewardPeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-reward-period",
+		Short: "Add a reward period starting in the future",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			rewardPeriod, err := readRewardPeriod()
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAppendRewardPeriod(clientCtx.GetFromAddress(), rewardPeriod)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsFlagRewardPeriod)
+	if err := cmd.MarkFlagRequired(FlagRewardPeriods); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdEditRewardPeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-reward-period",
+		Short: "Replace a reward period that has not started yet, matched by reward period id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			rewardPeriod, err := readRewardPeriod()
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgEditRewardPeriod(clientCtx.GetFromAddress(), rewardPeriod)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsFlagRewardPeriod)
+	if err := cmd.MarkFlagRequired(FlagRewardPeriods); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdDeleteRewardPeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-reward-period",
+		Short: "Delete a reward period that has not started yet or has ended",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeleteRewardPeriod(clientCtx.GetFromAddress(), viper.GetString(FlagRewardPeriodID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRewardPeriodID)
+	if err := cmd.MarkFlagRequired(FlagRewardPeriodID); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdFundRewardEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-reward-escrow",
+		Short: "Fund the escrow paying the rewards of a reward period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount := sdk.NewUintFromString(viper.GetString(FlagEscrowAmount))
+			msg := types.NewMsgFundRewardEscrow(clientCtx.GetFromAddress(), viper.GetString(FlagRewardPeriodID), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRewardPeriodID)
+	cmd.Flags().AddFlagSet(FsEscrowAmount)
+	if err := cmd.MarkFlagRequired(FlagRewardPeriodID); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagEscrowAmount); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdRefundRewardEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-reward-escrow",
+		Short: "Refund the remaining funds of a reward escrow to its funder",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRefundRewardEscrow(clientCtx.GetFromAddress(), viper.GetString(FlagRewardPeriodID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRewardPeriodID)
+	if err := cmd.MarkFlagRequired(FlagRewardPeriodID); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdUpdateRewardParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reward-params",
@@ -182,7 +351,7 @@ func GetCmdDecommissionPool() *cobra.Command {
 
 			symbol := viper.GetString(FlagAssetSymbol)
 			signer := clientCtx.GetFromAddress()
-			msg := types.NewMsgDecommissionPool(signer, symbol)
+			msg := types.NewMsgDecommissionPool(signer, symbol, viper.GetInt64(FlagGracePeriod), viper.GetString(FlagPayoutSymbol))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -190,6 +359,7 @@ func GetCmdDecommissionPool() *cobra.Command {
 		},
 	}
 	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsGracePeriod)
 	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired failed: ", err.Error())
 	}
@@ -215,6 +385,8 @@ func GetCmdAddLiquidity() *cobra.Command {
 			signer := clientCtx.GetFromAddress()
 
 			msg := types.NewMsgAddLiquidity(signer, externalAsset, sdk.NewUintFromString(nativeAmount), sdk.NewUintFromString(externalAmount))
+			msg.MinPoolUnits = sdk.NewUintFromString(viper.GetString(FlagMinPoolUnits))
+			msg.DeadlineHeight = viper.GetInt64(FlagDeadlineHeight)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -224,6 +396,8 @@ func GetCmdAddLiquidity() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsAssetSymbol)
 	cmd.Flags().AddFlagSet(FsExternalAssetAmount)
 	cmd.Flags().AddFlagSet(FsNativeAssetAmount)
+	cmd.Flags().AddFlagSet(FsMinPoolUnits)
+	cmd.Flags().AddFlagSet(FsDeadlineHeight)
 	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired  failed: ", err.Error())
 	}
@@ -240,6 +414,50 @@ func GetCmdAddLiquidity() *cobra.Command {
 	return cmd
 }
 
+func GetCmdAddLiquiditySingleSided() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-liquidity-single-sided",
+		Short: "Add liquidity to a pool with rowan or the pool asset only",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			sentAsset := types.NewAsset(viper.GetString(FlagSentAssetSymbol))
+			amount := viper.GetString(FlagAmount)
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgAddLiquiditySingleSided(signer, externalAsset, sentAsset, sdk.NewUintFromString(amount))
+			msg.MinPoolUnits = sdk.NewUintFromString(viper.GetString(FlagMinPoolUnits))
+			msg.DeadlineHeight = viper.GetInt64(FlagDeadlineHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsSentAssetSymbol)
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsMinPoolUnits)
+	cmd.Flags().AddFlagSet(FsDeadlineHeight)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagSentAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdRemoveLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove-liquidity",
@@ -264,6 +482,9 @@ func GetCmdRemoveLiquidity() *cobra.Command {
 			}
 
 			msg := types.NewMsgRemoveLiquidity(signer, externalAsset, wBasis, asymmetry)
+			msg.MinNativeOut = sdk.NewUintFromString(viper.GetString(FlagMinNativeOut))
+			msg.MinExternalOut = sdk.NewUintFromString(viper.GetString(FlagMinExternalOut))
+			msg.DeadlineHeight = viper.GetInt64(FlagDeadlineHeight)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -274,6 +495,8 @@ func GetCmdRemoveLiquidity() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsAssetSymbol)
 	cmd.Flags().AddFlagSet(FsWBasisPoints)
 	cmd.Flags().AddFlagSet(FsAsymmetry)
+	cmd.Flags().AddFlagSet(FsMinOut)
+	cmd.Flags().AddFlagSet(FsDeadlineHeight)
 	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired  failed: ", err.Error())
 	}
@@ -306,6 +529,9 @@ func GetCmdRemoveLiquidityUnits() *cobra.Command {
 			withdrawUnits := sdk.NewUintFromString(wU)
 
 			msg := types.NewMsgRemoveLiquidityUnits(signer, externalAsset, withdrawUnits)
+			msg.MinNativeOut = sdk.NewUintFromString(viper.GetString(FlagMinNativeOut))
+			msg.MinExternalOut = sdk.NewUintFromString(viper.GetString(FlagMinExternalOut))
+			msg.DeadlineHeight = viper.GetInt64(FlagDeadlineHeight)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -315,6 +541,8 @@ func GetCmdRemoveLiquidityUnits() *cobra.Command {
 	}
 	cmd.Flags().AddFlagSet(FsAssetSymbol)
 	cmd.Flags().AddFlagSet(FsWithdrawUnits)
+	cmd.Flags().AddFlagSet(FsMinOut)
+	cmd.Flags().AddFlagSet(FsDeadlineHeight)
 	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired  failed: ", err.Error())
 	}
@@ -327,6 +555,82 @@ func GetCmdRemoveLiquidityUnits() *cobra.Command {
 	return cmd
 }
 
+func GetCmdTransferLiquidityPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-liquidity-position",
+		Short: "Transfer liquidity units and pending unlocks of a pool to another address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			units := sdk.NewUintFromString(viper.GetString(FlagUnits))
+			recipient, err := sdk.AccAddressFromBech32(viper.GetString(FlagRecipient))
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgTransferLiquidityPosition(signer, recipient, externalAsset, units)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsUnits)
+	cmd.Flags().AddFlagSet(FsRecipient)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagUnits); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagRecipient); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Claim the pending liquidity mining rewards of a pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgClaimRewards(signer, externalAsset)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdModifyPmtpRates() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pmtp-rates",
@@ -421,7 +725,7 @@ func GetCmdUpdateStakingRewards() *cobra.Command {
 func GetCmdUpdatePmtpParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pmtp-params",
-		Short: "Update pmtp params to set a new policy",
+		Short: "Queue a new pmtp policy",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -459,6 +763,31 @@ func GetCmdUpdatePmtpParams() *cobra.Command {
 	return cmd
 }
 
+func GetCmdCancelPmtpPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-pmtp-policy",
+		Short: "Cancel a queued pmtp policy",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelPmtpPolicy(signer, viper.GetUint64(FlagPmtpPolicyID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPmtpPolicyID)
+	if err := cmd.MarkFlagRequired(FlagPmtpPolicyID); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdSwap() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap",
@@ -478,6 +807,7 @@ func GetCmdSwap() *cobra.Command {
 			signer := clientCtx.GetFromAddress()
 
 			msg := types.NewMsgSwap(signer, sentAsset, receivedAsset, sdk.NewUintFromString(sentAmount), sdk.NewUintFromString(minReceivingAmount))
+			msg.DeadlineHeight = viper.GetInt64(FlagDeadlineHeight)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -490,6 +820,7 @@ func GetCmdSwap() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsReceivedAssetSymbol)
 	cmd.Flags().AddFlagSet(FsAmount)
 	cmd.Flags().AddFlagSet(FsMinReceivingAmount)
+	cmd.Flags().AddFlagSet(FsDeadlineHeight)
 
 	if err := cmd.MarkFlagRequired(FlagSentAssetSymbol); err != nil {
 		log.Println("MarkFlagRequired failed: ", err.Error())
@@ -509,6 +840,55 @@ func GetCmdSwap() *cobra.Command {
 	return cmd
 }
 
+func GetCmdSwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route",
+		Short: "Swap tokens through an ordered route of liquidity pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var route []*types.Asset
+			for _, symbol := range strings.Split(viper.GetString(FlagSwapRoute), ",") {
+				asset := types.NewAsset(strings.TrimSpace(symbol))
+				route = append(route, &asset)
+			}
+
+			sentAmount := viper.GetString(FlagAmount)
+			minReceivingAmount := viper.GetString(FlagMinimumReceivingAmount)
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgSwapRoute(signer, route, sdk.NewUintFromString(sentAmount), sdk.NewUintFromString(minReceivingAmount))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSwapRoute)
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsMinReceivingAmount)
+
+	if err := cmd.MarkFlagRequired(FlagSwapRoute); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagMinimumReceivingAmount); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdUnlockLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unbond-liquidity",
@@ -548,3 +928,293 @@ func GetCmdUnlockLiquidity() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdPlaceLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order",
+		Short: "Place a limit order that swaps once the pool price reaches the limit price",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sentAsset := types.NewAsset(viper.GetString(FlagSentAssetSymbol))
+			receivedAsset := types.NewAsset(viper.GetString(FlagReceivedAssetSymbol))
+			sentAmount := viper.GetString(FlagAmount)
+			limitPrice, err := sdk.NewDecFromStr(viper.GetString(FlagLimitPrice))
+			if err != nil {
+				return err
+			}
+			expiryHeight := viper.GetInt64(FlagExpiryHeight)
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgPlaceLimitOrder(signer, sentAsset, receivedAsset, sdk.NewUintFromString(sentAmount), limitPrice, expiryHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSentAssetSymbol)
+	cmd.Flags().AddFlagSet(FsReceivedAssetSymbol)
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsLimitPrice)
+	cmd.Flags().AddFlagSet(FsExpiryHeight)
+
+	if err := cmd.MarkFlagRequired(FlagSentAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagReceivedAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagLimitPrice); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order",
+		Short: "Cancel an open limit order and refund the escrowed asset",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgCancelLimitOrder(signer, viper.GetUint64(FlagLimitOrderID))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsLimitOrderID)
+
+	if err := cmd.MarkFlagRequired(FlagLimitOrderID); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUpdateSwapFeeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-fee-rate",
+		Short: "Update the swap fee rate of a pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			swapFeeRate, err := sdk.NewDecFromStr(viper.GetString(FlagSwapFeeRate))
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdateSwapFeeRate(signer, externalAsset, swapFeeRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsSwapFeeRate)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagSwapFeeRate); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUpdateProtocolFeeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "protocol-fee-rate",
+		Short: "Update the share of swap fees sent to the fee collector",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			protocolFeeRate, err := sdk.NewDecFromStr(viper.GetString(FlagProtocolFeeRate))
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdateProtocolFeeRate(signer, protocolFeeRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsProtocolFeeRate)
+	if err := cmd.MarkFlagRequired(FlagProtocolFeeRate); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUpdatePoolPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-pause-state",
+		Short: "Pause or resume swaps, liquidity additions and liquidity removals of a pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdatePoolPauseState(signer, externalAsset,
+				viper.GetBool(FlagSwapsPaused), viper.GetBool(FlagAddsPaused), viper.GetBool(FlagRemovesPaused))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsPoolPauseState)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUpdatePoolSwapMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-swap-mode",
+		Short: "Execute the swaps of a pool one by one or clear them together at the end of every block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapMode, err := types.ParseSwapMode(viper.GetString(FlagSwapMode))
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdatePoolSwapMode(signer, externalAsset, swapMode)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsSwapMode)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdUpdateCircuitBreakerParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker-params",
+		Short: "Update the circuit breaker limits of the pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxPriceImpact, err := sdk.NewDecFromStr(viper.GetString(FlagMaxPriceImpact))
+			if err != nil {
+				return err
+			}
+			maxPriceChange, err := sdk.NewDecFromStr(viper.GetString(FlagMaxPriceChange))
+			if err != nil {
+				return err
+			}
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgUpdateCircuitBreakerParams(signer, maxPriceImpact, maxPriceChange)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsCircuitBreakerParams)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdApprovePendingPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-pool",
+		Short: "Approve a pending pool so that it can be swapped against",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgApprovePendingPool(signer, externalAsset)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCancelPoolWindDown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-wind-down",
+		Short: "Cancel the wind down of a pool so that it can be swapped against and added to again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			signer := clientCtx.GetFromAddress()
+			msg := types.NewMsgCancelPoolWindDown(signer, externalAsset)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
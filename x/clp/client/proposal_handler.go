@@ -0,0 +1,17 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/Sifchain/sifnode/x/clp/client/cli"
+	"github.com/Sifchain/sifnode/x/clp/client/rest"
+)
+
+// Proposal handlers of the clp admin actions that can be approved by governance
+var (
+	UpdatePmtpParamsProposalHandler          = govclient.NewProposalHandler(cli.GetCmdSubmitUpdatePmtpParamsProposal, rest.UpdatePmtpParamsProposalRESTHandler)
+	ModifyPmtpRatesProposalHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitModifyPmtpRatesProposal, rest.ModifyPmtpRatesProposalRESTHandler)
+	AddRewardPeriodProposalHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitAddRewardPeriodProposal, rest.AddRewardPeriodProposalRESTHandler)
+	UpdateRewardsParamsProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateRewardsParamsProposal, rest.UpdateRewardsParamsProposalRESTHandler)
+	UpdateStakingRewardParamsProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateStakingRewardParamsProposal, rest.UpdateStakingRewardParamsProposalRESTHandler)
+)
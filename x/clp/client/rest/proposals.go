@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+type (
+	UpdatePmtpParamsProposalReq struct {
+		BaseReq                  rest.BaseReq   `json:"base_req"`
+		Title                    string         `json:"title"`
+		Description              string         `json:"description"`
+		Deposit                  sdk.Coins      `json:"deposit"`
+		PmtpPeriodGovernanceRate string         `json:"pmtp_period_governance_rate"`
+		PmtpPeriodEpochLength    int64          `json:"pmtp_period_epoch_length"`
+		PmtpPeriodStartBlock     int64          `json:"pmtp_period_start_block"`
+		PmtpPeriodEndBlock       int64          `json:"pmtp_period_end_block"`
+		Proposer                 sdk.AccAddress `json:"proposer"`
+	}
+
+	ModifyPmtpRatesProposalReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req"`
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Deposit     sdk.Coins      `json:"deposit"`
+		BlockRate   string         `json:"block_rate"`
+		RunningRate string         `json:"running_rate"`
+		EndPolicy   bool           `json:"end_policy"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+	}
+
+	AddRewardPeriodProposalReq struct {
+		BaseReq       rest.BaseReq          `json:"base_req"`
+		Title         string                `json:"title"`
+		Description   string                `json:"description"`
+		Deposit       sdk.Coins             `json:"deposit"`
+		RewardPeriods []*types.RewardPeriod `json:"reward_periods"`
+		Proposer      sdk.AccAddress        `json:"proposer"`
+	}
+
+	UpdateRewardsParamsProposalReq struct {
+		BaseReq                      rest.BaseReq   `json:"base_req"`
+		Title                        string         `json:"title"`
+		Description                  string         `json:"description"`
+		Deposit                      sdk.Coins      `json:"deposit"`
+		LiquidityRemovalLockPeriod   uint64         `json:"liquidity_removal_lock_period"`
+		LiquidityRemovalCancelPeriod uint64         `json:"liquidity_removal_cancel_period"`
+		RewardPeriodFundingModules   []string       `json:"reward_period_funding_modules"`
+		Proposer                     sdk.AccAddress `json:"proposer"`
+	}
+
+	UpdateStakingRewardParamsProposalReq struct {
+		BaseReq     rest.BaseReq     `json:"base_req"`
+		Title       string           `json:"title"`
+		Description string           `json:"description"`
+		Deposit     sdk.Coins        `json:"deposit"`
+		Minter      minttypes.Minter `json:"minter"`
+		Params      minttypes.Params `json:"params"`
+		Proposer    sdk.AccAddress   `json:"proposer"`
+	}
+)
+
+func UpdatePmtpParamsProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clp_pmtp_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdatePmtpParamsProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewUpdatePmtpParamsProposal(req.Title, req.Description, req.PmtpPeriodGovernanceRate,
+				req.PmtpPeriodEpochLength, req.PmtpPeriodStartBlock, req.PmtpPeriodEndBlock)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func ModifyPmtpRatesProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clp_pmtp_rates",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req ModifyPmtpRatesProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewModifyPmtpRatesProposal(req.Title, req.Description, req.BlockRate, req.RunningRate, req.EndPolicy)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func AddRewardPeriodProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clp_reward_periods",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddRewardPeriodProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewAddRewardPeriodProposal(req.Title, req.Description, req.RewardPeriods)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func UpdateRewardsParamsProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clp_reward_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateRewardsParamsProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewUpdateRewardsParamsProposal(req.Title, req.Description, req.LiquidityRemovalLockPeriod, req.LiquidityRemovalCancelPeriod, req.RewardPeriodFundingModules)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+func UpdateStakingRewardParamsProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "clp_staking_rewards",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req UpdateStakingRewardParamsProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewUpdateStakingRewardParamsProposal(req.Title, req.Description, req.Minter, req.Params)
+			writeProposalTx(w, cliCtx, req.BaseReq, content, req.Deposit, req.Proposer)
+		},
+	}
+}
+
+// writeProposalTx writes the unsigned tx submitting the proposal content with the initial deposit
+func writeProposalTx(w http.ResponseWriter, cliCtx client.Context, baseReq rest.BaseReq, content govtypes.Content, deposit sdk.Coins, proposer sdk.AccAddress) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+	tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+}
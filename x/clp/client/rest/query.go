@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -48,6 +49,66 @@ func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 		"/clp/params",
 		getParamsHandler(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getSwapQuote",
+		getSwapQuoteHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getLimitOrdersByOwner",
+		getLimitOrdersByOwnerHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getLimitOrdersByPool",
+		getLimitOrdersByPoolHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getTwap",
+		getTwapHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPoolFees",
+		getPoolFeesHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getCircuitBreakerParams",
+		getCircuitBreakerParamsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPmtpPolicies",
+		getPmtpPoliciesHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/simulatePmtpPolicy",
+		simulatePmtpPolicyHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getLiquidityProviderRewards",
+		getLiquidityProviderRewardsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getRewardPeriodDistributions",
+		getRewardPeriodDistributionsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getRewardPeriodAllocations",
+		getRewardPeriodAllocationsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPoolHistory",
+		getPoolHistoryHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPoolStats",
+		getPoolStatsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/simulateAddLiquiditySingleSided",
+		simulateAddLiquiditySingleSidedHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/clp/getPendingPools",
+		getPendingPoolsHandler(cliCtx),
+	).Methods("GET")
 }
 
 func getPoolHandler(cliCtx client.Context) http.HandlerFunc {
@@ -334,3 +395,567 @@ func getParamsHandler(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+//http://localhost:1317/clp/getSwapQuote?sentAsset=ceth&receivedAsset=cusdc&sentAmount=1000000
+func getSwapQuoteHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySwapQuote)
+		params := types.NewQueryReqSwapQuote(
+			r.URL.Query().Get("sentAsset"),
+			r.URL.Query().Get("receivedAsset"),
+			r.URL.Query().Get("sentAmount"),
+		)
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getLimitOrdersByOwner?owner=sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd
+func getLimitOrdersByOwnerHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLimitOrdersByOwner)
+		pagination, ok := parsePageRequest(w, r)
+		if !ok {
+			return
+		}
+		params := types.LimitOrdersByOwnerReq{
+			Owner:      r.URL.Query().Get("owner"),
+			Pagination: pagination,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getLimitOrdersByPool?symbol=ceth
+func getLimitOrdersByPoolHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLimitOrdersByPool)
+		pagination, ok := parsePageRequest(w, r)
+		if !ok {
+			return
+		}
+		params := types.LimitOrdersByPoolReq{
+			Symbol:     r.URL.Query().Get("symbol"),
+			Pagination: pagination,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getTwap?symbol=ceth&startHeight=100
+//http://localhost:1317/clp/getTwap?symbol=ceth&startTime=1640995200
+func getTwapHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTwap)
+		var err error
+		var startHeight, startTime int64
+		if r.URL.Query().Get("startHeight") != "" {
+			startHeight, err = strconv.ParseInt(r.URL.Query().Get("startHeight"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if r.URL.Query().Get("startTime") != "" {
+			startTime, err = strconv.ParseInt(r.URL.Query().Get("startTime"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		params := types.NewQueryReqTwap(r.URL.Query().Get("symbol"), startHeight, startTime)
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getPoolFees?symbol=ceth
+func getPoolFeesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPoolFees)
+		params := types.NewQueryReqPoolFees(r.URL.Query().Get("symbol"))
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getCircuitBreakerParamsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCircuitBreakerParams)
+
+		res, height, err := cliCtx.Query(route)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// parsePageRequest reads the optional limit and offset query parameters
+func parsePageRequest(w http.ResponseWriter, r *http.Request) (*query.PageRequest, bool) {
+	var err error
+	var limit, offset uint64
+
+	if r.URL.Query().Get("limit") != "" {
+		limit, err = strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return nil, false
+		}
+	}
+
+	if r.URL.Query().Get("offset") != "" {
+		offset, err = strconv.ParseUint(r.URL.Query().Get("offset"), 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return nil, false
+		}
+	}
+
+	return &query.PageRequest{
+		Limit:  limit,
+		Offset: offset,
+	}, true
+}
+
+//http://localhost:1317/clp/getPmtpPolicies?status=pending
+func getPmtpPoliciesHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPmtpPolicies)
+		policyStatus, err := types.ParsePmtpPolicyStatus(r.URL.Query().Get("status"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		pagination, ok := parsePageRequest(w, r)
+		if !ok {
+			return
+		}
+		params := types.PmtpPoliciesReq{
+			Status:     policyStatus,
+			Pagination: pagination,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/simulatePmtpPolicy?governanceRate=0.1&epochLength=1&startBlock=100&endBlock=139&heights=100,139
+func simulatePmtpPolicyHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulatePmtpPolicy)
+		governanceRate, err := sdk.NewDecFromStr(r.URL.Query().Get("governanceRate"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var blocks [3]int64
+		for i, key := range []string{"epochLength", "startBlock", "endBlock"} {
+			blocks[i], err = strconv.ParseInt(r.URL.Query().Get(key), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		var heights []int64
+		if r.URL.Query().Get("heights") != "" {
+			for _, h := range strings.Split(r.URL.Query().Get("heights"), ",") {
+				height, err := strconv.ParseInt(strings.TrimSpace(h), 10, 64)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+					return
+				}
+				heights = append(heights, height)
+			}
+		}
+		params := types.NewQueryReqSimulatePmtpPolicy(governanceRate, blocks[0], blocks[1], blocks[2], heights)
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getLiquidityProviderRewards?symbol=ceth&lpAddress=sif1h2zjknvr3xlpk22q4dnv396ahftzqhyeth7egd
+func getLiquidityProviderRewardsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLPRewards)
+		var params types.LiquidityProviderRewardsReq
+		params.Symbol = r.URL.Query().Get("symbol")
+		lpAddress, err := sdk.AccAddressFromBech32(r.URL.Query().Get("lpAddress"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params.LpAddress = lpAddress.String()
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getRewardPeriodDistributions?rewardPeriodId=RP_1
+func getRewardPeriodDistributionsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRewardDistributions)
+		var params types.RewardPeriodDistributionsReq
+		params.RewardPeriodId = r.URL.Query().Get("rewardPeriodId")
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getRewardPeriodAllocations?rewardPeriodId=RP_1
+func getRewardPeriodAllocationsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRewardAllocations)
+		var params types.RewardPeriodAllocationsReq
+		params.RewardPeriodId = r.URL.Query().Get("rewardPeriodId")
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getPoolHistory?symbol=ceth&fromHeight=1000&toHeight=2000
+func getPoolHistoryHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPoolHistory)
+		var err error
+		var fromHeight, toHeight int64
+		if r.URL.Query().Get("fromHeight") != "" {
+			fromHeight, err = strconv.ParseInt(r.URL.Query().Get("fromHeight"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if r.URL.Query().Get("toHeight") != "" {
+			toHeight, err = strconv.ParseInt(r.URL.Query().Get("toHeight"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		pagination, ok := parsePageRequest(w, r)
+		if !ok {
+			return
+		}
+		params := types.PoolHistoryReq{
+			Symbol:     r.URL.Query().Get("symbol"),
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+			Pagination: pagination,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getPoolStats?symbol=ceth
+func getPoolStatsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPoolStats)
+		params := types.PoolStatsReq{
+			Symbol: r.URL.Query().Get("symbol"),
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/simulateAddLiquiditySingleSided?symbol=ceth&sentSymbol=rowan&amount=1000000
+func simulateAddLiquiditySingleSidedHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySimulateSingleSided)
+		amount, err := sdk.ParseUint(r.URL.Query().Get("amount"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params := types.SimulateAddLiquiditySingleSidedReq{
+			Symbol:     r.URL.Query().Get("symbol"),
+			SentSymbol: r.URL.Query().Get("sentSymbol"),
+			Amount:     amount,
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+//http://localhost:1317/clp/getPendingPools?limit=10&offset=0
+func getPendingPoolsHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingPools)
+
+		var err error
+		var limit, offset uint64
+
+		if r.URL.Query().Get("limit") != "" {
+			limit, err = strconv.ParseUint(r.URL.Query().Get("limit"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		if r.URL.Query().Get("offset") != "" {
+			offset, err = strconv.ParseUint(r.URL.Query().Get("offset"), 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.PendingPoolsReq{
+			Pagination: &query.PageRequest{
+				Limit:  limit,
+				Offset: offset,
+			},
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
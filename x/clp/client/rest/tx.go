@@ -46,22 +46,30 @@ type (
 		ExternalAsset       types.Asset  `json:"external_asset"`        // ExternalAsset in the pool pair (ex rwn:ceth)
 		NativeAssetAmount   sdk.Uint     `json:"native_asset_amount"`   // NativeAssetAmount is the amount of native asset being added
 		ExternalAssetAmount sdk.Uint     `json:"external_asset_amount"` // ExternalAssetAmount is the amount of external asset being added
+		MinPoolUnits        sdk.Uint     `json:"min_pool_units"`        // MinPoolUnits is the least liquidity units the user accepts, unset for no minimum
+		DeadlineHeight      int64        `json:"deadline_height"`       // DeadlineHeight is the last height the liquidity can be added at, 0 for no deadline
 	}
 
 	RemoveLiquidityReq struct {
-		BaseReq       rest.BaseReq `json:"base_req"`
-		Signer        string       `json:"signer"`         // User who is trying to remove liquidity to the pool
-		ExternalAsset types.Asset  `json:"external_asset"` // ExternalAsset in the pool pair (ex rwn:ceth)
-		WBasisPoints  sdk.Int      `json:"w_basis_points"` // WBasisPoints determines the amount of asset being withdrawn
-		Asymmetry     sdk.Int      `json:"asymmetry"`      // Asymmetry decides the type of asset being withdrawn asymmetry means equal amounts of native and external
+		BaseReq        rest.BaseReq `json:"base_req"`
+		Signer         string       `json:"signer"`           // User who is trying to remove liquidity to the pool
+		ExternalAsset  types.Asset  `json:"external_asset"`   // ExternalAsset in the pool pair (ex rwn:ceth)
+		WBasisPoints   sdk.Int      `json:"w_basis_points"`   // WBasisPoints determines the amount of asset being withdrawn
+		Asymmetry      sdk.Int      `json:"asymmetry"`        // Asymmetry decides the type of asset being withdrawn asymmetry means equal amounts of native and external
+		MinNativeOut   sdk.Uint     `json:"min_native_out"`   // MinNativeOut is the least native amount the user accepts, unset for no minimum
+		MinExternalOut sdk.Uint     `json:"min_external_out"` // MinExternalOut is the least external amount the user accepts, unset for no minimum
+		DeadlineHeight int64        `json:"deadline_height"`  // DeadlineHeight is the last height the liquidity can be removed at, 0 for no deadline
 
 	}
 
 	RemoveLiquidityUnitsReq struct {
-		BaseReq       rest.BaseReq `json:"base_req"`
-		Signer        string       `json:"signer"`         // User who is trying to remove liquidity to the pool
-		ExternalAsset types.Asset  `json:"external_asset"` // ExternalAsset in the pool pair (ex rwn:ceth)
-		WithdrawUnits sdk.Uint     `json:"withdraw_units"` // WithdrawUnits determines the amount of asset being withdrawn
+		BaseReq        rest.BaseReq `json:"base_req"`
+		Signer         string       `json:"signer"`           // User who is trying to remove liquidity to the pool
+		ExternalAsset  types.Asset  `json:"external_asset"`   // ExternalAsset in the pool pair (ex rwn:ceth)
+		WithdrawUnits  sdk.Uint     `json:"withdraw_units"`   // WithdrawUnits determines the amount of asset being withdrawn
+		MinNativeOut   sdk.Uint     `json:"min_native_out"`   // MinNativeOut is the least native amount the user accepts, unset for no minimum
+		MinExternalOut sdk.Uint     `json:"min_external_out"` // MinExternalOut is the least external amount the user accepts, unset for no minimum
+		DeadlineHeight int64        `json:"deadline_height"`  // DeadlineHeight is the last height the liquidity can be removed at, 0 for no deadline
 
 	}
 	CreatePoolReq struct {
@@ -72,9 +80,11 @@ type (
 		ExternalAssetAmount sdk.Uint     `json:"external_asset_amount"` // ExternalAssetAmount is the amount of external asset being added
 	}
 	DecommissionPoolReq struct {
-		BaseReq rest.BaseReq `json:"base_req"`
-		Signer  string       `json:"signer"` // User who is trying to Decommission the pool
-		Ticker  string       `json:"ticker"` // ExternalAsset Ticker in the pool pair (ex rwn:ceth ,would be ceth)
+		BaseReq      rest.BaseReq `json:"base_req"`
+		Signer       string       `json:"signer"`        // User who is trying to Decommission the pool
+		Ticker       string       `json:"ticker"`        // ExternalAsset Ticker in the pool pair (ex rwn:ceth ,would be ceth)
+		GracePeriod  int64        `json:"grace_period"`  // Blocks LPs can withdraw before the pool is settled, 0 to decommission right away
+		PayoutSymbol string       `json:"payout_symbol"` // Single asset LPs are paid in at settlement, rowan or the ticker, empty for both
 	}
 	SwapReq struct {
 		BaseReq            rest.BaseReq `json:"base_req"`
@@ -83,6 +93,7 @@ type (
 		ReceivedAsset      types.Asset  `json:"received_asset"`       // Asset which the user wants to receive ,can be an external asset or RWN
 		SentAmount         sdk.Uint     `json:"sent_amount"`          // Amount of SentAsset being sent
 		MinReceivingAmount sdk.Uint     `json:"min_receiving_amount"` // Min amount specified by the user m the swap will not go through if the receiving amount drops below this value
+		DeadlineHeight     int64        `json:"deadline_height"`      // DeadlineHeight is the last height the swap can execute at, 0 for no deadline
 	}
 )
 
@@ -139,7 +150,7 @@ func decommissionPoolHandler(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgDecommissionPool(signer, req.Ticker)
+		msg := types.NewMsgDecommissionPool(signer, req.Ticker, req.GracePeriod, req.PayoutSymbol)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -170,6 +181,8 @@ func addLiquidityHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgAddLiquidity(signer, req.ExternalAsset, req.NativeAssetAmount, req.ExternalAssetAmount)
+		msg.MinPoolUnits = req.MinPoolUnits
+		msg.DeadlineHeight = req.DeadlineHeight
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -200,6 +213,9 @@ func removeLiquidityHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgRemoveLiquidity(signer, req.ExternalAsset, req.WBasisPoints, req.Asymmetry)
+		msg.MinNativeOut = req.MinNativeOut
+		msg.MinExternalOut = req.MinExternalOut
+		msg.DeadlineHeight = req.DeadlineHeight
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -230,6 +246,9 @@ func removeLiquidityUnitsHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgRemoveLiquidityUnits(signer, req.ExternalAsset, req.WithdrawUnits)
+		msg.MinNativeOut = req.MinNativeOut
+		msg.MinExternalOut = req.MinExternalOut
+		msg.DeadlineHeight = req.DeadlineHeight
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -260,6 +279,7 @@ func swapHandler(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgSwap(signer, req.SentAsset, req.ReceivedAsset, req.SentAmount, req.MinReceivingAmount)
+		msg.DeadlineHeight = req.DeadlineHeight
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
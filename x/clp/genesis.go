@@ -29,6 +29,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) (res
 	k.SetPmtpParams(ctx, types.GetDefaultPmtpParams())
 
 	k.SetPmtpInterPolicyRate(ctx, sdk.NewDec(0))
+	if data.SwapFeeParams != nil {
+		k.SetSwapFeeParams(ctx, data.SwapFeeParams)
+	} else {
+		k.SetSwapFeeParams(ctx, types.GetDefaultSwapFeeParams())
+	}
+	if data.CircuitBreakerParams != nil {
+		k.SetCircuitBreakerParams(ctx, data.CircuitBreakerParams)
+	} else {
+		k.SetCircuitBreakerParams(ctx, types.GetDefaultCircuitBreakerParams())
+	}
 	if data.AddressWhitelist == nil || len(data.AddressWhitelist) == 0 {
 		panic("AddressWhiteList must be set.")
 	}
@@ -53,6 +63,75 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) (res
 	for _, lp := range data.LiquidityProviders {
 		k.SetLiquidityProvider(ctx, lp)
 	}
+	nextLimitOrderID := uint64(1)
+	for _, order := range data.LimitOrders {
+		k.SetLimitOrder(ctx, order)
+		if order.Id >= nextLimitOrderID {
+			nextLimitOrderID = order.Id + 1
+		}
+	}
+	k.SetNextLimitOrderID(ctx, nextLimitOrderID)
+	nextQueuedSwapID := uint64(1)
+	for _, swap := range data.QueuedSwaps {
+		k.SetQueuedSwap(ctx, swap)
+		if swap.Id >= nextQueuedSwapID {
+			nextQueuedSwapID = swap.Id + 1
+		}
+	}
+	k.SetNextQueuedSwapID(ctx, nextQueuedSwapID)
+	nextPmtpPolicyID := uint64(1)
+	for _, policy := range data.PmtpPolicies {
+		k.SetPmtpPolicy(ctx, policy)
+		if policy.Status == types.PmtpPolicyStatus_PMTP_POLICY_STATUS_ACTIVE {
+			k.SetActivePmtpPolicyID(ctx, policy.Id)
+		}
+		if policy.Id >= nextPmtpPolicyID {
+			nextPmtpPolicyID = policy.Id + 1
+		}
+	}
+	k.SetNextPmtpPolicyID(ctx, nextPmtpPolicyID)
+	// Rewards are set after the liquidity providers, which settle their rewards when set
+	for i := range data.PoolRewardAccumulators {
+		k.SetPoolRewardAccumulator(ctx, &data.PoolRewardAccumulators[i])
+	}
+	for i := range data.LiquidityProviderRewards {
+		k.SetLiquidityProviderRewards(ctx, &data.LiquidityProviderRewards[i])
+	}
+	for i := range data.RewardEscrows {
+		k.SetRewardEscrow(ctx, &data.RewardEscrows[i])
+	}
+	for _, fees := range data.PoolFeeAccruals {
+		k.SetPoolFeeAccrual(ctx, fees.Symbol, fees.Accrual)
+	}
+	for _, history := range data.PoolSnapshots {
+		if len(history.Snapshots) == 0 {
+			continue
+		}
+		for _, snapshot := range history.Snapshots {
+			k.SetPoolSnapshot(ctx, history.Symbol, snapshot)
+		}
+		k.SetPoolHistoryIndex(ctx, history.Symbol, types.PoolHistoryIndex{
+			FirstHeight: history.Snapshots[0].Height,
+			LastHeight:  history.Snapshots[len(history.Snapshots)-1].Height,
+		})
+	}
+	for _, stats := range data.PoolStats {
+		for _, bucket := range stats.Buckets {
+			k.SetPoolStatsBucket(ctx, stats.Symbol, bucket)
+		}
+	}
+	for _, twap := range data.TwapRecords {
+		if len(twap.Records) == 0 {
+			continue
+		}
+		for _, record := range twap.Records {
+			k.SetTwapRecord(ctx, twap.Symbol, record)
+		}
+		k.SetTwapAccumulator(ctx, twap.Symbol, types.TwapAccumulator{
+			FirstTimestamp: twap.Records[0].Timestamp,
+			LastTimestamp:  twap.Records[len(twap.Records)-1].Timestamp,
+		})
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -70,11 +149,45 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 	for i, entry := range whiteList {
 		wl[i] = entry.String()
 	}
+	var twapRecords []types.GenesisTwapRecords
+	var poolFeeAccruals []types.GenesisPoolFeeAccrual
+	var poolSnapshots []types.GenesisPoolSnapshots
+	var poolStats []types.GenesisPoolStats
+	for _, pool := range poolList {
+		poolFeeAccruals = append(poolFeeAccruals, types.GenesisPoolFeeAccrual{
+			Symbol:  pool.ExternalAsset.Symbol,
+			Accrual: keeper.GetPoolFeeAccrual(ctx, pool.ExternalAsset.Symbol),
+		})
+		records := keeper.GetTwapRecords(ctx, pool.ExternalAsset.Symbol)
+		if len(records) > 0 {
+			twapRecords = append(twapRecords, types.GenesisTwapRecords{Symbol: pool.ExternalAsset.Symbol, Records: records})
+		}
+		snapshots := keeper.GetPoolSnapshots(ctx, pool.ExternalAsset.Symbol)
+		if len(snapshots) > 0 {
+			poolSnapshots = append(poolSnapshots, types.GenesisPoolSnapshots{Symbol: pool.ExternalAsset.Symbol, Snapshots: snapshots})
+		}
+		buckets := keeper.GetPoolStatsBuckets(ctx, pool.ExternalAsset.Symbol)
+		if len(buckets) > 0 {
+			poolStats = append(poolStats, types.GenesisPoolStats{Symbol: pool.ExternalAsset.Symbol, Buckets: buckets})
+		}
+	}
 	return types.GenesisState{
-		Params:             params,
-		AddressWhitelist:   wl,
-		PoolList:           poolList,
-		LiquidityProviders: liquidityProviders,
+		Params:                   params,
+		AddressWhitelist:         wl,
+		PoolList:                 poolList,
+		LiquidityProviders:       liquidityProviders,
+		LimitOrders:              keeper.GetLimitOrders(ctx),
+		PmtpPolicies:             keeper.GetPmtpPolicies(ctx),
+		PoolRewardAccumulators:   keeper.GetPoolRewardAccumulators(ctx, ""),
+		LiquidityProviderRewards: keeper.GetAllLiquidityProviderRewards(ctx),
+		RewardEscrows:            keeper.GetRewardEscrows(ctx),
+		TwapRecords:              twapRecords,
+		SwapFeeParams:            keeper.GetSwapFeeParams(ctx),
+		PoolFeeAccruals:          poolFeeAccruals,
+		CircuitBreakerParams:     keeper.GetCircuitBreakerParams(ctx),
+		PoolSnapshots:            poolSnapshots,
+		PoolStats:                poolStats,
+		QueuedSwaps:              keeper.GetQueuedSwaps(ctx),
 	}
 }
 
@@ -93,5 +206,62 @@ func ValidateGenesis(data types.GenesisState) error {
 			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: liquidityProvider is invalid : %s", lp.String()))
 		}
 	}
+	for _, order := range data.LimitOrders {
+		if !order.Validate() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: limit order is invalid : %s", order.String()))
+		}
+	}
+	for _, swap := range data.QueuedSwaps {
+		if !swap.Validate() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: queued swap is invalid : %s", swap.String()))
+		}
+	}
+	for _, policy := range data.PmtpPolicies {
+		if !policy.Validate() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: pmtp policy is invalid : %s", policy.String()))
+		}
+	}
+	for _, rewards := range data.LiquidityProviderRewards {
+		if !rewards.Validate() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: liquidity provider rewards are invalid : %s", rewards.String()))
+		}
+	}
+	for _, escrow := range data.RewardEscrows {
+		if !escrow.Validate() {
+			return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: reward escrow is invalid : %s", escrow.String()))
+		}
+	}
+	if data.SwapFeeParams != nil && !types.ValidateFeeRate(data.SwapFeeParams.ProtocolFeeRate) {
+		return sdkerrors.Wrap(types.ErrInvalidFeeRate, data.SwapFeeParams.ProtocolFeeRate.String())
+	}
+	if data.CircuitBreakerParams != nil {
+		if data.CircuitBreakerParams.MaxPriceImpact.IsNil() || data.CircuitBreakerParams.MaxPriceImpact.IsNegative() {
+			return sdkerrors.Wrap(types.ErrInvalidCircuitBreakerLimit, "max price impact")
+		}
+		if data.CircuitBreakerParams.MaxPriceChange.IsNil() || data.CircuitBreakerParams.MaxPriceChange.IsNegative() {
+			return sdkerrors.Wrap(types.ErrInvalidCircuitBreakerLimit, "max price change")
+		}
+	}
+	for _, twap := range data.TwapRecords {
+		for i := 1; i < len(twap.Records); i++ {
+			if twap.Records[i].Timestamp <= twap.Records[i-1].Timestamp {
+				return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: twap records of %s are not in ascending time", twap.Symbol))
+			}
+		}
+	}
+	for _, history := range data.PoolSnapshots {
+		for i := 1; i < len(history.Snapshots); i++ {
+			if history.Snapshots[i].Height <= history.Snapshots[i-1].Height {
+				return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: snapshots of %s are not in ascending height", history.Symbol))
+			}
+		}
+	}
+	for _, stats := range data.PoolStats {
+		for _, bucket := range stats.Buckets {
+			if !bucket.RewardEmissions.IsValid() {
+				return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("clp: stats bucket of %s is invalid : %s", stats.Symbol, bucket.String()))
+			}
+		}
+	}
 	return nil
 }
@@ -50,6 +50,84 @@ func TestInitGenesis(t *testing.T) {
 	assert.Equal(t, app1.ClpKeeper.GetParams(ctx1).MinCreatePoolThreshold, app2.ClpKeeper.GetParams(ctx2).MinCreatePoolThreshold)
 }
 
+func TestInitGenesis_PoolState(t *testing.T) {
+	ctx1, app1 := test.CreateTestAppClp(false)
+	ctx2, app2 := test.CreateTestAppClp(false)
+	CreateState(ctx1, app1.ClpKeeper, t)
+	pools := app1.ClpKeeper.GetPools(ctx1)
+	symbol := pools[0].ExternalAsset.Symbol
+	for i := int64(1); i <= 3; i++ {
+		app1.ClpKeeper.SetTwapRecord(ctx1, symbol, types.TwapRecord{
+			Height:                  i,
+			Timestamp:               i * 10,
+			PriceNativeCumulative:   sdk.NewDec(i),
+			PriceExternalCumulative: sdk.NewDec(i * 2),
+		})
+	}
+	app1.ClpKeeper.SetTwapAccumulator(ctx1, symbol, types.TwapAccumulator{FirstTimestamp: 10, LastTimestamp: 30})
+	app1.ClpKeeper.SetSwapFeeParams(ctx1, &types.SwapFeeParams{ProtocolFeeRate: sdk.NewDecWithPrec(1, 1)})
+	accrual := types.NewPoolFeeAccrual()
+	accrual.LpFeesNative = sdk.NewUint(100)
+	accrual.ProtocolFeesExternal = sdk.NewUint(5)
+	app1.ClpKeeper.SetPoolFeeAccrual(ctx1, symbol, accrual)
+	app1.ClpKeeper.SetCircuitBreakerParams(ctx1, &types.CircuitBreakerParams{MaxPriceImpact: sdk.NewDecWithPrec(2, 1), MaxPriceChange: sdk.NewDecWithPrec(5, 2)})
+	for i := int64(1); i <= 2; i++ {
+		app1.ClpKeeper.SetPoolSnapshot(ctx1, symbol, types.PoolSnapshot{
+			Height:                 i * types.PoolSnapshotInterval,
+			NativeAssetBalance:     sdk.NewUint(1000),
+			ExternalAssetBalance:   sdk.NewUint(100),
+			PoolUnits:              sdk.NewUint(1),
+			SwapPriceNative:        sdk.ZeroDec(),
+			SwapPriceExternal:      sdk.ZeroDec(),
+			PmtpCurrentRunningRate: sdk.ZeroDec(),
+		})
+	}
+	app1.ClpKeeper.SetPoolHistoryIndex(ctx1, symbol, types.PoolHistoryIndex{FirstHeight: types.PoolSnapshotInterval, LastHeight: 2 * types.PoolSnapshotInterval})
+	app1.ClpKeeper.SetPoolStatsBucket(ctx1, symbol, types.PoolStatsBucket{
+		Hour:            3600,
+		VolumeNative:    sdk.NewUint(10),
+		VolumeExternal:  sdk.ZeroUint(),
+		FeesNative:      sdk.NewUint(1),
+		FeesExternal:    sdk.ZeroUint(),
+		RewardEmissions: sdk.NewCoins(sdk.NewCoin("rowan", sdk.NewInt(7))),
+		SwapCount:       1,
+	})
+	rowan := types.GetSettlementAsset()
+	app1.ClpKeeper.SetQueuedSwap(ctx1, &types.QueuedSwap{
+		Id:                 4,
+		Signer:             "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v",
+		PoolAsset:          pools[0].ExternalAsset,
+		SentAsset:          &rowan,
+		ReceivedAsset:      pools[0].ExternalAsset,
+		SentAmount:         sdk.NewUint(10),
+		MinReceivingAmount: sdk.ZeroUint(),
+	})
+	pools[0].SwapsPaused = true
+	assert.NoError(t, app1.ClpKeeper.SetPool(ctx1, pools[0]))
+	state := clp.ExportGenesis(ctx1, app1.ClpKeeper)
+	assert.NoError(t, clp.ValidateGenesis(state))
+	assert.Len(t, state.QueuedSwaps, 1)
+
+	clp.InitGenesis(ctx2, app2.ClpKeeper, state)
+	assert.Equal(t, app1.ClpKeeper.GetTwapRecords(ctx1, symbol), app2.ClpKeeper.GetTwapRecords(ctx2, symbol))
+	accumulator, found := app2.ClpKeeper.GetTwapAccumulator(ctx2, symbol)
+	assert.True(t, found)
+	assert.Equal(t, types.TwapAccumulator{FirstTimestamp: 10, LastTimestamp: 30}, accumulator)
+	assert.Equal(t, app1.ClpKeeper.GetSwapFeeParams(ctx1), app2.ClpKeeper.GetSwapFeeParams(ctx2))
+	assert.Equal(t, accrual, app2.ClpKeeper.GetPoolFeeAccrual(ctx2, symbol))
+	assert.Equal(t, app1.ClpKeeper.GetCircuitBreakerParams(ctx1), app2.ClpKeeper.GetCircuitBreakerParams(ctx2))
+	assert.Equal(t, app1.ClpKeeper.GetPoolSnapshots(ctx1, symbol), app2.ClpKeeper.GetPoolSnapshots(ctx2, symbol))
+	index, found := app2.ClpKeeper.GetPoolHistoryIndex(ctx2, symbol)
+	assert.True(t, found)
+	assert.Equal(t, types.PoolHistoryIndex{FirstHeight: types.PoolSnapshotInterval, LastHeight: 2 * types.PoolSnapshotInterval}, index)
+	assert.Equal(t, app1.ClpKeeper.GetPoolStatsBuckets(ctx1, symbol), app2.ClpKeeper.GetPoolStatsBuckets(ctx2, symbol))
+	assert.Equal(t, app1.ClpKeeper.GetQueuedSwaps(ctx1), app2.ClpKeeper.GetQueuedSwaps(ctx2))
+	assert.Equal(t, uint64(5), app2.ClpKeeper.GetNextQueuedSwapID(ctx2))
+	pool, err := app2.ClpKeeper.GetPool(ctx2, symbol)
+	assert.NoError(t, err)
+	assert.True(t, pool.SwapsPaused)
+}
+
 func TestValidateGenesis(t *testing.T) {
 	ctx, app := test.CreateTestAppClp(false)
 	// Generate State
@@ -34,6 +34,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSwap:
 			res, err := msgServer.Swap(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSwapRoute:
+			res, err := msgServer.SwapRoute(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgModifyPmtpRates:
 			res, err := msgServer.ModifyPmtpRates(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -53,6 +56,60 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUpdateStakingRewardParams:
 			res, err := msgServer.UpdateStakingRewardParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceLimitOrder:
+			res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelLimitOrder:
+			res, err := msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferLiquidityPosition:
+			res, err := msgServer.TransferLiquidityPosition(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateSwapFeeRate:
+			res, err := msgServer.UpdateSwapFeeRate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateProtocolFeeRate:
+			res, err := msgServer.UpdateProtocolFeeRate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdatePoolPauseState:
+			res, err := msgServer.UpdatePoolPauseState(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateCircuitBreakerParams:
+			res, err := msgServer.UpdateCircuitBreakerParams(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelPmtpPolicy:
+			res, err := msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimRewards:
+			res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAppendRewardPeriod:
+			res, err := msgServer.AppendRewardPeriod(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEditRewardPeriod:
+			res, err := msgServer.EditRewardPeriod(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteRewardPeriod:
+			res, err := msgServer.DeleteRewardPeriod(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFundRewardEscrow:
+			res, err := msgServer.FundRewardEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRefundRewardEscrow:
+			res, err := msgServer.RefundRewardEscrow(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddLiquiditySingleSided:
+			res, err := msgServer.AddLiquiditySingleSided(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdatePoolSwapMode:
+			res, err := msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgApprovePendingPool:
+			res, err := msgServer.ApprovePendingPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelPoolWindDown:
+			res, err := msgServer.CancelPoolWindDown(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errors.Wrap(errors.ErrUnknownRequest, errMsg)
@@ -355,12 +355,12 @@ func TestDecommisionPool(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	msg := clptypes.NewMsgDecommissionPool(signer, asset.Symbol)
+	msg := clptypes.NewMsgDecommissionPool(signer, asset.Symbol, 0, "")
 	_, err = handler(ctx, &msg)
 	require.Error(t, err)
 	v := test.GenerateWhitelistAddress("")
 	clpKeeper.SetClpWhiteList(ctx, []sdk.AccAddress{v})
-	msg = clptypes.NewMsgDecommissionPool(signer, asset.Symbol)
+	msg = clptypes.NewMsgDecommissionPool(signer, asset.Symbol, 0, "")
 	res, err = handler(ctx, &msg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
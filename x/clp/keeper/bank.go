@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// LiquidityProviderTokenBankKeeper is the bank keeper of every module but clp. Liquidity provider tokens moved by bank
+// sends, ibc transfers or any other module update the liquidity providers on both sides of the transfer, so that the
+// liquidity provider records always match the tokens held. The clp keeper updates its records itself and uses the
+// wrapped keeper.
+type LiquidityProviderTokenBankKeeper struct {
+	bankkeeper.Keeper
+	clpKeeper *Keeper
+}
+
+var _ bankkeeper.Keeper = LiquidityProviderTokenBankKeeper{}
+
+// NewLiquidityProviderTokenBankKeeper wraps bankKeeper, clpKeeper may be set after the bank keeper is created
+func NewLiquidityProviderTokenBankKeeper(bankKeeper bankkeeper.Keeper, clpKeeper *Keeper) LiquidityProviderTokenBankKeeper {
+	return LiquidityProviderTokenBankKeeper{Keeper: bankKeeper, clpKeeper: clpKeeper}
+}
+
+func (k LiquidityProviderTokenBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, fromAddr, toAddr)
+}
+
+func (k LiquidityProviderTokenBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	err := k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	if err != nil {
+		return err
+	}
+	for _, input := range inputs {
+		addr, err := sdk.AccAddressFromBech32(input.Address)
+		if err != nil {
+			return err
+		}
+		err = k.syncLiquidityProviders(ctx, input.Coins, addr)
+		if err != nil {
+			return err
+		}
+	}
+	for _, output := range outputs {
+		addr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		err = k.syncLiquidityProviders(ctx, output.Coins, addr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k LiquidityProviderTokenBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+}
+
+func (k LiquidityProviderTokenBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	err := k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+}
+
+func (k LiquidityProviderTokenBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	err := k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+}
+
+func (k LiquidityProviderTokenBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	err := k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+}
+
+func (k LiquidityProviderTokenBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+}
+
+func (k LiquidityProviderTokenBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, delegatorAddr, moduleAccAddr)
+}
+
+func (k LiquidityProviderTokenBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.syncLiquidityProviders(ctx, amt, moduleAccAddr, delegatorAddr)
+}
+
+// syncLiquidityProviders syncs the liquidity providers addrs of every pool whose tokens are in amt. Transfers to or
+// from the clp module account mint or burn tokens, the clp module updates the liquidity providers of those itself.
+func (k LiquidityProviderTokenBankKeeper) syncLiquidityProviders(ctx sdk.Context, amt sdk.Coins, addrs ...sdk.AccAddress) error {
+	clpAddress := authtypes.NewModuleAddress(types.ModuleName)
+	for _, addr := range addrs {
+		if addr.Equals(clpAddress) {
+			return nil
+		}
+	}
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, types.LiquidityProviderTokenPrefix) {
+			continue
+		}
+		symbol := strings.TrimPrefix(coin.Denom, types.LiquidityProviderTokenPrefix)
+		if !k.clpKeeper.ExistsPool(ctx, symbol) {
+			continue
+		}
+		for _, addr := range addrs {
+			_, err := k.clpKeeper.SyncLiquidityProvider(ctx, symbol, addr.String())
+			if err != nil && !errors.Is(err, types.ErrLiquidityProviderDoesNotExist) {
+				return err
+			}
+		}
+	}
+	return nil
+}
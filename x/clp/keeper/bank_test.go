@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_LiquidityProviderTokenTransfers(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	provider := sdk.AccAddress("genesis_provider____")
+	receiver := sdk.AccAddress("receiver____________")
+	other := sdk.AccAddress("other_______________")
+	lpDenom := types.GetLiquidityProviderTokenDenom("ceth")
+	requireUnits := func(addr sdk.AccAddress, units uint64) {
+		lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", addr.String())
+		if units == 0 {
+			require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewUint(units), lp.LiquidityProviderUnits)
+	}
+
+	// Both sides of a bank send are updated
+	sent := sdk.NewCoins(sdk.NewCoin(lpDenom, sdk.NewInt(500000000000)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, provider, receiver, sent))
+	requireUnits(provider, 500000000000)
+	requireUnits(receiver, 500000000000)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	// So are the inputs and outputs of a multi send, a provider sending all of its tokens has no position left
+	err := app.BankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(receiver, sent)},
+		[]banktypes.Output{
+			banktypes.NewOutput(provider, sdk.NewCoins(sdk.NewCoin(lpDenom, sdk.NewInt(100000000000)))),
+			banktypes.NewOutput(other, sdk.NewCoins(sdk.NewCoin(lpDenom, sdk.NewInt(400000000000)))),
+		})
+	require.NoError(t, err)
+	requireUnits(provider, 600000000000)
+	requireUnits(receiver, 0)
+	requireUnits(other, 400000000000)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
@@ -0,0 +1,414 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// SetQueuedSwap stores a swap queued against a pool in batch swap mode
+func (k Keeper) SetQueuedSwap(ctx sdk.Context, swap *types.QueuedSwap) {
+	if !swap.Validate() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedSwapKey(swap.PoolAsset.Symbol, swap.Id), k.cdc.MustMarshal(swap))
+}
+
+func (k Keeper) DeleteQueuedSwap(ctx sdk.Context, swap types.QueuedSwap) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedSwapKey(swap.PoolAsset.Symbol, swap.Id))
+}
+
+func (k Keeper) GetNextQueuedSwapID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QueuedSwapNextIDPrefix)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextQueuedSwapID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.QueuedSwapNextIDPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// GetQueuedSwaps returns the queued swaps of every pool, grouped by pool in the order they were queued
+func (k Keeper) GetQueuedSwaps(ctx sdk.Context) []*types.QueuedSwap {
+	var swaps []*types.QueuedSwap
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.QueuedSwapPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var swap types.QueuedSwap
+		k.cdc.MustUnmarshal(iterator.Value(), &swap)
+		swaps = append(swaps, &swap)
+	}
+	return swaps
+}
+
+// GetBatchSwapPool returns the pool a swap of sentAsset for receivedAsset has to be queued against, if any.
+// Swaps between two external assets go through two pools and cannot be batched.
+func (k Keeper) GetBatchSwapPool(ctx sdk.Context, sentAsset, receivedAsset types.Asset) (types.Pool, bool, error) {
+	var symbols []string
+	for _, asset := range []types.Asset{sentAsset, receivedAsset} {
+		if !asset.Equals(types.GetSettlementAsset()) {
+			symbols = append(symbols, asset.Symbol)
+		}
+	}
+	for _, symbol := range symbols {
+		pool, err := k.GetPool(ctx, symbol)
+		if err != nil || pool.SwapMode != types.SwapMode_SWAP_MODE_BATCH {
+			continue
+		}
+		if len(symbols) > 1 {
+			return types.Pool{}, false, sdkerrors.Wrap(types.ErrBatchSwapNotSupported, fmt.Sprintf("pool %s", symbol))
+		}
+		return pool, true, nil
+	}
+	return types.Pool{}, false, nil
+}
+
+// QueueSwap takes the sent amount of a swap from the swapper and queues the swap until the end of the block
+func (k Keeper) QueueSwap(ctx sdk.Context, swapper sdk.AccAddress, pool types.Pool, msg *types.MsgSwap) (types.QueuedSwap, error) {
+	if err := checkSwapsEnabled(pool); err != nil {
+		return types.QueuedSwap{}, err
+	}
+	sentAmountInt, ok := k.ParseToInt(msg.SentAmount.String())
+	if !ok {
+		return types.QueuedSwap{}, types.ErrUnableToParseInt
+	}
+	err := k.InitiateSwap(ctx, sdk.NewCoin(msg.SentAsset.Symbol, sentAmountInt), swapper)
+	if err != nil {
+		return types.QueuedSwap{}, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+	id := k.GetNextQueuedSwapID(ctx)
+	swap := types.QueuedSwap{
+		Id:                 id,
+		Signer:             msg.Signer,
+		PoolAsset:          pool.ExternalAsset,
+		SentAsset:          msg.SentAsset,
+		ReceivedAsset:      msg.ReceivedAsset,
+		SentAmount:         msg.SentAmount,
+		MinReceivingAmount: msg.MinReceivingAmount,
+	}
+	k.SetQueuedSwap(ctx, &swap)
+	k.SetNextQueuedSwapID(ctx, id+1)
+	return swap, nil
+}
+
+// ClearBatchSwaps clears the swaps queued against every pool at a single uniform price per pool.
+// When a batch cannot be cleared all of its swaps are refunded, a swap failing to be refunded stays queued
+// and is retried at the next block.
+func (k Keeper) ClearBatchSwaps(ctx sdk.Context, pmtpCurrentRunningRate sdk.Dec) {
+	var symbols []string
+	batches := make(map[string][]*types.QueuedSwap)
+	for _, swap := range k.GetQueuedSwaps(ctx) {
+		k.DeleteQueuedSwap(ctx, *swap)
+		if _, ok := batches[swap.PoolAsset.Symbol]; !ok {
+			symbols = append(symbols, swap.PoolAsset.Symbol)
+		}
+		batches[swap.PoolAsset.Symbol] = append(batches[swap.PoolAsset.Symbol], swap)
+	}
+	for _, symbol := range symbols {
+		swaps := batches[symbol]
+		err := ApplyCached(ctx, func(ctx sdk.Context) error {
+			return k.clearBatch(ctx, symbol, swaps, pmtpCurrentRunningRate)
+		})
+		if err == nil {
+			continue
+		}
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to clear batch swaps of pool %s : %s", symbol, err.Error()))
+		for _, swap := range swaps {
+			refundErr := ApplyCached(ctx, func(ctx sdk.Context) error {
+				return k.refundQueuedSwap(ctx, *swap, err.Error())
+			})
+			if refundErr != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to refund queued swap %d : %s", swap.Id, refundErr.Error()))
+				k.SetQueuedSwap(ctx, swap)
+			}
+		}
+	}
+}
+
+func (k Keeper) clearBatch(ctx sdk.Context, symbol string, swaps []*types.QueuedSwap, pmtpCurrentRunningRate sdk.Dec) error {
+	pool, err := k.GetPool(ctx, symbol)
+	if err != nil {
+		return types.ErrPoolDoesNotExist
+	}
+	normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+	var clearing BatchClearing
+	for {
+		clearing, err = CalcBatchClearing(pool, swaps, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return err
+		}
+		// Swaps whose minimum is not met at the clearing price are refunded and the rest of the batch is cleared again
+		var kept []*types.QueuedSwap
+		for i, swap := range swaps {
+			if clearing.Payouts[i].LT(swap.MinReceivingAmount) {
+				err = k.refundQueuedSwap(ctx, *swap, types.ErrReceivedAmountBelowExpected.Error())
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			kept = append(kept, swap)
+		}
+		if len(kept) == len(swaps) {
+			break
+		}
+		if len(kept) == 0 {
+			return nil
+		}
+		swaps = kept
+	}
+	if err = checkSwapsEnabled(pool); err != nil {
+		return err
+	}
+	if !clearing.NetAmount.IsZero() {
+		err = k.checkPriceImpact(ctx, pool, clearing.NetReceivedAsset(pool), clearing.NetAmount)
+		if err != nil {
+			return err
+		}
+		err = k.CollectSwapFees(ctx, &clearing.Pool, clearing.NetAmount, clearing.NetReceivedAsset(pool), clearing.LiquidityFee, clearing.SwapFee)
+		if err != nil {
+			return err
+		}
+	}
+	err = k.SetPool(ctx, &clearing.Pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	// The matched flows never go through the pool, they are only counted as volume
+	matched := clearing.NetSentAmount.Sub(clearing.NetAmount)
+	if !matched.IsZero() {
+		k.RecordSwapStats(ctx, symbol, matched, clearing.NetReceivedAsset(pool), sdk.ZeroUint())
+	}
+	if opposing := clearing.OpposingAmount(); !opposing.IsZero() {
+		k.RecordSwapStats(ctx, symbol, opposing, clearing.NetSentAsset(pool), sdk.ZeroUint())
+	}
+	for i, swap := range swaps {
+		err = k.payQueuedSwap(ctx, clearing, *swap, clearing.Payouts[i])
+		if err != nil {
+			return err
+		}
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClearBatchSwaps,
+		sdk.NewAttribute(types.AttributeKeyPool, clearing.Pool.String()),
+		sdk.NewAttribute(types.AttributeKeyClearingPrice, clearing.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyNativeAmount, clearing.NativeIn.String()),
+		sdk.NewAttribute(types.AttributeKeyExternalAmount, clearing.ExternalIn.String()),
+		sdk.NewAttribute(types.AttributeKeyNetSwapAmount, clearing.NetAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyLiquidityFee, clearing.LiquidityFee.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return nil
+}
+
+func (k Keeper) payQueuedSwap(ctx sdk.Context, clearing BatchClearing, swap types.QueuedSwap, payout sdk.Uint) error {
+	signer, err := sdk.AccAddressFromBech32(swap.Signer)
+	if err != nil {
+		return err
+	}
+	payoutInt, ok := k.ParseToInt(payout.String())
+	if !ok {
+		return types.ErrUnableToParseInt
+	}
+	if payoutInt.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(sdk.NewCoin(swap.ReceivedAsset.Symbol, payoutInt)))
+		if err != nil {
+			return err
+		}
+	}
+	// The liquidity fee of the net swap is shared by the side it was swapped for
+	liquidityFee := sdk.ZeroUint()
+	if swap.SentAsset.Equals(clearing.NetSentAsset(clearing.Pool)) && !clearing.NetSentAmount.IsZero() {
+		liquidityFee = clearing.LiquidityFee.Mul(swap.SentAmount).Quo(clearing.NetSentAmount)
+	}
+	k.AfterSwap(ctx, signer, clearing.Pool,
+		sdk.NewCoin(swap.SentAsset.Symbol, sdk.NewIntFromBigInt(swap.SentAmount.BigInt())),
+		sdk.NewCoin(swap.ReceivedAsset.Symbol, payoutInt),
+		sdk.NewCoin(swap.ReceivedAsset.Symbol, sdk.NewIntFromBigInt(liquidityFee.BigInt())))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwap,
+		sdk.NewAttribute(types.AttributeKeyQueuedSwap, swap.String()),
+		sdk.NewAttribute(types.AttributeKeySwapAmount, payout.String()),
+		sdk.NewAttribute(types.AttributeKeyLiquidityFee, liquidityFee.String()),
+		sdk.NewAttribute(types.AttributeKeyClearingPrice, clearing.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return nil
+}
+
+func (k Keeper) refundQueuedSwap(ctx sdk.Context, swap types.QueuedSwap, reason string) error {
+	signer, err := sdk.AccAddressFromBech32(swap.Signer)
+	if err != nil {
+		return err
+	}
+	sentAmountInt, ok := k.ParseToInt(swap.SentAmount.String())
+	if !ok {
+		return types.ErrUnableToParseInt
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, sdk.NewCoins(sdk.NewCoin(swap.SentAsset.Symbol, sentAmountInt)))
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwapFailed,
+		sdk.NewAttribute(types.AttributeKeyQueuedSwap, swap.String()),
+		sdk.NewAttribute(types.AttributeKeyThreshold, swap.MinReceivingAmount.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAction, reason),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return nil
+}
+
+// BatchClearing is the outcome of clearing a batch of queued swaps against a pool
+type BatchClearing struct {
+	NativeIn   sdk.Uint
+	ExternalIn sdk.Uint
+	// NetSentAmount is the total sent by the larger side of the batch, of which NetAmount is swapped through the pool
+	// and the rest is matched against the other side
+	NetSentAmount sdk.Uint
+	NetAmount     sdk.Uint
+	LiquidityFee  sdk.Uint
+	// SwapFee is the part of LiquidityFee charged at the swap fee rate of the pool
+	SwapFee sdk.Uint
+	// Price is the uniform clearing price, in external asset per native asset
+	Price sdk.Dec
+	// Pool is the pool after the net swap, the rounding remainders of the payouts are kept by the pool
+	Pool       types.Pool
+	Payouts    []sdk.Uint
+	nativeSide bool
+}
+
+// OpposingAmount is the total sent by the smaller side of the batch
+func (c BatchClearing) OpposingAmount() sdk.Uint {
+	if c.nativeSide {
+		return c.ExternalIn
+	}
+	return c.NativeIn
+}
+
+// NetSentAsset is the asset of the larger side of the batch, which is swapped through the pool
+func (c BatchClearing) NetSentAsset(pool types.Pool) types.Asset {
+	if c.nativeSide {
+		return types.GetSettlementAsset()
+	}
+	return *pool.ExternalAsset
+}
+
+// NetReceivedAsset is the asset the net swap of the batch receives from the pool
+func (c BatchClearing) NetReceivedAsset(pool types.Pool) types.Asset {
+	if c.nativeSide {
+		return *pool.ExternalAsset
+	}
+	return types.GetSettlementAsset()
+}
+
+// CalcBatchClearing computes the uniform price at which a batch of swaps against a pool clears.
+// The flows of both sides are netted against each other and only the excess of the larger side is swapped through
+// the pool. Every swap of the batch trades at the price of the pool for that net swap, so the larger side receives
+// the opposing flow plus the result of the net swap and the opposing side receives the remainder of the larger side.
+// The net amount depends on the clearing price, it is found by bisection.
+func CalcBatchClearing(pool types.Pool, swaps []*types.QueuedSwap, normalizationFactor sdk.Dec, adjustExternalToken bool, pmtpCurrentRunningRate sdk.Dec) (BatchClearing, error) {
+	clearing := BatchClearing{NativeIn: sdk.ZeroUint(), ExternalIn: sdk.ZeroUint(), LiquidityFee: sdk.ZeroUint(), SwapFee: sdk.ZeroUint(), Pool: pool}
+	for _, swap := range swaps {
+		if swap.SentAsset.Equals(types.GetSettlementAsset()) {
+			clearing.NativeIn = clearing.NativeIn.Add(swap.SentAmount)
+		} else {
+			clearing.ExternalIn = clearing.ExternalIn.Add(swap.SentAmount)
+		}
+	}
+	if pool.NativeAssetBalance.IsZero() || pool.ExternalAssetBalance.IsZero() {
+		return BatchClearing{}, types.ErrNotEnoughAssetTokens
+	}
+	// The larger side is found by valuing both flows at the pool ratio
+	clearing.nativeSide = clearing.NativeIn.Mul(pool.ExternalAssetBalance).GTE(clearing.ExternalIn.Mul(pool.NativeAssetBalance))
+	sentAsset, receivedAsset := clearing.NetSentAsset(pool), clearing.NetReceivedAsset(pool)
+	sent, opposing := clearing.NativeIn, clearing.ExternalIn
+	if !clearing.nativeSide {
+		sent, opposing = clearing.ExternalIn, clearing.NativeIn
+	}
+	swapNet := func(netAmount sdk.Uint) (sdk.Uint, error) {
+		clearing.LiquidityFee, clearing.SwapFee, clearing.Pool = sdk.ZeroUint(), sdk.ZeroUint(), pool
+		if netAmount.IsZero() {
+			return sdk.ZeroUint(), nil
+		}
+		swapResult, liquidityFee, swapFee, _, swappedPool, err := SwapOneWithSwapFee(sentAsset, netAmount, receivedAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		clearing.LiquidityFee, clearing.SwapFee, clearing.Pool = liquidityFee, swapFee, swappedPool
+		return swapResult, err
+	}
+	// The opposing side buys the matched part of the larger side at the price of the net swap, so the net amount
+	// is the smallest one that is at least what the opposing side leaves unmatched at that price
+	low, high := sdk.ZeroUint(), sent
+	for low.LT(high) {
+		mid := low.Add(high).QuoUint64(2)
+		swapResult, err := swapNet(mid)
+		if err != nil {
+			return BatchClearing{}, err
+		}
+		unmatched := sent
+		if total := opposing.Add(swapResult); !total.IsZero() {
+			unmatched = sent.Sub(opposing.Mul(sent).Quo(total))
+		}
+		if unmatched.LTE(mid) {
+			high = mid
+		} else {
+			low = mid.AddUint64(1)
+		}
+	}
+	netAmount := high
+	swapResult, err := swapNet(netAmount)
+	if err != nil {
+		return BatchClearing{}, err
+	}
+	clearing.NetSentAmount, clearing.NetAmount = sent, netAmount
+	sentTotal := opposing.Add(swapResult)
+	opposingTotal := sent.Sub(netAmount)
+	if clearing.nativeSide {
+		clearing.Price = uintRatio(sentTotal, sent)
+	} else {
+		clearing.Price = uintRatio(sent, sentTotal)
+	}
+	// Payouts are pro rata within each side, the rounding remainders stay in the pool
+	sentPaid, opposingPaid := sdk.ZeroUint(), sdk.ZeroUint()
+	for _, swap := range swaps {
+		payout := sdk.ZeroUint()
+		if swap.SentAsset.Equals(sentAsset) {
+			payout = swap.SentAmount.Mul(sentTotal).Quo(sent)
+			sentPaid = sentPaid.Add(payout)
+		} else {
+			payout = swap.SentAmount.Mul(opposingTotal).Quo(opposing)
+			opposingPaid = opposingPaid.Add(payout)
+		}
+		clearing.Payouts = append(clearing.Payouts, payout)
+	}
+	if clearing.nativeSide {
+		clearing.Pool.ExternalAssetBalance = clearing.Pool.ExternalAssetBalance.Add(sentTotal.Sub(sentPaid))
+		clearing.Pool.NativeAssetBalance = clearing.Pool.NativeAssetBalance.Add(opposingTotal.Sub(opposingPaid))
+	} else {
+		clearing.Pool.NativeAssetBalance = clearing.Pool.NativeAssetBalance.Add(sentTotal.Sub(sentPaid))
+		clearing.Pool.ExternalAssetBalance = clearing.Pool.ExternalAssetBalance.Add(opposingTotal.Sub(opposingPaid))
+	}
+	return clearing, nil
+}
+
+func uintRatio(numerator, denominator sdk.Uint) sdk.Dec {
+	if denominator.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromBigInt(numerator.BigInt()).Quo(sdk.NewDecFromBigInt(denominator.BigInt()))
+}
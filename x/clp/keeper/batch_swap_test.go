@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_BatchSwap(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+	buyer, seller, greedy := sdk.AccAddress("buyer_______________"), sdk.AccAddress("seller______________"), sdk.AccAddress("greedy______________")
+	for _, addr := range []sdk.AccAddress{buyer, seller, greedy} {
+		funds := sdk.NewCoins(sdk.NewCoin(rowan.Symbol, sdk.NewInt(100000000000)), sdk.NewCoin(eth.Symbol, sdk.NewInt(100000000000)))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, funds))
+	}
+
+	modeMsg := types.NewMsgUpdatePoolSwapMode(buyer, eth, types.SwapMode_SWAP_MODE_BATCH)
+	_, err := msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	modeMsg = types.NewMsgUpdatePoolSwapMode(admin, eth, types.SwapMode_SWAP_MODE_BATCH)
+	_, err = msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+	_, _, err = app.ClpKeeper.GetBatchSwapPool(ctx, eth, types.NewAsset("cusdc"))
+	require.ErrorIs(t, err, types.ErrBatchSwapNotSupported)
+
+	poolBefore, err := app.ClpKeeper.GetPool(ctx, eth.Symbol)
+	require.NoError(t, err)
+	swaps := []types.MsgSwap{
+		types.NewMsgSwap(buyer, rowan, eth, sdk.NewUint(60000000000), sdk.ZeroUint()),
+		types.NewMsgSwap(buyer, rowan, eth, sdk.NewUint(20000000000), sdk.ZeroUint()),
+		types.NewMsgSwap(seller, eth, rowan, sdk.NewUint(40000000000), sdk.ZeroUint()),
+		// Buyers pay more than the pool ratio, so a one to one minimum cannot be met
+		types.NewMsgSwap(greedy, rowan, eth, sdk.NewUint(10000000000), sdk.NewUint(10000000000)),
+	}
+	for i := range swaps {
+		res, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &swaps[i])
+		require.NoError(t, err)
+		require.Equal(t, uint64(i+1), res.QueuedSwapId)
+	}
+	require.Len(t, app.ClpKeeper.GetQueuedSwaps(ctx), 4)
+	pool, err := app.ClpKeeper.GetPool(ctx, eth.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, poolBefore.NativeAssetBalance, pool.NativeAssetBalance)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	app.ClpKeeper.ClearBatchSwaps(ctx, sdk.ZeroDec())
+
+	require.Empty(t, app.ClpKeeper.GetQueuedSwaps(ctx))
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+	require.Equal(t, sdk.NewInt(100000000000), app.BankKeeper.GetBalance(ctx, greedy, rowan.Symbol).Amount)
+	bought := app.BankKeeper.GetBalance(ctx, buyer, eth.Symbol).Amount.Sub(sdk.NewInt(100000000000))
+	sold := app.BankKeeper.GetBalance(ctx, seller, rowan.Symbol).Amount.Sub(sdk.NewInt(100000000000))
+	require.True(t, bought.IsPositive())
+	require.True(t, sold.IsPositive())
+	// Both sides trade at the same price
+	buyPrice := bought.ToDec().Quo(sdk.NewDec(80000000000))
+	sellPrice := sdk.NewDec(40000000000).Quo(sold.ToDec())
+	require.True(t, buyPrice.Sub(sellPrice).Abs().LT(sdk.NewDecWithPrec(1, 9)), "%s != %s", buyPrice, sellPrice)
+	// Only the net flow goes through the pool, so buyers get more than by swapping their whole amount
+	normalizationFactor, adjustExternalToken := app.ClpKeeper.GetNormalizationFactorFromAsset(ctx, eth)
+	sequential, _, _, _, err := clpkeeper.SwapOne(rowan, sdk.NewUint(80000000000), eth, poolBefore, normalizationFactor, adjustExternalToken, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, bought.GT(sdk.NewIntFromBigInt(sequential.BigInt())))
+	pool, err = app.ClpKeeper.GetPool(ctx, eth.Symbol)
+	require.NoError(t, err)
+	require.True(t, pool.NativeAssetBalance.GT(poolBefore.NativeAssetBalance))
+	require.True(t, pool.NativeAssetBalance.LT(poolBefore.NativeAssetBalance.Add(sdk.NewUint(40000000000))))
+
+	// A batch with a single side is swapped through the pool as a whole
+	sell := types.NewMsgSwap(seller, eth, rowan, sdk.NewUint(10000000000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &sell)
+	require.NoError(t, err)
+	app.ClpKeeper.ClearBatchSwaps(ctx, sdk.ZeroDec())
+	poolAfter, err := app.ClpKeeper.GetPool(ctx, eth.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, pool.ExternalAssetBalance.Add(sdk.NewUint(10000000000)), poolAfter.ExternalAssetBalance)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	// Back in sequential mode swaps execute right away
+	modeMsg = types.NewMsgUpdatePoolSwapMode(admin, eth, types.SwapMode_SWAP_MODE_SEQUENTIAL)
+	_, err = msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+	res, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &sell)
+	require.NoError(t, err)
+	require.Zero(t, res.QueuedSwapId)
+	require.Empty(t, app.ClpKeeper.GetQueuedSwaps(ctx))
+}
+
+func TestMsgServer_BatchSwap_SequentialSwapsRejected(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+
+	// An order placed before the switch stays open while the pool is in batch mode
+	order := types.NewMsgPlaceLimitOrder(admin, eth, rowan, sdk.NewUint(1000000), sdk.MustNewDecFromStr("0.5"), 0)
+	_, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &order)
+	require.NoError(t, err)
+	modeMsg := types.NewMsgUpdatePoolSwapMode(admin, eth, types.SwapMode_SWAP_MODE_BATCH)
+	_, err = msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+
+	// Failing messages run in a cached context, as their state changes would be reverted with the transaction
+	failCtx, _ := ctx.CacheContext()
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(failCtx), &order)
+	require.ErrorIs(t, err, types.ErrPoolInBatchMode)
+	route := types.NewMsgSwapRoute(admin, []*types.Asset{&eth, &rowan}, sdk.NewUint(1000000), sdk.ZeroUint())
+	_, err = msgServer.SwapRoute(sdk.WrapSDKContext(failCtx), &route)
+	require.ErrorIs(t, err, types.ErrPoolInBatchMode)
+	singleSided := types.NewMsgAddLiquiditySingleSided(admin, eth, eth, sdk.NewUint(1000000))
+	_, err = msgServer.AddLiquiditySingleSided(sdk.WrapSDKContext(failCtx), &singleSided)
+	require.ErrorIs(t, err, types.ErrPoolInBatchMode)
+
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), 1)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	modeMsg = types.NewMsgUpdatePoolSwapMode(admin, eth, types.SwapMode_SWAP_MODE_SEQUENTIAL)
+	_, err = msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+	require.Empty(t, app.ClpKeeper.GetLimitOrders(ctx))
+}
+
+func TestKeeper_ClearBatchSwaps_RefundFails(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+	modeMsg := types.NewMsgUpdatePoolSwapMode(admin, eth, types.SwapMode_SWAP_MODE_BATCH)
+	_, err := msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+	swap := types.NewMsgSwap(admin, eth, rowan, sdk.NewUint(1000000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swap)
+	require.NoError(t, err)
+	// The clp module does not hold enough ceth to refund this swap
+	app.ClpKeeper.SetQueuedSwap(ctx, &types.QueuedSwap{
+		Id:                 app.ClpKeeper.GetNextQueuedSwapID(ctx),
+		Signer:             address,
+		PoolAsset:          &eth,
+		SentAsset:          &eth,
+		ReceivedAsset:      &rowan,
+		SentAmount:         sdk.NewUint(1000000000000000),
+		MinReceivingAmount: sdk.ZeroUint(),
+	})
+	require.Len(t, app.ClpKeeper.GetQueuedSwaps(ctx), 2)
+	pool, err := app.ClpKeeper.GetPool(ctx, eth.Symbol)
+	require.NoError(t, err)
+	pool.SwapsPaused = true
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+
+	require.NotPanics(t, func() { app.ClpKeeper.ClearBatchSwaps(ctx, sdk.ZeroDec()) })
+
+	queued := app.ClpKeeper.GetQueuedSwaps(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, sdk.NewUint(1000000000000000), queued[0].SentAmount)
+	require.Equal(t, sdk.NewInt(3000000), app.BankKeeper.GetBalance(ctx, admin, eth.Symbol).Amount)
+}
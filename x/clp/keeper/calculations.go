@@ -20,25 +20,42 @@ func SwapOne(from types.Asset,
 	adjustExternalToken bool,
 	pmtpCurrentRunningRate sdk.Dec) (sdk.Uint, sdk.Uint, sdk.Uint, types.Pool, error) {
 
+	swapResult, liquidityFee, _, priceImpact, swappedPool, err := SwapOneWithSwapFee(from, sentAmount, to, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	return swapResult, liquidityFee, priceImpact, swappedPool, err
+}
+
+// SwapOneWithSwapFee is SwapOne also returning the part of the liquidity fee charged at the swap fee rate of the pool
+func SwapOneWithSwapFee(from types.Asset,
+	sentAmount sdk.Uint,
+	to types.Asset,
+	pool types.Pool,
+	normalizationFactor sdk.Dec,
+	adjustExternalToken bool,
+	pmtpCurrentRunningRate sdk.Dec) (sdk.Uint, sdk.Uint, sdk.Uint, sdk.Uint, types.Pool, error) {
+
 	X, x, Y, toRowan := SetInputs(sentAmount, to, pool)
 	liquidityFee, err := CalcLiquidityFee(toRowan, normalizationFactor, adjustExternalToken, X, x, Y)
 	if err != nil {
 		// this branch will never be reached as err will always be nil
-		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
+		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
 	}
 	priceImpact, err := calcPriceImpact(X, x)
 	if err != nil {
 		// this branch will never be reached as err will always be nil
-		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
+		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
 	}
 	swapResult, err := CalcSwapResult(toRowan, normalizationFactor, adjustExternalToken, X, x, Y, pmtpCurrentRunningRate)
 	if err != nil {
 		// this branch will never be reached as err will always be nil
-		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
+		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, types.Pool{}, err
 	}
 	if swapResult.GTE(Y) {
-		return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), types.Pool{}, types.ErrNotEnoughAssetTokens
+		return sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint(), types.Pool{}, types.ErrNotEnoughAssetTokens
 	}
+	// The swap fee of the pool is kept in the pool and counted as liquidity fee
+	swapFee := calcPoolSwapFee(pool.SwapFeeRateOrZero(), swapResult)
+	swapResult = swapResult.Sub(swapFee)
+	liquidityFee = liquidityFee.Add(swapFee)
 	if from == types.GetSettlementAsset() {
 		pool.NativeAssetBalance = X.Add(x)
 		pool.ExternalAssetBalance = Y.Sub(swapResult)
@@ -47,7 +64,7 @@ func SwapOne(from types.Asset,
 		pool.NativeAssetBalance = Y.Sub(swapResult)
 	}
 
-	return swapResult, liquidityFee, priceImpact, pool, nil
+	return swapResult, liquidityFee, swapFee, priceImpact, pool, nil
 }
 
 func CalcSwapPrice(from types.Asset,
@@ -419,6 +436,14 @@ func CalcSwapPriceResult(toRowan bool,
 	return y
 }
 
+func calcPoolSwapFee(swapFeeRate sdk.Dec, swapResult sdk.Uint) sdk.Uint {
+	if !swapFeeRate.IsPositive() {
+		return sdk.ZeroUint()
+	}
+	fee := sdk.NewDecFromBigInt(swapResult.BigInt()).Mul(swapFeeRate)
+	return sdk.NewUintFromBigInt(fee.TruncateInt().BigInt())
+}
+
 func calcPriceImpact(X, x sdk.Uint) (sdk.Uint, error) {
 	if x.IsZero() {
 		return sdk.ZeroUint(), nil
@@ -440,3 +465,38 @@ func CalculateAllAssetsForLP(pool types.Pool, lp types.LiquidityProvider) (sdk.U
 		sdk.ZeroInt(),
 	)
 }
+
+// CalculateSingleSidedSwapAmount returns the share of amount of sentAsset to swap through pool before adding the
+// remainder and the swap result as liquidity. It is the largest swap for which the remainder still matches or exceeds
+// the pool ratio after the swap, so the slip adjustment of the add charges at most one unit of imbalance.
+func CalculateSingleSidedSwapAmount(sentAsset types.Asset,
+	amount sdk.Uint,
+	pool types.Pool,
+	normalizationFactor sdk.Dec,
+	adjustExternalToken bool,
+	pmtpCurrentRunningRate sdk.Dec) sdk.Uint {
+
+	toAsset := types.GetSettlementAsset()
+	if sentAsset.Equals(toAsset) {
+		toAsset = *pool.ExternalAsset
+	}
+	low, high := sdk.ZeroUint(), amount
+	for high.Sub(low).GT(sdk.OneUint()) {
+		mid := low.Add(high).QuoUint64(2)
+		swapResult, _, _, swappedPool, err := SwapOne(sentAsset, mid, toAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			high = mid
+			continue
+		}
+		sentBalance, receivedBalance := swappedPool.ExternalAssetBalance, swappedPool.NativeAssetBalance
+		if toAsset.Equals(*pool.ExternalAsset) {
+			sentBalance, receivedBalance = receivedBalance, sentBalance
+		}
+		if amount.Sub(mid).Mul(receivedBalance).GTE(swapResult.Mul(sentBalance)) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
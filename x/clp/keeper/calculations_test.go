@@ -135,13 +135,13 @@ func TestKeeper_SwapOneFromGenesis(t *testing.T) {
 			wBasis:                 sdk.NewInt(1000),
 			asymmetry:              sdk.NewInt(10000),
 			pmtpCurrentRunningRate: sdk.OneDec(),
-			swapResult:             sdk.NewUint(20),
-			liquidityFee:           sdk.NewUint(978),
+			swapResult:             sdk.NewUint(165),
+			liquidityFee:           sdk.NewUint(8),
 			priceImpact:            sdk.ZeroUint(),
 			expectedPool: types.Pool{
 				ExternalAsset:                 &types.Asset{Symbol: "eth"},
-				NativeAssetBalance:            sdk.NewUint(100598),
-				ExternalAssetBalance:          sdk.NewUint(978),
+				NativeAssetBalance:            sdk.NewUint(1098),
+				ExternalAssetBalance:          sdk.NewUint(833),
 				PoolUnits:                     sdk.NewUint(1),
 				RewardPeriodNativeDistributed: sdk.ZeroUint(),
 			},
@@ -749,6 +749,14 @@ func TestKeeper_SwapOneFromGenesis(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				pools := []*types.Pool{
+					{
+						ExternalAsset:        &types.Asset{Symbol: tc.poolAsset},
+						NativeAssetBalance:   tc.nativeAssetAmount,
+						ExternalAssetBalance: tc.externalAssetAmount,
+						PoolUnits:            tc.poolUnits,
+					},
+				}
 				balances := []banktypes.Balance{
 					{
 						Address: tc.address,
@@ -760,22 +768,15 @@ func TestKeeper_SwapOneFromGenesis(t *testing.T) {
 				}
 				bankGs := banktypes.DefaultGenesisState()
 				bankGs.Balances = append(bankGs.Balances, balances...)
+				bankGs.Balances = append(bankGs.Balances, test.GetPoolsModuleBalance(pools))
 				bz, _ = app.AppCodec().MarshalJSON(bankGs)
 				genesisState["bank"] = bz
 
-				pools := []*types.Pool{
-					{
-						ExternalAsset:        &types.Asset{Symbol: tc.poolAsset},
-						NativeAssetBalance:   tc.nativeAssetAmount,
-						ExternalAssetBalance: tc.externalAssetAmount,
-						PoolUnits:            tc.poolUnits,
-					},
-				}
 				lps := []*types.LiquidityProvider{
 					{
 						Asset:                    &types.Asset{Symbol: tc.poolAsset},
 						LiquidityProviderAddress: tc.address,
-						LiquidityProviderUnits:   tc.nativeAssetAmount,
+						LiquidityProviderUnits:   tc.poolUnits,
 					},
 				}
 				clpGs := types.DefaultGenesisState()
@@ -850,10 +851,11 @@ func TestKeeper_SwapOneFromGenesis(t *testing.T) {
 			}
 
 			require.NoError(t, err)
-			require.Equal(t, swapResult, tc.swapResult)
-			require.Equal(t, liquidityFee, tc.liquidityFee)
-			require.Equal(t, priceImpact, tc.priceImpact)
-			require.Equal(t, newPool, tc.expectedPool)
+			// A computed zero and sdk.ZeroUint() differ in their internal representation only
+			require.Equal(t, tc.swapResult.String(), swapResult.String())
+			require.Equal(t, tc.liquidityFee.String(), liquidityFee.String())
+			require.Equal(t, tc.priceImpact.String(), priceImpact.String())
+			require.Equal(t, tc.expectedPool, newPool)
 		})
 	}
 }
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) SetCircuitBreakerParams(ctx sdk.Context, params *types.CircuitBreakerParams) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CircuitBreakerPrefix, k.cdc.MustMarshal(params))
+}
+
+// GetCircuitBreakerParams returns the circuit breaker params, chains upgraded from a version without them have it disabled
+func (k Keeper) GetCircuitBreakerParams(ctx sdk.Context) *types.CircuitBreakerParams {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CircuitBreakerPrefix)
+	if bz == nil {
+		return types.GetDefaultCircuitBreakerParams()
+	}
+	params := types.CircuitBreakerParams{}
+	k.cdc.MustUnmarshal(bz, &params)
+	return &params
+}
+
+// checkSwapsEnabled fails if swaps of the pool are paused, the pool still waits for approval or winds down
+func checkSwapsEnabled(pool types.Pool) error {
+	if pool.SwapsPaused {
+		return sdkerrors.Wrap(types.ErrPoolPaused, fmt.Sprintf("swaps of pool %s", pool.ExternalAsset.Symbol))
+	}
+	if pool.Pending {
+		return sdkerrors.Wrap(types.ErrPoolPending, pool.ExternalAsset.Symbol)
+	}
+	if pool.WindDownEndHeight != 0 {
+		return sdkerrors.Wrap(types.ErrPoolWindingDown, pool.ExternalAsset.Symbol)
+	}
+	return nil
+}
+
+// checkAddsEnabled fails if liquidity additions of the pool are paused or the pool winds down
+func checkAddsEnabled(pool types.Pool) error {
+	if pool.AddsPaused {
+		return sdkerrors.Wrap(types.ErrPoolPaused, fmt.Sprintf("liquidity additions of pool %s", pool.ExternalAsset.Symbol))
+	}
+	if pool.WindDownEndHeight != 0 {
+		return sdkerrors.Wrap(types.ErrPoolWindingDown, pool.ExternalAsset.Symbol)
+	}
+	return nil
+}
+
+// checkSequentialSwaps fails if the pool is in batch swap mode, such a pool only accepts the swaps queued by MsgSwap
+func checkSequentialSwaps(pool types.Pool) error {
+	if pool.SwapMode == types.SwapMode_SWAP_MODE_BATCH {
+		return sdkerrors.Wrap(types.ErrPoolInBatchMode, pool.ExternalAsset.Symbol)
+	}
+	return nil
+}
+
+// CheckSwapAllowed fails if swaps of the pool are not enabled, if the pool is in batch swap mode or if swapping
+// sentAmount into the pool trades against a larger share of the pool than the circuit breaker allows
+func (k Keeper) CheckSwapAllowed(ctx sdk.Context, pool types.Pool, to types.Asset, sentAmount sdk.Uint) error {
+	if err := checkSwapsEnabled(pool); err != nil {
+		return err
+	}
+	if err := checkSequentialSwaps(pool); err != nil {
+		return err
+	}
+	return k.checkPriceImpact(ctx, pool, to, sentAmount)
+}
+
+// checkPriceImpact fails if swapping sentAmount into the pool trades against a larger share of the pool than the
+// circuit breaker allows
+func (k Keeper) checkPriceImpact(ctx sdk.Context, pool types.Pool, to types.Asset, sentAmount sdk.Uint) error {
+	maxPriceImpact := k.GetCircuitBreakerParams(ctx).MaxPriceImpact
+	if !maxPriceImpact.IsPositive() || sentAmount.IsZero() {
+		return nil
+	}
+	X, x, _, _ := SetInputs(sentAmount, to, pool)
+	priceImpact := sdk.NewDecFromBigInt(x.BigInt()).Quo(sdk.NewDecFromBigInt(X.Add(x).BigInt()))
+	if priceImpact.GT(maxPriceImpact) {
+		return sdkerrors.Wrap(types.ErrPriceImpactTooHigh, fmt.Sprintf("price impact %s greater than %s", priceImpact, maxPriceImpact))
+	}
+	return nil
+}
+
+// checkPriceChange pauses the swaps of pool when its native swap price moved more than the
+// circuit breaker allows since the previous block
+func (k Keeper) checkPriceChange(ctx sdk.Context, pool *types.Pool, previousPrice *sdk.Dec, maxPriceChange sdk.Dec) {
+	if !maxPriceChange.IsPositive() || pool.SwapsPaused || previousPrice == nil || !previousPrice.IsPositive() {
+		return
+	}
+	priceChange := pool.SwapPriceNative.Sub(*previousPrice).Abs().Quo(*previousPrice)
+	if priceChange.LTE(maxPriceChange) {
+		return
+	}
+	pool.SwapsPaused = true
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCircuitBreakerTripped,
+		sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+		sdk.NewAttribute(types.AttributeKeyPriceChange, priceChange.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	k.Logger(ctx).Info(fmt.Sprintf("Pausing swaps of pool %s | Price change : %s", pool.ExternalAsset.Symbol, priceChange))
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_UpdatePoolPauseState(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	adminAddr, _ := sdk.AccAddressFromBech32(admin)
+	otherAddr, _ := sdk.AccAddressFromBech32(other)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+
+	pauseMsg := types.NewMsgUpdatePoolPauseState(otherAddr, eth, true, true, true)
+	_, err := msgServer.UpdatePoolPauseState(sdk.WrapSDKContext(ctx), &pauseMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+
+	pauseMsg = types.NewMsgUpdatePoolPauseState(adminAddr, eth, true, true, true)
+	_, err = msgServer.UpdatePoolPauseState(sdk.WrapSDKContext(ctx), &pauseMsg)
+	require.NoError(t, err)
+	swapMsg := types.NewMsgSwap(adminAddr, rowan, eth, sdk.NewUint(1000), sdk.NewUint(1))
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.ErrorIs(t, err, types.ErrPoolPaused)
+	routeMsg := types.NewMsgSwapRoute(adminAddr, []*types.Asset{&rowan, &eth}, sdk.NewUint(1000), sdk.NewUint(1))
+	_, err = msgServer.SwapRoute(sdk.WrapSDKContext(ctx), &routeMsg)
+	require.ErrorIs(t, err, types.ErrPoolPaused)
+	addMsg := types.NewMsgAddLiquidity(adminAddr, eth, sdk.NewUint(1000), sdk.NewUint(1000))
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.ErrorIs(t, err, types.ErrPoolPaused)
+	removeMsg := types.NewMsgRemoveLiquidity(adminAddr, eth, sdk.NewInt(10000), sdk.ZeroInt())
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeMsg)
+	require.ErrorIs(t, err, types.ErrPoolPaused)
+
+	// Orders are kept while swaps are paused and fill once swaps resume
+	orderMsg := types.NewMsgPlaceLimitOrder(adminAddr, eth, rowan, sdk.NewUint(1000), sdk.MustNewDecFromStr("0.5"), 0)
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &orderMsg)
+	require.NoError(t, err)
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, 1)
+	require.NoError(t, err)
+
+	// Removals stay open while swaps and additions are paused
+	pauseMsg = types.NewMsgUpdatePoolPauseState(adminAddr, eth, true, true, false)
+	_, err = msgServer.UpdatePoolPauseState(sdk.WrapSDKContext(ctx), &pauseMsg)
+	require.NoError(t, err)
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeMsg)
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+
+	pauseMsg = types.NewMsgUpdatePoolPauseState(adminAddr, eth, false, false, false)
+	_, err = msgServer.UpdatePoolPauseState(sdk.WrapSDKContext(ctx), &pauseMsg)
+	require.NoError(t, err)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, 1)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+}
+
+func TestKeeper_CheckSwapAllowed(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+
+	// The circuit breaker is disabled by default
+	require.NoError(t, app.ClpKeeper.CheckSwapAllowed(ctx, pool, eth, sdk.NewUint(1000000000000)))
+
+	msg := types.NewMsgUpdateCircuitBreakerParams(signer, sdk.MustNewDecFromStr("0.01"), sdk.ZeroDec())
+	_, err = msgServer.UpdateCircuitBreakerParams(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.NoError(t, app.ClpKeeper.CheckSwapAllowed(ctx, pool, eth, sdk.NewUint(10000000000)))
+	err = app.ClpKeeper.CheckSwapAllowed(ctx, pool, eth, sdk.NewUint(10200000000))
+	require.ErrorIs(t, err, types.ErrPriceImpactTooHigh)
+}
+
+func TestKeeper_PriceChangeCircuitBreaker(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	app.ClpKeeper.SetCircuitBreakerParams(ctx, &types.CircuitBreakerParams{
+		MaxPriceImpact: sdk.ZeroDec(),
+		MaxPriceChange: sdk.MustNewDecFromStr("0.1"),
+	})
+	require.NoError(t, app.ClpKeeper.PolicyRun(ctx, sdk.ZeroDec()))
+
+	// A 5% move keeps the pool open
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	pool.ExternalAssetBalance = sdk.NewUint(1050000000000)
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	require.NoError(t, app.ClpKeeper.PolicyRun(ctx, sdk.ZeroDec()))
+	pool, err = app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.False(t, pool.SwapsPaused)
+
+	// A 50% move pauses swaps but leaves liquidity additions and removals open
+	pool.ExternalAssetBalance = sdk.NewUint(1575000000000)
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	require.NoError(t, app.ClpKeeper.PolicyRun(ctx, sdk.ZeroDec()))
+	pool, err = app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.True(t, pool.SwapsPaused)
+	require.False(t, pool.AddsPaused)
+	require.False(t, pool.RemovesPaused)
+}
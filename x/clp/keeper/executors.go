@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -38,6 +40,10 @@ func (k Keeper) CreatePool(ctx sdk.Context, poolUints sdk.Uint, msg *types.MsgCr
 		return nil, types.ErrBalanceNotAvailable
 	}
 	pool := types.NewPool(msg.ExternalAsset, msg.NativeAssetAmount, msg.ExternalAssetAmount, poolUints)
+	// Pools of tokens requiring approval cannot be swapped against until a clp admin approves them
+	if entry, err := k.tokenRegistryKeeper.GetEntry(k.tokenRegistryKeeper.GetRegistry(ctx), msg.ExternalAsset.Symbol); err == nil {
+		pool.Pending = entry.PoolApprovalRequired
+	}
 	// Send coins from user to pool
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(externalAssetCoin, nativeAssetCoin))
 	if err != nil {
@@ -48,6 +54,12 @@ func (k Keeper) CreatePool(ctx sdk.Context, poolUints sdk.Uint, msg *types.MsgCr
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
 	}
+	// All units of a new pool belong to its creator
+	err = k.MintLiquidityProviderTokens(ctx, msg.ExternalAsset.Symbol, poolUints, addr)
+	if err != nil {
+		return nil, err
+	}
+	k.AfterPoolCreated(ctx, addr, pool)
 	return &pool, nil
 }
 
@@ -102,6 +114,11 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 	pool.NativeAssetBalance = pool.NativeAssetBalance.Add(msg.NativeAssetAmount)
 	pool.ExternalAssetBalance = pool.ExternalAssetBalance.Add(msg.ExternalAssetAmount)
 
+	err = k.MintLiquidityProviderTokens(ctx, msg.ExternalAsset.Symbol, lpUnits, addr)
+	if err != nil {
+		return nil, err
+	}
+	mintedUnits := lpUnits
 	// Create new Liquidity provider or add liquidity units
 	lp, err := k.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
 	if err != nil {
@@ -123,6 +140,7 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 	}
 	// Save LP
 	k.SetLiquidityProvider(ctx, &lp)
+	k.AfterAddLiquidity(ctx, addr, pool, msg.NativeAssetAmount, msg.ExternalAssetAmount, mintedUnits)
 	return &lp, err
 }
 
@@ -136,6 +154,11 @@ func (k Keeper) RemoveLiquidityProvider(ctx sdk.Context, coins sdk.Coins, lp typ
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrUnableToAddBalance, err.Error())
 	}
+	// The refunded units must be backed by the tokens of the provider
+	err = k.BurnLiquidityProviderTokens(ctx, lp.Asset.Symbol, lp.LiquidityProviderUnits, lpaddr)
+	if err != nil {
+		return err
+	}
 	k.DestroyLiquidityProvider(ctx, lp.Asset.Symbol, lp.LiquidityProviderAddress)
 	return nil
 }
@@ -145,6 +168,7 @@ func (k Keeper) DecommissionPool(ctx sdk.Context, pool types.Pool) error {
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrUnableToDestroyPool, err.Error())
 	}
+	k.AfterPoolDecommissioned(ctx, pool)
 	return nil
 }
 
@@ -172,6 +196,12 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, pool types.Pool, externalAssetC
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
 	}
+	// Removed units are backed by the tokens of the provider
+	burntUnits := lp.LiquidityProviderUnits.Sub(lpUnitsLeft)
+	err = k.BurnLiquidityProviderTokens(ctx, lp.Asset.Symbol, burntUnits, lpAddr)
+	if err != nil {
+		return err
+	}
 	// Send coins from pool to user
 	if !sendCoins.Empty() {
 		for _, coin := range sendCoins {
@@ -191,9 +221,78 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, pool types.Pool, externalAssetC
 		lp.LiquidityProviderUnits = lpUnitsLeft
 		k.SetLiquidityProvider(ctx, &lp)
 	}
+	nativeAmount := sdk.NewUintFromBigInt(sendCoins.AmountOf(nativeAssetCoin.Denom).BigInt())
+	externalAmount := sdk.NewUintFromBigInt(sendCoins.AmountOf(externalAssetCoin.Denom).BigInt())
+	k.AfterRemoveLiquidity(ctx, lpAddr, pool, nativeAmount, externalAmount, burntUnits)
 	return nil
 }
 
+// TransferLiquidityPosition moves units of a liquidity provider, along with the tokens backing them, to recipient.
+// Pending unlocks move first, oldest first, so that the recipient keeps their position in the unlock queue.
+func (k Keeper) TransferLiquidityPosition(ctx sdk.Context, lp types.LiquidityProvider, recipient sdk.AccAddress, units sdk.Uint) (types.LiquidityProvider, types.LiquidityProvider, error) {
+	if units.GT(lp.LiquidityProviderUnits) {
+		return types.LiquidityProvider{}, types.LiquidityProvider{}, sdkerrors.Wrap(types.ErrBalanceNotAvailable, fmt.Sprintf("units %s greater than total LP units %s", units, lp.LiquidityProviderUnits))
+	}
+	lpAddr, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+	if err != nil {
+		return types.LiquidityProvider{}, types.LiquidityProvider{}, err
+	}
+	coin := sdk.NewCoin(types.GetLiquidityProviderTokenDenom(lp.Asset.Symbol), sdk.NewIntFromBigInt(units.BigInt()))
+	if !k.bankKeeper.HasBalance(ctx, lpAddr, coin) {
+		return types.LiquidityProvider{}, types.LiquidityProvider{}, sdkerrors.Wrap(types.ErrNotEnoughLiquidityProviderToken, coin.String())
+	}
+	err = k.bankKeeper.SendCoins(ctx, lpAddr, recipient, sdk.NewCoins(coin))
+	if err != nil {
+		return types.LiquidityProvider{}, types.LiquidityProvider{}, err
+	}
+
+	kept := make([]*types.LiquidityUnlock, 0)
+	moved := make([]*types.LiquidityUnlock, 0)
+	unitsToMove := units
+	for _, unlock := range lp.Unlocks {
+		if unitsToMove.IsZero() {
+			kept = append(kept, unlock)
+			continue
+		}
+		if unlock.Units.LTE(unitsToMove) {
+			moved = append(moved, unlock)
+			unitsToMove = unitsToMove.Sub(unlock.Units)
+			continue
+		}
+		moved = append(moved, &types.LiquidityUnlock{RequestHeight: unlock.RequestHeight, Units: unitsToMove})
+		kept = append(kept, &types.LiquidityUnlock{RequestHeight: unlock.RequestHeight, Units: unlock.Units.Sub(unitsToMove)})
+		unitsToMove = sdk.ZeroUint()
+	}
+
+	to, err := k.GetLiquidityProvider(ctx, lp.Asset.Symbol, recipient.String())
+	if err != nil {
+		to = k.CreateLiquidityProvider(ctx, lp.Asset, sdk.ZeroUint(), recipient)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateLiquidityProvider,
+				sdk.NewAttribute(types.AttributeKeyLiquidityProvider, to.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		})
+	}
+	to.LiquidityProviderUnits = to.LiquidityProviderUnits.Add(units)
+	to.Unlocks = append(to.Unlocks, moved...)
+	// Keep the unlock queue of the recipient ordered by request height
+	sort.SliceStable(to.Unlocks, func(i, j int) bool {
+		return to.Unlocks[i].RequestHeight < to.Unlocks[j].RequestHeight
+	})
+	k.SetLiquidityProvider(ctx, &to)
+
+	lp.LiquidityProviderUnits = lp.LiquidityProviderUnits.Sub(units)
+	lp.Unlocks = kept
+	if lp.LiquidityProviderUnits.IsZero() {
+		k.DestroyLiquidityProvider(ctx, lp.Asset.Symbol, lp.LiquidityProviderAddress)
+	} else {
+		k.SetLiquidityProvider(ctx, &lp)
+	}
+	return lp, to, nil
+}
+
 func (k Keeper) InitiateSwap(ctx sdk.Context, sentCoin sdk.Coin, swapper sdk.AccAddress) error {
 	if !k.bankKeeper.HasBalance(ctx, swapper, sentCoin) {
 		return types.ErrBalanceNotAvailable
@@ -205,6 +304,27 @@ func (k Keeper) InitiateSwap(ctx sdk.Context, sentCoin sdk.Coin, swapper sdk.Acc
 	return nil
 
 }
+
+// SettleSwap collects the fees of a swap of sentAmount through swappedPool, stores the pool and calls the swap hooks.
+// swapFee is the part of liquidityFee charged at the swap fee rate of the pool. The received asset is not sent to
+// the swapper.
+func (k Keeper) SettleSwap(ctx sdk.Context, swapper sdk.AccAddress, swappedPool *types.Pool, sentAsset types.Asset, sentAmount sdk.Uint,
+	receivedAsset types.Asset, swapResult sdk.Uint, liquidityFee sdk.Uint, swapFee sdk.Uint) error {
+	err := k.CollectSwapFees(ctx, swappedPool, sentAmount, receivedAsset, liquidityFee, swapFee)
+	if err != nil {
+		return err
+	}
+	err = k.SetPool(ctx, swappedPool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	k.AfterSwap(ctx, swapper, *swappedPool,
+		sdk.NewCoin(sentAsset.Symbol, sdk.NewIntFromBigInt(sentAmount.BigInt())),
+		sdk.NewCoin(receivedAsset.Symbol, sdk.NewIntFromBigInt(swapResult.BigInt())),
+		sdk.NewCoin(receivedAsset.Symbol, sdk.NewIntFromBigInt(liquidityFee.BigInt())))
+	return nil
+}
+
 func (k Keeper) FinalizeSwap(ctx sdk.Context, sentAmount string, finalPool types.Pool, msg types.MsgSwap) error {
 	err := k.SetPool(ctx, &finalPool)
 	if err != nil {
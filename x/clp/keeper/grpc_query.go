@@ -7,13 +7,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
 )
 
-const MaxPageLimit = 200
+const (
+	MaxPageLimit = 200
+	// MaxSimulatedEpochs and MaxSimulatedHeights bound the size of SimulatePmtpPolicy responses
+	MaxSimulatedEpochs  = 1000
+	MaxSimulatedHeights = 100
+)
 
 // Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
 type Querier struct {
@@ -246,3 +252,502 @@ func (k Querier) GetRewardParams(c context.Context, _ *types.RewardParamsReq) (*
 
 	return &types.RewardParamsRes{Params: params}, nil
 }
+
+func (k Querier) SwapQuote(c context.Context, req *types.SwapQuoteReq) (*types.SwapQuoteRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sentAmount, err := sdk.ParseUint(req.SentAmount)
+	if err != nil || sentAmount.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid sent amount %s", req.SentAmount))
+	}
+	sentAsset := types.NewAsset(req.SentAsset)
+	receivedAsset := types.NewAsset(req.ReceivedAsset)
+	if sentAsset.Equals(receivedAsset) {
+		return nil, status.Error(codes.InvalidArgument, "sent and received asset cannot be the same")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	registry := k.Keeper.tokenRegistryKeeper.GetRegistry(ctx)
+	sEntry, err := k.Keeper.tokenRegistryKeeper.GetEntry(registry, sentAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrTokenNotSupported
+	}
+	rEntry, err := k.Keeper.tokenRegistryKeeper.GetEntry(registry, receivedAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrTokenNotSupported
+	}
+	pmtpCurrentRunningRate := k.Keeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	// Swaps against a pool in batch mode are only priced when the batch is cleared
+	batchPool, batched, err := k.Keeper.GetBatchSwapPool(ctx, sentAsset, receivedAsset)
+	if err != nil {
+		return nil, err
+	}
+	if batched {
+		// The circuit breaker limits the net amount of the batch once it is cleared
+		if err := checkSwapsEnabled(batchPool); err != nil {
+			return nil, err
+		}
+		return &types.SwapQuoteRes{
+			ReceivedAmount:           sdk.ZeroUint(),
+			LiquidityFee:             sdk.ZeroUint(),
+			PriceImpact:              sdk.ZeroUint(),
+			IntermediateNativeAmount: sdk.ZeroUint(),
+			PmtpCurrentRunningRate:   pmtpCurrentRunningRate,
+			Height:                   ctx.BlockHeight(),
+			SwapMode:                 types.SwapMode_SWAP_MODE_BATCH,
+		}, nil
+	}
+	nativeAsset := types.GetSettlementAsset()
+	intermediateAmount := sdk.ZeroUint()
+	liquidityFeeNative := sdk.ZeroUint()
+	priceImpact := sdk.ZeroUint()
+	swapAsset, swapAmount := sentAsset, sentAmount
+	// Same routing as msgServer.Swap, non native to non native swaps go through rowan first
+	if !sentAsset.Equals(nativeAsset) && !receivedAsset.Equals(nativeAsset) {
+		inPool, err := k.Keeper.GetPool(ctx, sentAsset.Symbol)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, sentAsset.String())
+		}
+		if err := k.Keeper.CheckSwapAllowed(ctx, inPool, nativeAsset, sentAmount); err != nil {
+			return nil, err
+		}
+		normalizationFactor, adjustExternalToken := k.Keeper.GetNormalizationFactor(sEntry.Decimals)
+		emitAmount, lp, ts, _, err := SwapOne(sentAsset, sentAmount, nativeAsset, inPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return nil, err
+		}
+		intermediateAmount = emitAmount
+		liquidityFeeNative = lp
+		priceImpact = priceImpact.Add(ts)
+		swapAsset, swapAmount = nativeAsset, emitAmount
+	}
+	outPoolSymbol, decimals := receivedAsset.Symbol, rEntry.Decimals
+	if receivedAsset.Equals(nativeAsset) {
+		outPoolSymbol, decimals = sentAsset.Symbol, sEntry.Decimals
+	}
+	outPool, err := k.Keeper.GetPool(ctx, outPoolSymbol)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, outPoolSymbol)
+	}
+	if err := k.Keeper.CheckSwapAllowed(ctx, outPool, receivedAsset, swapAmount); err != nil {
+		return nil, err
+	}
+	normalizationFactor, adjustExternalToken := k.Keeper.GetNormalizationFactor(decimals)
+	emitAmount, lp, ts, _, err := SwapOne(swapAsset, swapAmount, receivedAsset, outPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	if err != nil {
+		return nil, err
+	}
+	liquidityFee := lp
+	if !liquidityFeeNative.IsZero() {
+		liquidityFee = liquidityFee.Add(GetSwapFee(liquidityFeeNative, receivedAsset, outPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate))
+	}
+	priceImpact = priceImpact.Add(ts)
+	return &types.SwapQuoteRes{
+		ReceivedAmount:           emitAmount,
+		LiquidityFee:             liquidityFee,
+		PriceImpact:              priceImpact,
+		IntermediateNativeAmount: intermediateAmount,
+		PmtpCurrentRunningRate:   pmtpCurrentRunningRate,
+		Height:                   ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetLimitOrdersByOwner(c context.Context, req *types.LimitOrdersByOwnerReq) (*types.LimitOrdersRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+	orders, pageRes, err := k.Keeper.GetLimitOrdersByOwnerPaginated(ctx, owner, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LimitOrdersRes{
+		LimitOrders: orders,
+		Height:      ctx.BlockHeight(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Querier) GetLimitOrdersByPool(c context.Context, req *types.LimitOrdersByPoolReq) (*types.LimitOrdersRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	orders, pageRes, err := k.Keeper.GetLimitOrdersByPoolPaginated(ctx, req.Symbol, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LimitOrdersRes{
+		LimitOrders: orders,
+		Height:      ctx.BlockHeight(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Querier) GetTwap(c context.Context, req *types.TwapReq) (*types.TwapRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if (req.StartHeight == 0) == (req.StartTime == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of start height and start time must be set")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.Keeper.ExistsPool(ctx, req.Symbol) {
+		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, req.Symbol)
+	}
+	var start types.TwapRecord
+	var err error
+	if req.StartHeight != 0 {
+		start, err = k.Keeper.GetTwapRecordAtHeight(ctx, req.Symbol, req.StartHeight)
+	} else {
+		start, err = k.Keeper.GetTwapRecordAtTime(ctx, req.Symbol, req.StartTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+	priceNative, priceExternal, end, err := k.Keeper.CalculateTwap(ctx, req.Symbol, start)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TwapRes{
+		PriceNative:   priceNative,
+		PriceExternal: priceExternal,
+		StartHeight:   start.Height,
+		StartTime:     start.Timestamp,
+		EndHeight:     end.Height,
+		EndTime:       end.Timestamp,
+		Height:        ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetPoolFees(c context.Context, req *types.PoolFeesReq) (*types.PoolFeesRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := k.Keeper.GetPool(ctx, req.Symbol)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, req.Symbol)
+	}
+	return &types.PoolFeesRes{
+		SwapFeeRate:     pool.SwapFeeRateOrZero(),
+		ProtocolFeeRate: k.Keeper.GetSwapFeeParams(ctx).ProtocolFeeRate,
+		Accrual:         k.Keeper.GetPoolFeeAccrual(ctx, req.Symbol),
+		Height:          ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetCircuitBreakerParams(c context.Context, _ *types.CircuitBreakerParamsReq) (*types.CircuitBreakerParamsRes, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.CircuitBreakerParamsRes{
+		Params: k.Keeper.GetCircuitBreakerParams(ctx),
+		Height: ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetPmtpPolicies(c context.Context, req *types.PmtpPoliciesReq) (*types.PmtpPoliciesRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policies, pageRes, err := k.Keeper.GetPmtpPoliciesPaginated(ctx, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PmtpPoliciesRes{
+		Policies:   policies,
+		Height:     ctx.BlockHeight(),
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Querier) SimulatePmtpPolicy(c context.Context, req *types.SimulatePmtpPolicyReq) (*types.SimulatePmtpPolicyRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	params := req.Params
+	if params.PmtpPeriodGovernanceRate.IsNil() {
+		return nil, status.Error(codes.InvalidArgument, "governance rate cannot be empty")
+	}
+	if err := types.ValidatePmtpPeriod(params.PmtpPeriodEpochLength, params.PmtpPeriodStartBlock, params.PmtpPeriodEndBlock); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	numBlocksInPolicyPeriod := params.PmtpPeriodEndBlock - params.PmtpPeriodStartBlock + 1
+	numEpochsInPolicyPeriod := numBlocksInPolicyPeriod / params.PmtpPeriodEpochLength
+	if numEpochsInPolicyPeriod > MaxSimulatedEpochs {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("number of epochs greater than max %d", MaxSimulatedEpochs))
+	}
+	if len(req.Heights) > MaxSimulatedHeights {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("number of heights greater than max %d", MaxSimulatedHeights))
+	}
+	blockRate, err := CalcPmtpBlockRate(params.PmtpPeriodGovernanceRate, numEpochsInPolicyPeriod, numBlocksInPolicyPeriod)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	interPolicyRate := k.Keeper.GetPmtpRateParams(ctx).PmtpInterPolicyRate
+	// Same running rate as BeginBlocker, the rate is flat before the policy starts and after it ends
+	runningRateAt := func(height int64) sdk.Dec {
+		if height < params.PmtpPeriodStartBlock {
+			return interPolicyRate
+		}
+		if height > params.PmtpPeriodEndBlock {
+			height = params.PmtpPeriodEndBlock
+		}
+		return CalcPmtpRunningRate(blockRate, interPolicyRate, height-params.PmtpPeriodStartBlock+1)
+	}
+	epochs := make([]types.PmtpEpochProjection, 0, numEpochsInPolicyPeriod)
+	for epoch := int64(1); epoch <= numEpochsInPolicyPeriod; epoch++ {
+		endHeight := params.PmtpPeriodStartBlock + epoch*params.PmtpPeriodEpochLength - 1
+		epochs = append(epochs, types.PmtpEpochProjection{
+			Epoch:       epoch,
+			EndHeight:   endHeight,
+			RunningRate: runningRateAt(endHeight),
+		})
+	}
+	pools := k.Keeper.GetPools(ctx)
+	heights := make([]types.PmtpHeightProjection, 0, len(req.Heights))
+	for _, height := range req.Heights {
+		runningRate := runningRateAt(height)
+		projection := types.PmtpHeightProjection{
+			Height:      height,
+			RunningRate: runningRate,
+			Pools:       make([]types.PoolPriceProjection, 0, len(pools)),
+		}
+		for _, pool := range pools {
+			swapPriceNative, swapPriceExternal := k.Keeper.CalcPoolSwapPrices(ctx, *pool, runningRate)
+			projection.Pools = append(projection.Pools, types.PoolPriceProjection{
+				Symbol:            pool.ExternalAsset.Symbol,
+				SwapPriceNative:   swapPriceNative,
+				SwapPriceExternal: swapPriceExternal,
+			})
+		}
+		heights = append(heights, projection)
+	}
+	return &types.SimulatePmtpPolicyRes{
+		BlockRate: blockRate,
+		Epochs:    epochs,
+		Heights:   heights,
+		Height:    ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetLiquidityProviderRewards(c context.Context, req *types.LiquidityProviderRewardsReq) (*types.LiquidityProviderRewardsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Symbol == "" || req.LpAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol and liquidity provider address cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	units := sdk.ZeroUint()
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, req.Symbol, req.LpAddress)
+	if err == nil {
+		units = lp.LiquidityProviderUnits
+	}
+	rewards, _ := k.Keeper.ComputeLiquidityProviderRewards(ctx, req.Symbol, req.LpAddress, units)
+	return &types.LiquidityProviderRewardsRes{
+		Rewards: rewards,
+		Pending: rewards.Pending(),
+		Height:  ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetRewardPeriodDistributions(c context.Context, req *types.RewardPeriodDistributionsReq) (*types.RewardPeriodDistributionsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.RewardPeriodId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reward period id cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	accumulators := make([]types.PoolRewardAccumulator, 0)
+	for _, accumulator := range k.Keeper.GetPoolRewardAccumulators(ctx, "") {
+		if accumulator.RewardPeriodId == req.RewardPeriodId {
+			accumulators = append(accumulators, accumulator)
+		}
+	}
+	return &types.RewardPeriodDistributionsRes{
+		Accumulators: accumulators,
+		Height:       ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetRewardPeriodAllocations(c context.Context, req *types.RewardPeriodAllocationsReq) (*types.RewardPeriodAllocationsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	accumulators := k.Keeper.GetPoolRewardAccumulators(ctx, "")
+	allocations := make([]types.RewardPeriodAllocation, 0)
+	for _, period := range k.Keeper.GetRewardsParams(ctx).RewardPeriods {
+		if req.RewardPeriodId != "" && period.RewardPeriodId != req.RewardPeriodId {
+			continue
+		}
+		distributed := sdk.ZeroUint()
+		for _, accumulator := range accumulators {
+			if accumulator.RewardPeriodId == period.RewardPeriodId {
+				distributed = distributed.Add(accumulator.Distributed)
+			}
+		}
+		remaining := sdk.ZeroUint()
+		if period.RewardPeriodAllocation.GT(distributed) {
+			remaining = period.RewardPeriodAllocation.Sub(distributed)
+		}
+		allocation := types.RewardPeriodAllocation{
+			RewardPeriodId: period.RewardPeriodId,
+			Denom:          period.RewardDenom(),
+			FundingSource:  period.RewardPeriodFundingSource,
+			Allocation:     *period.RewardPeriodAllocation,
+			Distributed:    distributed,
+			Remaining:      remaining,
+			Available:      k.Keeper.GetRewardPeriodAvailableFunds(ctx, period),
+		}
+		if escrow, found := k.Keeper.GetRewardEscrow(ctx, period.RewardPeriodId); found {
+			allocation.Escrow = &escrow
+		}
+		allocations = append(allocations, allocation)
+	}
+	if req.RewardPeriodId != "" && len(allocations) == 0 {
+		return nil, status.Error(codes.NotFound, types.ErrRewardPeriodDoesNotExist.Error())
+	}
+	return &types.RewardPeriodAllocationsRes{
+		Allocations: allocations,
+		Height:      ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetPoolHistory(c context.Context, req *types.PoolHistoryReq) (*types.PoolHistoryRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "symbol cannot be empty")
+	}
+	if req.ToHeight > 0 && req.FromHeight > req.ToHeight {
+		return nil, status.Error(codes.InvalidArgument, "from height cannot be after to height")
+	}
+
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	index, found := k.Keeper.GetPoolHistoryIndex(ctx, req.Symbol)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no history for pool %s", req.Symbol))
+	}
+	snapshots, pageRes, err := k.Keeper.GetPoolSnapshotsPaginated(ctx, req.Symbol, req.FromHeight, req.ToHeight, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PoolHistoryRes{
+		Snapshots:   snapshots,
+		FirstHeight: index.FirstHeight,
+		LastHeight:  index.LastHeight,
+		Height:      ctx.BlockHeight(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Querier) GetPoolStats(c context.Context, req *types.PoolStatsReq) (*types.PoolStatsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := k.Keeper.GetPool(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("pool %s not found", req.Symbol))
+	}
+	res := k.Keeper.GetPoolStats(ctx, pool)
+	return &res, nil
+}
+
+func (k Querier) SimulateAddLiquiditySingleSided(c context.Context, req *types.SimulateAddLiquiditySingleSidedReq) (*types.SimulateAddLiquiditySingleSidedRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.SentSymbol != types.GetSettlementAsset().Symbol && req.SentSymbol != req.Symbol {
+		return nil, status.Error(codes.InvalidArgument, "sent symbol must be rowan or the pool symbol")
+	}
+	if req.Amount == (sdk.Uint{}) || req.Amount.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pool, err := k.Keeper.GetPool(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("pool %s not found", req.Symbol))
+	}
+	res, _, _, err := k.Keeper.QuoteAddLiquiditySingleSided(ctx, pool, types.NewAsset(req.SentSymbol), req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &res, nil
+}
+
+func (k Querier) GetPendingPools(c context.Context, req *types.PendingPoolsReq) (*types.PendingPoolsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	pools, pageRes, err := k.Keeper.GetPendingPoolsPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PendingPoolsRes{
+		Pools:      pools,
+		Height:     ctx.BlockHeight(),
+		Pagination: pageRes,
+	}, nil
+}
@@ -5,9 +5,14 @@ import (
 	"errors"
 	"testing"
 
+	sifapp "github.com/Sifchain/sifnode/app"
 	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
 	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -122,3 +127,192 @@ func TestQuerier_GetLiquidityProviders_ReachedLimit(t *testing.T) {
 	_, err := querier.GetLiquidityProviders(ctx, req)
 	require.Error(t, err, errors.New("rpc error: code = InvalidArgument desc = empty request"))
 }
+
+func TestQuerier_SwapQuote(t *testing.T) {
+	var ctx context.Context
+	querier := clpkeeper.Querier{}
+
+	_, err := querier.SwapQuote(ctx, nil)
+	require.Error(t, err, errors.New("rpc error: code = InvalidArgument desc = empty request"))
+}
+
+func TestQuerier_SwapQuote_MatchesSwap(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	poolDepth := sdk.NewUint(1000000000000)
+	testcases := []struct {
+		name                string
+		sentAsset           string
+		receivedAsset       string
+		sentAmount          string
+		doubleSwap          bool
+		pmtpRunningRate     sdk.Dec
+		swapsPausedPool     string
+		batchPool           string
+		expectedErr         error
+		expectedErrContains string
+	}{
+		{
+			name:            "external to native",
+			sentAsset:       "ceth",
+			receivedAsset:   "rowan",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.ZeroDec(),
+		},
+		{
+			name:            "native to external with pmtp running rate",
+			sentAsset:       "rowan",
+			receivedAsset:   "cusdc",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:            "double swap",
+			sentAsset:       "ceth",
+			receivedAsset:   "cusdc",
+			sentAmount:      "1000000",
+			doubleSwap:      true,
+			pmtpRunningRate: sdk.MustNewDecFromStr("0.5"),
+		},
+		{
+			name:            "swaps paused",
+			sentAsset:       "rowan",
+			receivedAsset:   "cusdc",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.ZeroDec(),
+			swapsPausedPool: "cusdc",
+			expectedErr:     types.ErrPoolPaused,
+		},
+		{
+			name:            "double swap with swaps of the first pool paused",
+			sentAsset:       "ceth",
+			receivedAsset:   "cusdc",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.ZeroDec(),
+			swapsPausedPool: "ceth",
+			expectedErr:     types.ErrPoolPaused,
+		},
+		{
+			name:            "batch mode",
+			sentAsset:       "ceth",
+			receivedAsset:   "rowan",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.ZeroDec(),
+			batchPool:       "ceth",
+		},
+		{
+			name:            "double swap through a pool in batch mode",
+			sentAsset:       "ceth",
+			receivedAsset:   "cusdc",
+			sentAmount:      "1000000",
+			pmtpRunningRate: sdk.ZeroDec(),
+			batchPool:       "cusdc",
+			expectedErr:     types.ErrBatchSwapNotSupported,
+		},
+		{
+			name:                "invalid amount",
+			sentAsset:           "ceth",
+			receivedAsset:       "cusdc",
+			sentAmount:          "abc",
+			expectedErrContains: "invalid sent amount",
+		},
+		{
+			name:          "token not supported",
+			sentAsset:     "ceth",
+			receivedAsset: "xxx",
+			sentAmount:    "1000000",
+			expectedErr:   types.ErrTokenNotSupported,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+				entries := []*tokenregistrytypes.RegistryEntry{}
+				for _, symbol := range []string{"rowan", "ceth", "cusdc"} {
+					entries = append(entries, &tokenregistrytypes.RegistryEntry{Denom: symbol, BaseDenom: symbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+				}
+				trGs := &tokenregistrytypes.GenesisState{
+					AdminAccounts: test.GetAdmins(address),
+					Registry:      &tokenregistrytypes.Registry{Entries: entries},
+				}
+				bz, _ := app.AppCodec().MarshalJSON(trGs)
+				genesisState["tokenregistry"] = bz
+
+				clpGs := types.DefaultGenesisState()
+				for _, symbol := range []string{"ceth", "cusdc"} {
+					swapMode := types.SwapMode_SWAP_MODE_SEQUENTIAL
+					if symbol == tc.batchPool {
+						swapMode = types.SwapMode_SWAP_MODE_BATCH
+					}
+					clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+						ExternalAsset:        &types.Asset{Symbol: symbol},
+						NativeAssetBalance:   poolDepth,
+						ExternalAssetBalance: poolDepth,
+						PoolUnits:            poolDepth,
+						SwapsPaused:          symbol == tc.swapsPausedPool,
+						SwapMode:             swapMode,
+					})
+					clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, &types.LiquidityProvider{
+						Asset:                    &types.Asset{Symbol: symbol},
+						LiquidityProviderUnits:   poolDepth,
+						LiquidityProviderAddress: address,
+					})
+				}
+				bz, _ = app.AppCodec().MarshalJSON(clpGs)
+				genesisState["clp"] = bz
+
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances,
+					banktypes.Balance{
+						Address: address,
+						Coins:   sdk.NewCoins(sdk.NewCoin("ceth", sdk.NewInt(1000000)), sdk.NewCoin("rowan", sdk.NewInt(1000000))),
+					},
+					test.GetPoolsModuleBalance(clpGs.PoolList),
+				)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
+				return genesisState
+			})
+			app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, tc.pmtpRunningRate)
+			querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+
+			quote, err := querier.SwapQuote(sdk.WrapSDKContext(ctx), &types.SwapQuoteReq{
+				SentAsset:     tc.sentAsset,
+				ReceivedAsset: tc.receivedAsset,
+				SentAmount:    tc.sentAmount,
+			})
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			if tc.expectedErrContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErrContains)
+				return
+			}
+			require.NoError(t, err)
+			if tc.batchPool != "" {
+				// Batched swaps are priced when the batch is cleared
+				require.Equal(t, types.SwapMode_SWAP_MODE_BATCH, quote.SwapMode)
+				require.True(t, quote.ReceivedAmount.IsZero())
+				return
+			}
+			require.Equal(t, types.SwapMode_SWAP_MODE_SEQUENTIAL, quote.SwapMode)
+			require.Equal(t, tc.doubleSwap, !quote.IntermediateNativeAmount.IsZero())
+
+			signer, _ := sdk.AccAddressFromBech32(address)
+			balanceBefore := app.BankKeeper.GetBalance(ctx, signer, tc.receivedAsset).Amount
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+			_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &types.MsgSwap{
+				Signer:             address,
+				SentAsset:          &types.Asset{Symbol: tc.sentAsset},
+				ReceivedAsset:      &types.Asset{Symbol: tc.receivedAsset},
+				SentAmount:         sdk.NewUintFromString(tc.sentAmount),
+				MinReceivingAmount: quote.ReceivedAmount,
+			})
+			require.NoError(t, err)
+			received := app.BankKeeper.GetBalance(ctx, signer, tc.receivedAsset).Amount.Sub(balanceBefore)
+			require.Equal(t, quote.ReceivedAmount.String(), received.String())
+		})
+	}
+}
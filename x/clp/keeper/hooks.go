@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// Implements ClpHooks interface
+var _ types.ClpHooks = Keeper{}
+
+// SetHooks sets the clp hooks, it can only be called once
+func (k *Keeper) SetHooks(hooks types.ClpHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set clp hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// AfterSwap - call hook if registered
+func (k Keeper) AfterSwap(ctx sdk.Context, swapper sdk.AccAddress, pool types.Pool, sentCoin sdk.Coin, receivedCoin sdk.Coin, liquidityFee sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, swapper, pool, sentCoin, receivedCoin, liquidityFee)
+	}
+}
+
+// AfterAddLiquidity - call hook if registered
+func (k Keeper) AfterAddLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool types.Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint) {
+	if k.hooks != nil {
+		k.hooks.AfterAddLiquidity(ctx, provider, pool, nativeAmount, externalAmount, lpUnits)
+	}
+}
+
+// AfterRemoveLiquidity - call hook if registered
+func (k Keeper) AfterRemoveLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool types.Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint) {
+	if k.hooks != nil {
+		k.hooks.AfterRemoveLiquidity(ctx, provider, pool, nativeAmount, externalAmount, lpUnits)
+	}
+}
+
+// AfterPoolCreated - call hook if registered
+func (k Keeper) AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool types.Pool) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolCreated(ctx, creator, pool)
+	}
+}
+
+// BeforePoolDecommissioned - call hook if registered
+func (k Keeper) BeforePoolDecommissioned(ctx sdk.Context, pool types.Pool) {
+	if k.hooks != nil {
+		k.hooks.BeforePoolDecommissioned(ctx, pool)
+	}
+}
+
+// AfterPoolDecommissioned - call hook if registered
+func (k Keeper) AfterPoolDecommissioned(ctx sdk.Context, pool types.Pool) {
+	if k.hooks != nil {
+		k.hooks.AfterPoolDecommissioned(ctx, pool)
+	}
+}
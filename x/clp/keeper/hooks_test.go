@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHooks struct {
+	calls []string
+	swaps []sdk.Coin
+}
+
+func (h *recordingHooks) AfterSwap(_ sdk.Context, _ sdk.AccAddress, _ types.Pool, sentCoin sdk.Coin, receivedCoin sdk.Coin, _ sdk.Coin) {
+	h.calls = append(h.calls, "swap")
+	h.swaps = append(h.swaps, sentCoin, receivedCoin)
+}
+
+func (h *recordingHooks) AfterAddLiquidity(_ sdk.Context, _ sdk.AccAddress, _ types.Pool, _ sdk.Uint, _ sdk.Uint, _ sdk.Uint) {
+	h.calls = append(h.calls, "add")
+}
+
+func (h *recordingHooks) AfterRemoveLiquidity(_ sdk.Context, _ sdk.AccAddress, _ types.Pool, _ sdk.Uint, _ sdk.Uint, _ sdk.Uint) {
+	h.calls = append(h.calls, "remove")
+}
+
+func (h *recordingHooks) AfterPoolCreated(_ sdk.Context, _ sdk.AccAddress, _ types.Pool) {
+	h.calls = append(h.calls, "create")
+}
+
+func (h *recordingHooks) BeforePoolDecommissioned(_ sdk.Context, _ types.Pool) {
+	h.calls = append(h.calls, "settle")
+}
+
+func (h *recordingHooks) AfterPoolDecommissioned(_ sdk.Context, _ types.Pool) {
+	h.calls = append(h.calls, "decommission")
+}
+
+func TestKeeper_Hooks(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(10)
+	params := types.GetDefaultRewardParams()
+	params.LiquidityRemovalLockPeriod = 0
+	app.ClpKeeper.SetRewardParams(ctx, params)
+	hooks := &recordingHooks{}
+	keeper := clpkeeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.BankKeeper, app.AccountKeeper,
+		app.TokenRegistryKeeper, app.MintKeeper, app.GetSubspace(types.ModuleName))
+	keeper.SetHooks(types.NewMultiClpHooks(hooks))
+	require.Panics(t, func() { keeper.SetHooks(hooks) })
+	msgServer := clpkeeper.NewMsgServerImpl(keeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+
+	swapMsg := types.NewMsgSwap(signer, rowan, eth, sdk.NewUint(100000), sdk.ZeroUint())
+	_, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"swap"}, hooks.calls)
+	require.Equal(t, "100000rowan", hooks.swaps[0].String())
+	require.Equal(t, "ceth", hooks.swaps[1].Denom)
+
+	addMsg := types.NewMsgAddLiquidity(signer, eth, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+	lp, err := keeper.GetLiquidityProvider(ctx, "ceth", address)
+	require.NoError(t, err)
+	lp.Unlocks = []*types.LiquidityUnlock{{RequestHeight: 1, Units: lp.LiquidityProviderUnits}}
+	keeper.SetLiquidityProvider(ctx, &lp)
+	removeMsg := types.NewMsgRemoveLiquidity(signer, eth, sdk.NewInt(10000), sdk.NewInt(0))
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeMsg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"swap", "add", "remove"}, hooks.calls)
+
+	pool, err := keeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	err = keeper.DecommissionPool(ctx, pool)
+	require.NoError(t, err)
+	require.Equal(t, []string{"swap", "add", "remove", "decommission"}, hooks.calls)
+
+	funds := sdk.NewCoins(sdk.NewCoin(rowan.Symbol, sdk.NewInt(1000000)), sdk.NewCoin(eth.Symbol, sdk.NewInt(1000000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, funds))
+	createMsg := types.NewMsgCreatePool(signer, eth, sdk.NewUint(1000000), sdk.NewUint(1000000))
+	_, err = keeper.CreatePool(ctx, sdk.NewUint(1000000), &createMsg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"swap", "add", "remove", "decommission", "create"}, hooks.calls)
+}
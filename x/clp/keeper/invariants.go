@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInvariants registers all clp invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-units", PoolUnitsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "liquidity-unlocks", LiquidityUnlocksInvariant(k))
+}
+
+// AllInvariants runs all invariants of the clp module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = PoolUnitsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return LiquidityUnlocksInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the clp module account holds exactly the pool balances, the sent amounts of
+// open limit orders, the reward escrow balances and the liquidity mining rewards distributed but not yet claimed
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		add := func(denom string, amount sdk.Uint) {
+			expected = expected.Add(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount.BigInt())))
+		}
+		for _, pool := range k.GetPools(ctx) {
+			add(types.GetSettlementAsset().Symbol, pool.NativeAssetBalance)
+			add(pool.ExternalAsset.Symbol, pool.ExternalAssetBalance)
+		}
+		for _, order := range k.GetLimitOrders(ctx) {
+			add(order.SentAsset.Symbol, order.SentAmount)
+		}
+		for _, swap := range k.GetQueuedSwaps(ctx) {
+			add(swap.SentAsset.Symbol, swap.SentAmount)
+		}
+		for _, escrow := range k.GetRewardEscrows(ctx) {
+			add(escrow.Denom, escrow.Balance)
+		}
+		for _, accumulator := range k.GetPoolRewardAccumulators(ctx, "") {
+			add(accumulator.Denom, accumulator.Distributed)
+		}
+		claimed := sdk.NewCoins()
+		for _, rewards := range k.GetAllLiquidityProviderRewards(ctx) {
+			for _, period := range rewards.Periods {
+				claimed = claimed.Add(sdk.NewCoin(period.Denom, sdk.NewIntFromBigInt(period.Claimed.BigInt())))
+			}
+		}
+		expected, negative := expected.SafeSub(claimed)
+		if negative {
+			return sdk.FormatInvariant(types.ModuleName, "module-balance",
+				fmt.Sprintf("claimed rewards %s exceed the distributed rewards", claimed)), true
+		}
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		broken := !balance.IsEqual(expected)
+		return sdk.FormatInvariant(types.ModuleName, "module-balance",
+			fmt.Sprintf("\tclp module balance: %s\n\texpected balance: %s\n", balance, expected)), broken
+	}
+}
+
+// PoolUnitsInvariant checks that the units of every pool are the supply of its liquidity provider tokens, which only
+// the clp module mints and burns. Liquidity providers records follow the tokens their holders send each other.
+func PoolUnitsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		for _, pool := range k.GetPools(ctx) {
+			supply := k.bankKeeper.GetSupply(ctx, types.GetLiquidityProviderTokenDenom(pool.ExternalAsset.Symbol))
+			if !supply.Amount.Equal(sdk.NewIntFromBigInt(pool.PoolUnits.BigInt())) {
+				broken = true
+				msg += fmt.Sprintf("\tpool %s has %s units but %s liquidity provider tokens are in supply\n", pool.ExternalAsset.Symbol, pool.PoolUnits, supply.Amount)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "pool-units", msg), broken
+	}
+}
+
+// LiquidityUnlocksInvariant checks that no liquidity provider requested to unlock more units than it holds
+func LiquidityUnlocksInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		iterator := k.GetLiquidityProviderIterator(ctx)
+		defer func(iterator sdk.Iterator) {
+			err := iterator.Close()
+			if err != nil {
+				panic(err)
+			}
+		}(iterator)
+		for ; iterator.Valid(); iterator.Next() {
+			var lp types.LiquidityProvider
+			k.cdc.MustUnmarshal(iterator.Value(), &lp)
+			unlocked := sdk.ZeroUint()
+			for _, unlock := range lp.Unlocks {
+				unlocked = unlocked.Add(unlock.Units)
+			}
+			if unlocked.GT(lp.LiquidityProviderUnits) {
+				broken = true
+				msg += fmt.Sprintf("\tliquidity provider %s of pool %s unlocks %s units but holds %s\n",
+					lp.LiquidityProviderAddress, lp.Asset.Symbol, unlocked, lp.LiquidityProviderUnits)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "liquidity-unlocks", msg), broken
+	}
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_Invariants(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+	invariants := clpkeeper.AllInvariants(app.ClpKeeper)
+
+	msg, broken := invariants(ctx)
+	require.False(t, broken, msg)
+
+	addMsg := types.NewMsgAddLiquidity(signer, eth, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+	swapMsg := types.NewMsgSwap(signer, eth, rowan, sdk.NewUint(50000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	orderMsg := types.NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(1000000), sdk.NewDec(2), 0)
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &orderMsg)
+	require.NoError(t, err)
+	allocation := sdk.NewUint(10000000000000)
+	oneDec := sdk.OneDec()
+	period := &types.RewardPeriod{RewardPeriodId: "RP1", RewardPeriodStartBlock: 1, RewardPeriodEndBlock: 10, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec}
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, app.ClpKeeper.DistributeDepthRewards(ctx, period, app.ClpKeeper.GetPools(ctx)))
+	claimMsg := types.NewMsgClaimRewards(signer, eth)
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &claimMsg)
+	require.NoError(t, err)
+	msg, broken = invariants(ctx)
+	require.False(t, broken, msg)
+
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", address)
+	require.NoError(t, err)
+	lp.Unlocks = []*types.LiquidityUnlock{{RequestHeight: 1, Units: lp.LiquidityProviderUnits.AddUint64(1)}}
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	_, broken = clpkeeper.LiquidityUnlocksInvariant(app.ClpKeeper)(ctx)
+	require.True(t, broken)
+
+	// Liquidity provider tokens sent around do not change the supply
+	lpTokens := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), sdk.NewInt(1000)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, signer, sdk.AccAddress("receiver____________"), lpTokens))
+	_, broken = clpkeeper.PoolUnitsInvariant(app.ClpKeeper)(ctx)
+	require.False(t, broken)
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	pool.PoolUnits = pool.PoolUnits.AddUint64(1)
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, broken = clpkeeper.PoolUnitsInvariant(app.ClpKeeper)(ctx)
+	require.True(t, broken)
+
+	pool.NativeAssetBalance = pool.NativeAssetBalance.AddUint64(1)
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, broken = clpkeeper.ModuleBalanceInvariant(app.ClpKeeper)(ctx)
+	require.True(t, broken)
+}
@@ -22,6 +22,7 @@ type Keeper struct {
 	tokenRegistryKeeper types.TokenRegistryKeeper
 	mintKeeper          mintkeeper.Keeper
 	paramstore          paramtypes.Subspace
+	hooks               types.ClpHooks
 }
 
 // NewKeeper creates a clp keeper
@@ -97,3 +98,16 @@ func (k Keeper) GetNormalizationFactorFromAsset(ctx sdk.Context, asset types.Ass
 	}
 	return k.GetNormalizationFactor(registryEntry.Decimals)
 }
+
+// ApplyCached runs fn in a cached context of ctx, its state changes are written and its events emitted only when it
+// succeeds. Begin and end blockers process each item this way so that a single failing item does not halt the chain.
+func ApplyCached(ctx sdk.Context, fn func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	err := fn(cacheCtx)
+	if err != nil {
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
@@ -0,0 +1,318 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// SetLimitOrder stores a limit order along with its price and expiry indexes
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order *types.LimitOrder) {
+	if !order.Validate() {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLimitOrderKey(order.Id), k.cdc.MustMarshal(order))
+	store.Set(types.GetLimitOrderPriceKey(*order), []byte{})
+	if order.ExpiryHeight > 0 {
+		store.Set(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id), []byte{})
+	}
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, error) {
+	var order types.LimitOrder
+	key := types.GetLimitOrderKey(id)
+	if !k.Exists(ctx, key) {
+		return order, types.ErrLimitOrderDoesNotExist
+	}
+	store := ctx.KVStore(k.storeKey)
+	k.cdc.MustUnmarshal(store.Get(key), &order)
+	return order, nil
+}
+
+// DestroyLimitOrder removes a limit order and its indexes
+func (k Keeper) DestroyLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(order.Id))
+	store.Delete(types.GetLimitOrderPriceKey(order))
+	if order.ExpiryHeight > 0 {
+		store.Delete(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id))
+	}
+}
+
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LimitOrderNextIDPrefix)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LimitOrderNextIDPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetLimitOrderIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.LimitOrderPrefix)
+}
+
+// GetLimitOrders Use GetLimitOrdersByOwnerPaginated or GetLimitOrdersByPoolPaginated for RPC queries
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []*types.LimitOrder {
+	var orders []*types.LimitOrder
+	iterator := k.GetLimitOrderIterator(ctx)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &order)
+		orders = append(orders, &order)
+	}
+	return orders
+}
+
+func (k Keeper) GetLimitOrdersByOwnerPaginated(ctx sdk.Context, owner sdk.AccAddress, pagination *query.PageRequest) ([]*types.LimitOrder, *query.PageResponse, error) {
+	return k.getLimitOrdersFilteredPaginated(ctx, pagination, func(order types.LimitOrder) bool {
+		return order.Owner == owner.String()
+	})
+}
+
+func (k Keeper) GetLimitOrdersByPoolPaginated(ctx sdk.Context, symbol string, pagination *query.PageRequest) ([]*types.LimitOrder, *query.PageResponse, error) {
+	return k.getLimitOrdersFilteredPaginated(ctx, pagination, func(order types.LimitOrder) bool {
+		return order.PoolAsset.Symbol == symbol
+	})
+}
+
+func (k Keeper) getLimitOrdersFilteredPaginated(ctx sdk.Context, pagination *query.PageRequest, filter func(order types.LimitOrder) bool) ([]*types.LimitOrder, *query.PageResponse, error) {
+	var orders []*types.LimitOrder
+	store := ctx.KVStore(k.storeKey)
+	orderStore := prefix.NewStore(store, types.LimitOrderPrefix)
+	pageRes, err := query.FilteredPaginate(orderStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var order types.LimitOrder
+		err := k.cdc.Unmarshal(value, &order)
+		if err != nil {
+			return false, err
+		}
+		if !filter(order) {
+			return false, nil
+		}
+		if accumulate {
+			orders = append(orders, &order)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return orders, pageRes, nil
+}
+
+// PlaceLimitOrder escrows the sent asset of the order in the clp module account and stores the order
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, msg *types.MsgPlaceLimitOrder) (types.LimitOrder, error) {
+	poolAsset := *msg.SentAsset
+	if poolAsset.Equals(types.GetSettlementAsset()) {
+		poolAsset = *msg.ReceivedAsset
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	sentAmountInt, ok := k.ParseToInt(msg.SentAmount.String())
+	if !ok {
+		return types.LimitOrder{}, types.ErrUnableToParseInt
+	}
+	err = k.InitiateSwap(ctx, sdk.NewCoin(msg.SentAsset.Symbol, sentAmountInt), owner)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	id := k.GetNextLimitOrderID(ctx)
+	k.SetNextLimitOrderID(ctx, id+1)
+	order := types.LimitOrder{
+		Id:           id,
+		Owner:        msg.Signer,
+		PoolAsset:    &poolAsset,
+		SentAsset:    msg.SentAsset,
+		SentAmount:   msg.SentAmount,
+		LimitPrice:   msg.LimitPrice,
+		ExpiryHeight: msg.ExpiryHeight,
+		PlacedHeight: ctx.BlockHeight(),
+	}
+	k.SetLimitOrder(ctx, &order)
+	return order, nil
+}
+
+// RefundLimitOrder returns the escrowed asset of an order to its owner and removes the order
+func (k Keeper) RefundLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+	sentAmountInt, ok := k.ParseToInt(order.SentAmount.String())
+	if !ok {
+		return types.ErrUnableToParseInt
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(order.SentAsset.Symbol, sentAmountInt)))
+	if err != nil {
+		return err
+	}
+	k.DestroyLimitOrder(ctx, order)
+	return nil
+}
+
+// ExecuteLimitOrders fills every open order whose limit price is reached by the current pool swap price.
+// Orders of a pool side are filled in ascending limit price and the swap price is recomputed after every fill.
+func (k Keeper) ExecuteLimitOrders(ctx sdk.Context, pmtpCurrentRunningRate sdk.Dec) {
+	for _, pool := range k.GetPools(ctx) {
+		normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+		for _, sentAsset := range []types.Asset{types.GetSettlementAsset(), *pool.ExternalAsset} {
+			k.executeLimitOrdersForPoolSide(ctx, *pool.ExternalAsset, sentAsset, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		}
+	}
+}
+
+func (k Keeper) executeLimitOrdersForPoolSide(ctx sdk.Context, poolAsset types.Asset, sentAsset types.Asset, normalizationFactor sdk.Dec, adjustExternalToken bool, pmtpCurrentRunningRate sdk.Dec) {
+	// Orders against a pool in batch swap mode stay open until the pool swaps sequentially again
+	pool, err := k.GetPool(ctx, poolAsset.Symbol)
+	if err != nil || checkSwapsEnabled(pool) != nil || checkSequentialSwaps(pool) != nil {
+		return
+	}
+	receivedAsset := types.GetSettlementAsset()
+	if sentAsset.Equals(types.GetSettlementAsset()) {
+		receivedAsset = poolAsset
+	}
+	// Collect candidates first, the store cannot be written to while iterating
+	price := CalcSwapPrice(sentAsset, sdk.OneUint(), receivedAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	var candidates []types.LimitOrder
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetLimitOrderPoolSidePrefix(poolAsset.Symbol, sentAsset.Symbol))
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		order, err := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			continue
+		}
+		if order.LimitPrice.GT(price) {
+			break
+		}
+		candidates = append(candidates, order)
+	}
+	err = iterator.Close()
+	if err != nil {
+		panic(err)
+	}
+	for _, order := range candidates {
+		price = CalcSwapPrice(sentAsset, sdk.OneUint(), receivedAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		// Every fill moves the price against the remaining orders of this side
+		if order.LimitPrice.GT(price) {
+			return
+		}
+		var swappedPool types.Pool
+		var swapResult sdk.Uint
+		err := ApplyCached(ctx, func(ctx sdk.Context) error {
+			var err error
+			swappedPool, swapResult, err = k.fillLimitOrder(ctx, order, pool, receivedAsset, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+			return err
+		})
+		if errors.Is(err, types.ErrReceivedAmountBelowExpected) {
+			// The slippage of the order itself keeps it below its limit price, it stays open
+			continue
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to execute limit order %d : %s", order.Id, err.Error()))
+			continue
+		}
+		pool = swappedPool
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExecuteLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+			sdk.NewAttribute(types.AttributeKeySwapAmount, swapResult.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+}
+
+func (k Keeper) fillLimitOrder(ctx sdk.Context, order types.LimitOrder, pool types.Pool, receivedAsset types.Asset, normalizationFactor sdk.Dec, adjustExternalToken bool, pmtpCurrentRunningRate sdk.Dec) (types.Pool, sdk.Uint, error) {
+	err := k.CheckSwapAllowed(ctx, pool, receivedAsset, order.SentAmount)
+	if err != nil {
+		return types.Pool{}, sdk.Uint{}, err
+	}
+	swapResult, liquidityFee, swapFee, _, swappedPool, err := SwapOneWithSwapFee(*order.SentAsset, order.SentAmount, receivedAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	if err != nil {
+		return types.Pool{}, sdk.Uint{}, err
+	}
+	// The limit price bounds the average price of the fill, not only the swap price of the pool before it
+	minReceivedAmount := sdk.NewDecFromBigInt(order.SentAmount.BigInt()).Mul(order.LimitPrice)
+	if sdk.NewDecFromBigInt(swapResult.BigInt()).LT(minReceivedAmount) {
+		return types.Pool{}, sdk.Uint{}, sdkerrors.Wrap(types.ErrReceivedAmountBelowExpected, fmt.Sprintf("swap result %s below %s", swapResult, minReceivedAmount))
+	}
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return types.Pool{}, sdk.Uint{}, err
+	}
+	err = k.SettleSwap(ctx, owner, &swappedPool, *order.SentAsset, order.SentAmount, receivedAsset, swapResult, liquidityFee, swapFee)
+	if err != nil {
+		return types.Pool{}, sdk.Uint{}, err
+	}
+	swapResultInt, ok := k.ParseToInt(swapResult.String())
+	if !ok {
+		return types.Pool{}, sdk.Uint{}, types.ErrUnableToParseInt
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(receivedAsset.Symbol, swapResultInt)))
+	if err != nil {
+		return types.Pool{}, sdk.Uint{}, err
+	}
+	k.DestroyLimitOrder(ctx, order)
+	return swappedPool, swapResult, nil
+}
+
+// ExpireLimitOrders refunds every open order whose expiry height has been reached, an order failing to be refunded
+// stays open and is retried at the next block
+func (k Keeper) ExpireLimitOrders(ctx sdk.Context) error {
+	var expired []uint64
+	store := ctx.KVStore(k.storeKey)
+	end := append(types.LimitOrderExpiryPrefix, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1))...)
+	iterator := store.Iterator(types.LimitOrderExpiryPrefix, end)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		expired = append(expired, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	err := iterator.Close()
+	if err != nil {
+		return err
+	}
+	for _, id := range expired {
+		order, err := k.GetLimitOrder(ctx, id)
+		if err != nil {
+			return err
+		}
+		err = ApplyCached(ctx, func(ctx sdk.Context) error {
+			return k.RefundLimitOrder(ctx, order)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to refund expired limit order %d : %s", order.Id, err.Error()))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpireLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+	return nil
+}
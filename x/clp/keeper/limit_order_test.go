@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createLimitOrderTestApp(t *testing.T, address string) (sdk.Context, *sifapp.SifchainApp) {
+	return test.CreateTestAppWithPool(address,
+		sdk.NewCoins(sdk.NewCoin("ceth", sdk.NewInt(3000000)), sdk.NewCoin("rowan", sdk.NewInt(1000000))),
+		[]*tokenregistrytypes.RegistryEntry{
+			{Denom: "ceth", BaseDenom: "ceth", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+			{Denom: "rowan", BaseDenom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+		})
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestKeeper_ExecuteLimitOrders(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+
+	reachable := types.NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(1000000), sdk.MustNewDecFromStr("0.5"), 0)
+	res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &reachable)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+	unreachable := types.NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(1000000), sdk.NewDec(2), 0)
+	res, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &unreachable)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Id)
+	require.Equal(t, sdk.NewInt(1000000), app.BankKeeper.GetBalance(ctx, signer, "ceth").Amount)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+	// The transfers of the fill are emitted along with the execution of the order
+	require.Equal(t, 1, countEvents(ctx, banktypes.EventTypeTransfer))
+
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, 1)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, 2)
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount.GT(sdk.NewInt(1000000)))
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(1000001000000), pool.ExternalAssetBalance)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_ExecuteLimitOrders_Slippage(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	funds := sdk.NewCoins(sdk.NewCoin("ceth", sdk.NewInt(100000000000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, funds))
+
+	// The swap price of the pool reaches the limit price but the order moves it below
+	msg := types.NewMsgPlaceLimitOrder(signer, types.NewAsset("ceth"), types.GetSettlementAsset(), sdk.NewUint(100000000000), sdk.MustNewDecFromStr("0.95"), 0)
+	res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	app.ClpKeeper.ExecuteLimitOrders(ctx, sdk.ZeroDec())
+
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000000), app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount)
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(1000000000000), pool.ExternalAssetBalance)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_CancelLimitOrder(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+
+	msg := types.NewMsgPlaceLimitOrder(signer, types.NewAsset("ceth"), types.GetSettlementAsset(), sdk.NewUint(1000000), sdk.NewDec(2), 0)
+	res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	other := types.NewMsgCancelLimitOrder(sdk.AccAddress("addr2_______________"), res.Id)
+	_, err = msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), &other)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+
+	cancel := types.NewMsgCancelLimitOrder(signer, res.Id)
+	_, err = msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), &cancel)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3000000), app.BankKeeper.GetBalance(ctx, signer, "ceth").Amount)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+
+	_, err = msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), &cancel)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_ExpireLimitOrders(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	ctx = ctx.WithBlockHeight(10)
+
+	expired := types.NewMsgPlaceLimitOrder(signer, types.GetSettlementAsset(), types.NewAsset("ceth"), sdk.NewUint(1000000), sdk.NewDec(2), 5)
+	_, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &expired)
+	require.ErrorIs(t, err, types.ErrInvalidExpiryHeight)
+
+	msg := types.NewMsgPlaceLimitOrder(signer, types.GetSettlementAsset(), types.NewAsset("ceth"), sdk.NewUint(1000000), sdk.NewDec(2), 11)
+	res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroInt(), app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount)
+
+	err = app.ClpKeeper.ExpireLimitOrders(ctx)
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	err = app.ClpKeeper.ExpireLimitOrders(ctx)
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+	require.Equal(t, sdk.NewInt(1000000), app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount)
+	require.Equal(t, 1, countEvents(ctx, banktypes.EventTypeTransfer))
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_ExpireLimitOrders_RefundFails(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	ctx = ctx.WithBlockHeight(10)
+
+	// The module account holds none of the sent asset of this order
+	unfunded := types.LimitOrder{
+		Id:           app.ClpKeeper.GetNextLimitOrderID(ctx),
+		Owner:        address,
+		PoolAsset:    &types.Asset{Symbol: "cusdc"},
+		SentAsset:    &types.Asset{Symbol: "cusdc"},
+		SentAmount:   sdk.NewUint(1000000),
+		LimitPrice:   sdk.NewDec(2),
+		ExpiryHeight: 11,
+		PlacedHeight: ctx.BlockHeight(),
+	}
+	app.ClpKeeper.SetLimitOrder(ctx, &unfunded)
+	app.ClpKeeper.SetNextLimitOrderID(ctx, unfunded.Id+1)
+	msg := types.NewMsgPlaceLimitOrder(signer, types.GetSettlementAsset(), types.NewAsset("ceth"), sdk.NewUint(1000000), sdk.NewDec(2), 11)
+	res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	err = app.ClpKeeper.ExpireLimitOrders(ctx)
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, unfunded.Id)
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderDoesNotExist)
+	require.Equal(t, sdk.NewInt(1000000), app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount)
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/Sifchain/sifnode/x/clp/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,6 +19,14 @@ func (k Keeper) SetLiquidityProvider(ctx sdk.Context, lp *types.LiquidityProvide
 	}
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetLiquidityProviderKey(lp.Asset.Symbol, lp.LiquidityProviderAddress)
+	// Rewards accrue on the units held until now
+	units := sdk.ZeroUint()
+	if bz := store.Get(key); bz != nil {
+		var stored types.LiquidityProvider
+		k.cdc.MustUnmarshal(bz, &stored)
+		units = stored.LiquidityProviderUnits
+	}
+	k.SettleLiquidityProviderRewards(ctx, lp.Asset.Symbol, lp.LiquidityProviderAddress, units)
 	store.Set(key, k.cdc.MustMarshal(lp))
 }
 
@@ -69,13 +80,94 @@ func (k Keeper) GetAssetsForLiquidityProviderPaginated(ctx sdk.Context, lpAddres
 
 func (k Keeper) DestroyLiquidityProvider(ctx sdk.Context, symbol string, lpAddress string) {
 	key := types.GetLiquidityProviderKey(symbol, lpAddress)
-	if !k.Exists(ctx, key) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
 		return
 	}
-	store := ctx.KVStore(k.storeKey)
+	// The rewards outlive the liquidity provider so that they can still be claimed
+	var lp types.LiquidityProvider
+	k.cdc.MustUnmarshal(bz, &lp)
+	k.SettleLiquidityProviderRewards(ctx, symbol, lpAddress, lp.LiquidityProviderUnits)
 	store.Delete(key)
 }
 
+// SyncLiquidityProvider sets the units of the liquidity provider lpAddress of the pool of symbol to the liquidity
+// provider tokens it holds, creating its record for tokens it received. It runs after every transfer of the tokens
+// outside the clp module, so that units sent away by bank transfer or ibc can only be redeemed by their holder.
+func (k Keeper) SyncLiquidityProvider(ctx sdk.Context, symbol string, lpAddress string) (types.LiquidityProvider, error) {
+	addr, err := sdk.AccAddressFromBech32(lpAddress)
+	if err != nil {
+		return types.LiquidityProvider{}, err
+	}
+	held := sdk.NewUintFromBigInt(k.bankKeeper.GetBalance(ctx, addr, types.GetLiquidityProviderTokenDenom(symbol)).Amount.BigInt())
+	lp, err := k.GetLiquidityProvider(ctx, symbol, lpAddress)
+	if err != nil {
+		if held.IsZero() {
+			return lp, types.ErrLiquidityProviderDoesNotExist
+		}
+		asset := types.NewAsset(symbol)
+		lp = k.CreateLiquidityProvider(ctx, &asset, held, addr)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCreateLiquidityProvider,
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, lp.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+		return lp, nil
+	}
+	if lp.LiquidityProviderUnits.Equal(held) {
+		return lp, nil
+	}
+	if held.IsZero() {
+		k.DestroyLiquidityProvider(ctx, symbol, lpAddress)
+		return types.LiquidityProvider{}, types.ErrLiquidityProviderDoesNotExist
+	}
+	lp.LiquidityProviderUnits = held
+	// Pending unlocks cannot exceed the units left, the most recent ones are dropped first
+	unlocks := make([]*types.LiquidityUnlock, 0, len(lp.Unlocks))
+	unlockable := held
+	for _, unlock := range lp.Unlocks {
+		if unlockable.IsZero() {
+			break
+		}
+		units := sdk.MinUint(unlock.Units, unlockable)
+		unlocks = append(unlocks, &types.LiquidityUnlock{RequestHeight: unlock.RequestHeight, Units: units})
+		unlockable = unlockable.Sub(units)
+	}
+	lp.Unlocks = unlocks
+	k.SetLiquidityProvider(ctx, &lp)
+	return lp, nil
+}
+
+// MintLiquidityProviderTokens mints liquidity units of the pool of symbol as bank tokens to addr
+func (k Keeper) MintLiquidityProviderTokens(ctx sdk.Context, symbol string, units sdk.Uint, addr sdk.AccAddress) error {
+	if units.IsZero() {
+		return nil
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(symbol), sdk.NewIntFromBigInt(units.BigInt())))
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// BurnLiquidityProviderTokens burns liquidity unit tokens of the pool of symbol held by addr
+func (k Keeper) BurnLiquidityProviderTokens(ctx sdk.Context, symbol string, units sdk.Uint, addr sdk.AccAddress) error {
+	if units.IsZero() {
+		return nil
+	}
+	coin := sdk.NewCoin(types.GetLiquidityProviderTokenDenom(symbol), sdk.NewIntFromBigInt(units.BigInt()))
+	if !k.bankKeeper.HasBalance(ctx, addr, coin) {
+		return sdkerrors.Wrap(types.ErrNotEnoughLiquidityProviderToken, coin.String())
+	}
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(coin))
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin))
+}
+
 func (k Keeper) GetLiquidityProvidersForAssetPaginated(ctx sdk.Context, asset types.Asset,
 	pagination *query.PageRequest) ([]*types.LiquidityProvider, *query.PageResponse, error) {
 	var lpList []*types.LiquidityProvider
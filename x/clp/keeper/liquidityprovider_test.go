@@ -108,3 +108,72 @@ func TestKeeper_GetLiquidityProviderData(t *testing.T) {
 		require.Equal(t, fmt.Sprint(1000*uint64(i+1)), lpData.NativeAssetBalance)
 	}
 }
+
+func TestKeeper_MintBurnLiquidityProviderTokens(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	addr := test.GenerateAddress(test.AddressKey1)
+	denom := types.GetLiquidityProviderTokenDenom("eth")
+	assert.Equal(t, "clp/eth", denom)
+	err := clpKeeper.MintLiquidityProviderTokens(ctx, "eth", sdk.NewUint(1000), addr)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, addr, denom).Amount)
+	err = clpKeeper.BurnLiquidityProviderTokens(ctx, "eth", sdk.NewUint(400), addr)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(600), app.BankKeeper.GetBalance(ctx, addr, denom).Amount)
+	assert.Equal(t, sdk.NewInt(600), app.BankKeeper.GetSupply(ctx, denom).Amount)
+	err = clpKeeper.BurnLiquidityProviderTokens(ctx, "eth", sdk.NewUint(601), addr)
+	require.ErrorIs(t, err, types.ErrNotEnoughLiquidityProviderToken)
+}
+
+func TestKeeper_SyncLiquidityProvider(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	asset := types.NewAsset("eth")
+	sender := test.GenerateAddress(test.AddressKey1)
+	receiver := test.GenerateAddress(test.AddressKey2)
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(1000), sender)
+	lp.Unlocks = []*types.LiquidityUnlock{{RequestHeight: 1, Units: sdk.NewUint(500)}, {RequestHeight: 2, Units: sdk.NewUint(300)}}
+	clpKeeper.SetLiquidityProvider(ctx, &lp)
+	require.NoError(t, clpKeeper.MintLiquidityProviderTokens(ctx, asset.Symbol, sdk.NewUint(1000), sender))
+
+	// Tokens sent away by bank transfer move the units along with them
+	tokens := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(asset.Symbol), sdk.NewInt(400)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, receiver, tokens))
+	synced, err := clpKeeper.SyncLiquidityProvider(ctx, asset.Symbol, sender.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(600), synced.LiquidityProviderUnits)
+	require.Equal(t, []*types.LiquidityUnlock{{RequestHeight: 1, Units: sdk.NewUint(500)}, {RequestHeight: 2, Units: sdk.NewUint(100)}}, synced.Unlocks)
+	synced, err = clpKeeper.SyncLiquidityProvider(ctx, asset.Symbol, receiver.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(400), synced.LiquidityProviderUnits)
+
+	// Providers left without tokens no longer have a position
+	tokens = sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(asset.Symbol), sdk.NewInt(600)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, sender, receiver, tokens))
+	_, err = clpKeeper.SyncLiquidityProvider(ctx, asset.Symbol, sender.String())
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+	_, err = clpKeeper.GetLiquidityProvider(ctx, asset.Symbol, sender.String())
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+	synced, err = clpKeeper.SyncLiquidityProvider(ctx, asset.Symbol, receiver.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(1000), synced.LiquidityProviderUnits)
+}
+
+func TestMigrator_MigrateToVer3(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	lpList := test.GenerateRandomLP(5)
+	for i := range lpList {
+		lp := lpList[i]
+		clpKeeper.SetLiquidityProvider(ctx, &lp)
+	}
+	err := clpkeeper.NewMigrator(clpKeeper).MigrateToVer3(ctx)
+	require.NoError(t, err)
+	for _, lp := range lpList {
+		addr, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		require.NoError(t, err)
+		balance := app.BankKeeper.GetBalance(ctx, addr, types.GetLiquidityProviderTokenDenom(lp.Asset.Symbol))
+		assert.Equal(t, lp.LiquidityProviderUnits.String(), balance.Amount.String())
+	}
+}
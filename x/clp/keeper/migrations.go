@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"math"
+
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type Migrator struct {
@@ -43,3 +46,24 @@ func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+func (m Migrator) MigrateToVer3(ctx sdk.Context) error {
+	// Mint the liquidity units of existing providers as bank tokens
+	lps, _, err := m.keeper.GetAllLiquidityProvidersPaginated(ctx, &query.PageRequest{
+		Limit: uint64(math.MaxUint64),
+	})
+	if err != nil {
+		return err
+	}
+	for _, lp := range lps {
+		addr, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		if err != nil {
+			return err
+		}
+		err = m.keeper.MintLiquidityProviderTokens(ctx, lp.Asset.Symbol, lp.LiquidityProviderUnits, addr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
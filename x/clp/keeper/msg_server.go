@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
 
@@ -12,7 +11,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
 	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
@@ -31,10 +29,7 @@ func (k msgServer) UpdateStakingRewardParams(goCtx context.Context, msg *types.M
 	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
 		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
-	if !(msg.Minter.AnnualProvisions.IsZero() && msg.Minter.Inflation.IsZero()) {
-		k.mintKeeper.SetMinter(ctx, msg.Minter)
-	}
-	k.mintKeeper.SetParams(ctx, msg.Params)
+	k.Keeper.SetStakingRewardParams(ctx, msg.Minter, msg.Params)
 
 	return &types.MsgUpdateStakingRewardParamsResponse{}, err
 
@@ -50,10 +45,7 @@ func (k msgServer) UpdateRewardsParams(goCtx context.Context, msg *types.MsgUpda
 	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
 		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
-	params := k.GetRewardsParams(ctx)
-	params.LiquidityRemovalLockPeriod = msg.LiquidityRemovalLockPeriod
-	params.LiquidityRemovalCancelPeriod = msg.LiquidityRemovalCancelPeriod
-	k.SetRewardParams(ctx, params)
+	k.Keeper.SetLiquidityRemovalPeriods(ctx, msg.LiquidityRemovalLockPeriod, msg.LiquidityRemovalCancelPeriod)
 	return response, err
 }
 
@@ -67,9 +59,10 @@ func (k msgServer) AddRewardPeriod(goCtx context.Context, msg *types.MsgAddRewar
 	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
 		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
-	params := k.GetRewardsParams(ctx)
-	params.RewardPeriods = msg.RewardPeriods
-	k.SetRewardParams(ctx, params)
+	err = k.Keeper.ReplaceRewardPeriods(ctx, msg.RewardPeriods)
+	if err != nil {
+		return response, err
+	}
 	return response, nil
 }
 
@@ -91,32 +84,37 @@ func (k msgServer) UpdatePmtpParams(goCtx context.Context, msg *types.MsgUpdateP
 	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
 		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
-	params := k.GetPmtpParams(ctx)
-	// Check to see if a policy is still running
-	if k.IsInsidePmtpWindow(ctx) {
-		return response, types.ErrCannotStartPolicy
-	}
-	// Check to make sure new policy starts in the future so that PolicyStart from begin-block can be triggered
-	if msg.PmtpPeriodStartBlock <= ctx.BlockHeight() {
-		return response, errors.New("Start block cannot be in the past/current block")
+	policy, err := k.Keeper.AddPmtpPolicy(ctx, msg.PmtpPeriodGovernanceRate, msg.PmtpPeriodEpochLength, msg.PmtpPeriodStartBlock, msg.PmtpPeriodEndBlock)
+	if err != nil {
+		return response, err
 	}
-	params.PmtpPeriodStartBlock = msg.PmtpPeriodStartBlock
-	params.PmtpPeriodEndBlock = msg.PmtpPeriodEndBlock
-	params.PmtpPeriodEpochLength = msg.PmtpPeriodEpochLength
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+	return &types.MsgUpdatePmtpParamsResponse{PolicyId: policy.Id}, nil
+}
 
-	if !strings.EqualFold(msg.PmtpPeriodGovernanceRate, "") {
-		rGov, err := sdk.NewDecFromStr(msg.PmtpPeriodGovernanceRate)
-		if err != nil {
-			return response, err
-		}
-		params.PmtpPeriodGovernanceRate = rGov
+func (k msgServer) CancelPmtpPolicy(goCtx context.Context, msg *types.MsgCancelPmtpPolicy) (*types.MsgCancelPmtpPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	response := &types.MsgCancelPmtpPolicyResponse{}
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
+		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	policy, err := k.DequeuePmtpPolicy(ctx, msg.PolicyId)
+	if err != nil {
+		return response, err
 	}
-
-	k.SetPmtpParams(ctx, params)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeAddNewPmtpPolicy,
-			sdk.NewAttribute(types.AttributeKeyPmtpPolicyParams, params.String()),
+			types.EventTypeCancelPmtpPolicy,
+			sdk.NewAttribute(types.AttributeKeyPmtpPolicyID, strconv.FormatUint(policy.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPmtpPolicyParams, policy.Params.String()),
 			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
 		),
 		sdk.NewEvent(
@@ -125,7 +123,7 @@ func (k msgServer) UpdatePmtpParams(goCtx context.Context, msg *types.MsgUpdateP
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
 		),
 	})
-	return &types.MsgUpdatePmtpParamsResponse{}, nil
+	return response, nil
 }
 
 func (k msgServer) ModifyPmtpRates(goCtx context.Context, msg *types.MsgModifyPmtpRates) (*types.MsgModifyPmtpRatesResponse, error) {
@@ -138,54 +136,17 @@ func (k msgServer) ModifyPmtpRates(goCtx context.Context, msg *types.MsgModifyPm
 	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
 		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
-	params := k.GetPmtpParams(ctx)
-	rateParams := k.GetPmtpRateParams(ctx)
-
-	// Set Block Rate is needed only if no policy is presently executing
-	if !strings.EqualFold(msg.BlockRate, "") && !k.IsInsidePmtpWindow(ctx) {
-		blockRate, err := sdk.NewDecFromStr(msg.BlockRate)
-		if err != nil {
-			return response, err
-		}
-		rateParams.PmtpPeriodBlockRate = blockRate
+	ended, err := k.Keeper.UpdatePmtpRates(ctx, msg.BlockRate, msg.RunningRate, msg.EndPolicy)
+	if err != nil {
+		return response, err
 	}
-
-	// Set Running Rate if Needed only if no policy is presently executing
-	if !strings.EqualFold(msg.RunningRate, "") && !k.IsInsidePmtpWindow(ctx) {
-		runningRate, err := sdk.NewDecFromStr(msg.RunningRate)
-		if err != nil {
-			return response, err
-		}
-		rateParams.PmtpCurrentRunningRate = runningRate
-		// inter policy rate should always equal running rate between policies
-		rateParams.PmtpInterPolicyRate = runningRate
-	}
-	k.SetPmtpRateParams(ctx, rateParams)
-	events := sdk.EmptyEvents()
-	// End Policy If Needed , returns if not policy is presently
-	if msg.EndPolicy && k.IsInsidePmtpWindow(ctx) {
-		params.PmtpPeriodEndBlock = ctx.BlockHeight()
-		k.SetPmtpParams(ctx, params)
-		k.SetPmtpEpoch(ctx, types.PmtpEpoch{
-			EpochCounter: 0,
-			BlockCounter: 0,
-		})
-		k.SetPmtpInterPolicyRate(ctx, rateParams.PmtpCurrentRunningRate)
-		events = events.AppendEvents(sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeEndPmtpPolicy,
-				sdk.NewAttribute(types.AttributeKeyPmtpPolicyParams, params.String()),
-				sdk.NewAttribute(types.AttributeKeyPmtpRateParams, rateParams.String()),
-				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
-			),
-			sdk.NewEvent(
-				sdk.EventTypeMessage,
-				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
-			),
-		})
+	if ended {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		))
 	}
-	ctx.EventManager().EmitEvents(events)
 	return response, nil
 }
 
@@ -236,55 +197,40 @@ func (k msgServer) DecommissionPool(goCtx context.Context, msg *types.MsgDecommi
 	if err != nil {
 		return nil, err
 	}
-	// TODO : Deprecate this Admin in favor of TokenRegistry
-	if !k.Keeper.ValidateAddress(ctx, addAddr) {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "user does not have permission to decommission pool")
-	}
-	if pool.NativeAssetBalance.GTE(sdk.NewUintFromString(types.PoolThrehold)) {
-		return nil, types.ErrBalanceTooHigh
-	}
-	// Get all LP's for the pool
 	if pool.ExternalAsset == nil {
 		return nil, errors.New("nill external asset")
 	}
-	lpList, _, err := k.Keeper.GetLiquidityProvidersForAssetPaginated(ctx, *pool.ExternalAsset, &query.PageRequest{
-		Limit: uint64(math.MaxUint64),
-	})
-	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrLiquidityProviderDoesNotExist, err.Error())
-	}
-	poolUnits := pool.PoolUnits
-	nativeAssetBalance := pool.NativeAssetBalance
-	externalAssetBalance := pool.ExternalAssetBalance
-	// iterate over Lp list and refund them there tokens
-	// Return both RWN and EXTERNAL ASSET
-	for _, lp := range lpList {
-		withdrawNativeAsset, withdrawExternalAsset, _, _ := CalculateAllAssetsForLP(pool, *lp)
-		poolUnits = poolUnits.Sub(lp.LiquidityProviderUnits)
-		nativeAssetBalance = nativeAssetBalance.Sub(withdrawNativeAsset)
-		externalAssetBalance = externalAssetBalance.Sub(withdrawExternalAsset)
-
-		withdrawNativeAssetInt, ok := k.Keeper.ParseToInt(withdrawNativeAsset.String())
-		if !ok {
-			return nil, types.ErrUnableToParseInt
+	// Pools of any size can be wound down by an admin, their providers are settled once the grace period ends.
+	// Winding down a pool again reschedules its settlement.
+	if msg.GracePeriod > 0 {
+		if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, addAddr) {
+			return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 		}
-		withdrawExternalAssetInt, ok := k.Keeper.ParseToInt(withdrawExternalAsset.String())
-		if !ok {
-			return nil, types.ErrUnableToParseInt
-		}
-		withdrawNativeCoins := sdk.NewCoin(types.GetSettlementAsset().Symbol, withdrawNativeAssetInt)
-		withdrawExternalCoins := sdk.NewCoin(msg.Symbol, withdrawExternalAssetInt)
-		refundingCoins := sdk.NewCoins(withdrawExternalCoins, withdrawNativeCoins)
-		err := k.Keeper.RemoveLiquidityProvider(ctx, refundingCoins, *lp)
+		err = k.Keeper.WindDownPool(ctx, pool, msg.GracePeriod, msg.PayoutSymbol)
 		if err != nil {
-			return nil, sdkerrors.Wrap(types.ErrUnableToRemoveLiquidityProvider, err.Error())
+			return nil, err
 		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		))
+		return &types.MsgDecommissionPoolResponse{}, nil
+	}
+	if pool.WindDownEndHeight != 0 {
+		return nil, sdkerrors.Wrap(types.ErrPoolWindingDown, msg.Symbol)
+	}
+	// TODO : Deprecate this Admin in favor of TokenRegistry
+	if !k.Keeper.ValidateAddress(ctx, addAddr) {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "user does not have permission to decommission pool")
 	}
-	// Pool should be empty at this point
-	// Decommission the pool
-	err = k.Keeper.DecommissionPool(ctx, pool)
+	if pool.NativeAssetBalance.GTE(sdk.NewUintFromString(types.PoolThrehold)) {
+		return nil, types.ErrBalanceTooHigh
+	}
+	// Refund the LPs and decommission the pool
+	err = k.Keeper.SettlePool(ctx, pool)
 	if err != nil {
-		return nil, sdkerrors.Wrap(types.ErrUnableToDecommissionPool, err.Error())
+		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -303,6 +249,10 @@ func (k msgServer) DecommissionPool(goCtx context.Context, msg *types.MsgDecommi
 
 func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := checkDeadline(ctx, types.EventTypeSwapFailed, msg.DeadlineHeight, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	var (
 		priceImpact sdk.Uint
 	)
@@ -321,6 +271,14 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	if !k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
 		return nil, tokenregistrytypes.ErrPermissionDenied
 	}
+	// Swaps against a pool in batch swap mode are cleared together at the end of the block
+	batchPool, batched, err := k.Keeper.GetBatchSwapPool(ctx, *msg.SentAsset, *msg.ReceivedAsset)
+	if err != nil {
+		return nil, err
+	}
+	if batched {
+		return k.queueSwap(ctx, batchPool, msg)
+	}
 	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
 	decimals := sAsset.Decimals
 	liquidityFeeNative := sdk.ZeroUint()
@@ -357,13 +315,17 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	// If its one way we can skip this if condition and add balance to users account from outpool
 	if !msg.SentAsset.Equals(nativeAsset) && !msg.ReceivedAsset.Equals(nativeAsset) {
 		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(decimals)
-		emitAmount, lp, ts, finalPool, err := SwapOne(*sentAsset, sentAmount, nativeAsset, inPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		err = k.Keeper.CheckSwapAllowed(ctx, inPool, nativeAsset, sentAmount)
+		if err != nil {
+			return nil, err
+		}
+		emitAmount, lp, swapFee, ts, finalPool, err := SwapOneWithSwapFee(*sentAsset, sentAmount, nativeAsset, inPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
 		if err != nil {
 			return nil, err
 		}
-		err = k.Keeper.SetPool(ctx, &finalPool)
+		err = k.Keeper.SettleSwap(ctx, accAddr, &finalPool, *sentAsset, sentAmount, nativeAsset, emitAmount, lp, swapFee)
 		if err != nil {
-			return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 		}
 		sentAmount = emitAmount
 		sentAsset = &nativeAsset
@@ -386,7 +348,11 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	}
 	// Calculating amount user receives
 	normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(decimals)
-	emitAmount, lp, ts, finalPool, err := SwapOne(*sentAsset, sentAmount, *receivedAsset, outPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	err = k.Keeper.CheckSwapAllowed(ctx, outPool, *receivedAsset, sentAmount)
+	if err != nil {
+		return nil, err
+	}
+	emitAmount, lp, swapFee, ts, finalPool, err := SwapOneWithSwapFee(*sentAsset, sentAmount, *receivedAsset, outPool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
 	if err != nil {
 		return nil, err
 	}
@@ -408,6 +374,10 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		})
 		return &types.MsgSwapResponse{}, types.ErrReceivedAmountBelowExpected
 	}
+	err = k.Keeper.SettleSwap(ctx, accAddr, &finalPool, *sentAsset, sentAmount, *receivedAsset, emitAmount, lp, swapFee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
 	// todo nil pointer deref test
 	err = k.Keeper.FinalizeSwap(ctx, emitAmount.String(), finalPool, *msg)
 	if err != nil {
@@ -443,8 +413,158 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	return &types.MsgSwapResponse{}, nil
 }
 
+func (k msgServer) queueSwap(ctx sdk.Context, pool types.Pool, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	swap, err := k.Keeper.QueueSwap(ctx, accAddr, pool, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueSwap,
+			sdk.NewAttribute(types.AttributeKeyQueuedSwap, swap.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgSwapResponse{QueuedSwapId: swap.Id}, nil
+}
+
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	// Check every asset on the route before moving any funds
+	decimals := make([]int64, len(msg.Assets))
+	for i, asset := range msg.Assets {
+		entry, err := k.tokenRegistryKeeper.GetEntry(registry, asset.Symbol)
+		if err != nil {
+			return nil, types.ErrTokenNotSupported
+		}
+		if !k.tokenRegistryKeeper.CheckEntryPermissions(entry, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+			return nil, tokenregistrytypes.ErrPermissionDenied
+		}
+		decimals[i] = entry.Decimals
+	}
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	nativeAsset := types.GetSettlementAsset()
+	sentAsset := msg.Assets[0]
+	receivedAsset := msg.Assets[len(msg.Assets)-1]
+	sentAmountInt, ok := k.Keeper.ParseToInt(msg.SentAmount.String())
+	if !ok {
+		return nil, types.ErrUnableToParseInt
+	}
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.InitiateSwap(ctx, sdk.NewCoin(sentAsset.Symbol, sentAmountInt), accAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+	// Execute the hops in order, feeding the output of every hop into the next one.
+	// Pools are read back from the store on every hop, so a route may go through the same pool twice.
+	hopAmount := msg.SentAmount
+	hopEvents := sdk.EmptyEvents()
+	finalPool := types.Pool{}
+	for i := 1; i < len(msg.Assets); i++ {
+		from, to := *msg.Assets[i-1], *msg.Assets[i]
+		externalAsset, externalDecimals := from, decimals[i-1]
+		if from.Equals(nativeAsset) {
+			externalAsset, externalDecimals = to, decimals[i]
+		}
+		pool, err := k.Keeper.GetPool(ctx, externalAsset.Symbol)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, externalAsset.String())
+		}
+		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(externalDecimals)
+		err = k.Keeper.CheckSwapAllowed(ctx, pool, to, hopAmount)
+		if err != nil {
+			return nil, err
+		}
+		swapResult, liquidityFee, swapFee, priceImpact, swappedPool, err := SwapOneWithSwapFee(from, hopAmount, to, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return nil, err
+		}
+		err = k.Keeper.SettleSwap(ctx, accAddr, &swappedPool, from, hopAmount, to, swapResult, liquidityFee, swapFee)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+		hopEvents = hopEvents.AppendEvent(sdk.NewEvent(
+			types.EventTypeSwapRouteHop,
+			sdk.NewAttribute(types.AttributeKeyHop, strconv.Itoa(i)),
+			sdk.NewAttribute(types.AttributeKeySentAsset, from.Symbol),
+			sdk.NewAttribute(types.AttributeKeySentAmount, hopAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedAsset, to.Symbol),
+			sdk.NewAttribute(types.AttributeKeySwapAmount, swapResult.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityFee, liquidityFee.String()),
+			sdk.NewAttribute(types.AttributeKeyPriceImpact, priceImpact.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+		))
+		hopAmount = swapResult
+		finalPool = swappedPool
+	}
+	route := routeString(msg.Assets)
+	if hopAmount.LT(msg.MinReceivingAmount) {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSwapRouteFailed,
+				sdk.NewAttribute(types.AttributeKeySwapAmount, hopAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, msg.MinReceivingAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyRoute, route),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+			),
+		})
+		return &types.MsgSwapRouteResponse{}, types.ErrReceivedAmountBelowExpected
+	}
+	err = k.Keeper.FinalizeSwap(ctx, hopAmount.String(), finalPool, types.NewMsgSwap(accAddr, *sentAsset, *receivedAsset, msg.SentAmount, msg.MinReceivingAmount))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSwapRoute,
+		sdk.NewAttribute(types.AttributeKeyRoute, route),
+		sdk.NewAttribute(types.AttributeKeySentAmount, msg.SentAmount.String()),
+		sdk.NewAttribute(types.AttributeKeySwapAmount, hopAmount.String()),
+		sdk.NewAttribute(types.AttributePmtpBlockRate, k.GetPmtpRateParams(ctx).PmtpPeriodBlockRate.String()),
+		sdk.NewAttribute(types.AttributePmtpCurrentRunningRate, pmtpCurrentRunningRate.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	ctx.EventManager().EmitEvents(hopEvents)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+	return &types.MsgSwapRouteResponse{}, nil
+}
+
+// routeString joins the symbols of a swap route for use in events
+func routeString(route []*types.Asset) string {
+	symbols := make([]string, len(route))
+	for i, asset := range route {
+		symbols[i] = asset.Symbol
+	}
+	return strings.Join(symbols, "->")
+}
+
 func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := checkDeadline(ctx, types.EventTypeRemoveLiquidityFailed, msg.DeadlineHeight, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
 	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
 	if err != nil {
@@ -457,6 +577,9 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	if err != nil {
 		return nil, types.ErrPoolDoesNotExist
 	}
+	if pool.RemovesPaused {
+		return nil, sdkerrors.Wrap(types.ErrPoolPaused, fmt.Sprintf("liquidity removals of pool %s", msg.ExternalAsset.Symbol))
+	}
 	//Get LP
 	lp, err := k.Keeper.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
 	if err != nil {
@@ -474,9 +597,12 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 		pool.NativeAssetBalance.String(), pool.ExternalAssetBalance.String(), lp.LiquidityProviderUnits.String(),
 		msg.WBasisPoints.String(), msg.Asymmetry)
 
-	err = k.Keeper.UseUnlockedLiquidity(ctx, lp, lp.LiquidityProviderUnits.Sub(lpUnitsLeft))
-	if err != nil {
-		return nil, err
+	// Providers of pools winding down can withdraw without unlocking their liquidity first
+	if pool.WindDownEndHeight == 0 {
+		err = k.Keeper.UseUnlockedLiquidity(ctx, lp, lp.LiquidityProviderUnits.Sub(lpUnitsLeft))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	withdrawExternalAssetAmountInt, ok := k.Keeper.ParseToInt(withdrawExternalAssetAmount.String())
@@ -497,10 +623,18 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	if !msg.Asymmetry.IsZero() && (pool.ExternalAssetBalance.IsZero() || pool.NativeAssetBalance.IsZero()) {
 		return nil, sdkerrors.Wrap(types.ErrPoolTooShallow, "pool balance nil before adjusting asymmetry")
 	}
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	// Swapping between Native and External based on Asymmetry
 	if msg.Asymmetry.IsPositive() {
 		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(eAsset.Decimals)
-		swapResult, _, _, swappedPool, err := SwapOne(types.GetSettlementAsset(), swapAmount, *msg.ExternalAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		swapResult, liquidityFee, swapFee, _, swappedPool, err := SwapOneWithSwapFee(types.GetSettlementAsset(), swapAmount, *msg.ExternalAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+		err = k.Keeper.SettleSwap(ctx, signer, &swappedPool, types.GetSettlementAsset(), swapAmount, *msg.ExternalAsset, swapResult, liquidityFee, swapFee)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 		}
@@ -522,7 +656,11 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	}
 	if msg.Asymmetry.IsNegative() {
 		normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(eAsset.Decimals)
-		swapResult, _, _, swappedPool, err := SwapOne(*msg.ExternalAsset, swapAmount, types.GetSettlementAsset(), pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		swapResult, liquidityFee, swapFee, _, swappedPool, err := SwapOneWithSwapFee(*msg.ExternalAsset, swapAmount, types.GetSettlementAsset(), pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+		err = k.Keeper.SettleSwap(ctx, signer, &swappedPool, *msg.ExternalAsset, swapAmount, types.GetSettlementAsset(), swapResult, liquidityFee, swapFee)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 		}
@@ -542,6 +680,11 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 		}
 		pool = swappedPool
 	}
+	err = checkRemovalMinimums(ctx, pool, sdk.NewUintFromBigInt(nativeAssetCoin.Amount.BigInt()), sdk.NewUintFromBigInt(externalAssetCoin.Amount.BigInt()),
+		msg.MinNativeOut, msg.MinExternalOut, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	// Check and  remove Liquidity
 	err = k.Keeper.RemoveLiquidity(ctx, pool, externalAssetCoin, nativeAssetCoin, lp, lpUnitsLeft, poolOriginalEB, poolOriginalNB)
 	if err != nil {
@@ -566,6 +709,10 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 
 func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRemoveLiquidityUnits) (*types.MsgRemoveLiquidityUnitsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := checkDeadline(ctx, types.EventTypeRemoveLiquidityFailed, msg.DeadlineHeight, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
 	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
 	if err != nil {
@@ -578,6 +725,9 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 	if err != nil {
 		return nil, types.ErrPoolDoesNotExist
 	}
+	if pool.RemovesPaused {
+		return nil, sdkerrors.Wrap(types.ErrPoolPaused, fmt.Sprintf("liquidity removals of pool %s", msg.ExternalAsset.Symbol))
+	}
 	//Get LP
 	lp, err := k.Keeper.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
 	if err != nil {
@@ -600,9 +750,12 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 		pool.NativeAssetBalance.String(), pool.ExternalAssetBalance.String(), lp.LiquidityProviderUnits.String(),
 		msg.WithdrawUnits)
 
-	err = k.Keeper.UseUnlockedLiquidity(ctx, lp, lp.LiquidityProviderUnits.Sub(lpUnitsLeft))
-	if err != nil {
-		return nil, err
+	// Providers of pools winding down can withdraw without unlocking their liquidity first
+	if pool.WindDownEndHeight == 0 {
+		err = k.Keeper.UseUnlockedLiquidity(ctx, lp, lp.LiquidityProviderUnits.Sub(lpUnitsLeft))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	withdrawExternalAssetAmountInt, ok := k.Keeper.ParseToInt(withdrawExternalAssetAmount.String())
@@ -619,6 +772,10 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 	pool.PoolUnits = pool.PoolUnits.Sub(lp.LiquidityProviderUnits).Add(lpUnitsLeft)
 	pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(withdrawNativeAssetAmount)
 	pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(withdrawExternalAssetAmount)
+	err = checkRemovalMinimums(ctx, pool, withdrawNativeAssetAmount, withdrawExternalAssetAmount, msg.MinNativeOut, msg.MinExternalOut, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check and  remove Liquidity
 	err = k.Keeper.RemoveLiquidity(ctx, pool, externalAssetCoin, nativeAssetCoin, lp, lpUnitsLeft, poolOriginalEB, poolOriginalNB)
@@ -642,18 +799,37 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 	return &types.MsgRemoveLiquidityUnitsResponse{}, nil
 }
 
+// poolCreationMinimums returns the least native and external amounts a pool of the entry can be
+// created with, the default native threshold applies when the entry is nil or sets no minimum
+func poolCreationMinimums(entry *tokenregistrytypes.RegistryEntry) (sdk.Uint, sdk.Uint) {
+	minNative, minExternal := sdk.NewUintFromString(types.PoolThrehold), sdk.ZeroUint()
+	if entry == nil {
+		return minNative, minExternal
+	}
+	if amount, err := sdk.ParseUint(entry.MinPoolNativeAmount); err == nil {
+		minNative = amount
+	}
+	if amount, err := sdk.ParseUint(entry.MinPoolExternalAmount); err == nil {
+		minExternal = amount
+	}
+	return minNative, minExternal
+}
+
 func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (*types.MsgCreatePoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// Verify min threshold
-	MinThreshold := sdk.NewUintFromString(types.PoolThrehold)
-	if msg.NativeAssetAmount.LT(MinThreshold) { // Need to verify
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	eAsset, entryErr := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	// Verify min threshold, the registry entry of the asset can set its own
+	minNative, minExternal := poolCreationMinimums(eAsset)
+	if msg.NativeAssetAmount.LT(minNative) { // Need to verify
 		return nil, types.ErrTotalAmountTooLow
 	}
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
-	if err != nil {
+	if entryErr != nil {
 		return nil, types.ErrTokenNotSupported
 	}
+	if msg.ExternalAssetAmount.LT(minExternal) {
+		return nil, sdkerrors.Wrap(types.ErrTotalAmountTooLow, fmt.Sprintf("external asset amount below %s", minExternal))
+	}
 	if !k.tokenRegistryKeeper.CheckEntryPermissions(eAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
 		return nil, tokenregistrytypes.ErrPermissionDenied
 	}
@@ -700,6 +876,10 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 
 func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := checkDeadline(ctx, types.EventTypeAddLiquidityFailed, msg.DeadlineHeight, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
 	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
 	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
 	if err != nil {
@@ -713,6 +893,9 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 	if err != nil {
 		return nil, types.ErrPoolDoesNotExist
 	}
+	if err := checkAddsEnabled(pool); err != nil {
+		return nil, err
+	}
 	normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(eAsset.Decimals)
 	newPoolUnits, lpUnits, err := CalculatePoolUnits(
 		pool.PoolUnits,
@@ -725,6 +908,23 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 	if err != nil {
 		return nil, err
 	}
+	if belowMinimum(lpUnits, msg.MinPoolUnits) {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAddLiquidityFailed,
+				sdk.NewAttribute(types.AttributeKeyUnits, lpUnits.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, msg.MinPoolUnits.String()),
+				sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+			),
+		})
+		return nil, sdkerrors.Wrap(types.ErrLiquidityBelowMinimum, fmt.Sprintf("received %s units", lpUnits))
+	}
 	// Get lp , if lp doesnt exist create lp
 	lp, err := k.Keeper.AddLiquidity(ctx, msg, pool, newPoolUnits, lpUnits)
 	if err != nil {
@@ -744,3 +944,679 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 	})
 	return &types.MsgAddLiquidityResponse{}, nil
 }
+
+func (k msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	sAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.SentAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrTokenNotSupported
+	}
+	rAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ReceivedAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrTokenNotSupported
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return nil, tokenregistrytypes.ErrPermissionDenied
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return nil, tokenregistrytypes.ErrPermissionDenied
+	}
+	poolAsset := msg.SentAsset
+	if poolAsset.Equals(types.GetSettlementAsset()) {
+		poolAsset = msg.ReceivedAsset
+	}
+	pool, err := k.Keeper.GetPool(ctx, poolAsset.Symbol)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, poolAsset.String())
+	}
+	if pool.WindDownEndHeight != 0 {
+		return nil, sdkerrors.Wrap(types.ErrPoolWindingDown, poolAsset.Symbol)
+	}
+	if err := checkSequentialSwaps(pool); err != nil {
+		return nil, err
+	}
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrap(types.ErrInvalidExpiryHeight, strconv.FormatInt(msg.ExpiryHeight, 10))
+	}
+	order, err := k.Keeper.PlaceLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgPlaceLimitOrderResponse{Id: order.Id}, nil
+}
+
+func (k msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order, err := k.Keeper.GetLimitOrder(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Owner != msg.Signer {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	err = k.Keeper.RefundLimitOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+func (k msgServer) TransferLiquidityPosition(goCtx context.Context, msg *types.MsgTransferLiquidityPosition) (*types.MsgTransferLiquidityPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.Keeper.ExistsPool(ctx, msg.ExternalAsset.Symbol) {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
+	if err != nil {
+		return nil, types.ErrLiquidityProviderDoesNotExist
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	// Prune unlocks
+	params := k.GetRewardsParams(ctx)
+	k.PruneUnlockRecords(ctx, &lp, params.LiquidityRemovalLockPeriod, params.LiquidityRemovalCancelPeriod)
+	from, to, err := k.Keeper.TransferLiquidityPosition(ctx, lp, recipient, msg.Units)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferLiquidity,
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, from.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, msg.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeyUnits, msg.Units.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgTransferLiquidityPositionResponse{}, nil
+}
+
+func (k msgServer) UpdateSwapFeeRate(goCtx context.Context, msg *types.MsgUpdateSwapFeeRate) (*types.MsgUpdateSwapFeeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	swapFeeRate := msg.SwapFeeRate
+	pool.SwapFeeRate = &swapFeeRate
+	err = k.Keeper.SetPool(ctx, &pool)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateSwapFeeRate,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeySwapFeeRate, swapFeeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgUpdateSwapFeeRateResponse{}, nil
+}
+
+func (k msgServer) UpdateProtocolFeeRate(goCtx context.Context, msg *types.MsgUpdateProtocolFeeRate) (*types.MsgUpdateProtocolFeeRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	params := k.GetSwapFeeParams(ctx)
+	params.ProtocolFeeRate = msg.ProtocolFeeRate
+	k.SetSwapFeeParams(ctx, params)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateProtocolFeeRate,
+			sdk.NewAttribute(types.AttributeKeyProtocolFeeRate, params.ProtocolFeeRate.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgUpdateProtocolFeeRateResponse{}, nil
+}
+
+func (k msgServer) UpdatePoolPauseState(goCtx context.Context, msg *types.MsgUpdatePoolPauseState) (*types.MsgUpdatePoolPauseStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	pool.SwapsPaused = msg.SwapsPaused
+	pool.AddsPaused = msg.AddsPaused
+	pool.RemovesPaused = msg.RemovesPaused
+	err = k.Keeper.SetPool(ctx, &pool)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePoolPauseState,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeySwapsPaused, strconv.FormatBool(pool.SwapsPaused)),
+			sdk.NewAttribute(types.AttributeKeyAddsPaused, strconv.FormatBool(pool.AddsPaused)),
+			sdk.NewAttribute(types.AttributeKeyRemovesPaused, strconv.FormatBool(pool.RemovesPaused)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgUpdatePoolPauseStateResponse{}, nil
+}
+
+func (k msgServer) UpdateCircuitBreakerParams(goCtx context.Context, msg *types.MsgUpdateCircuitBreakerParams) (*types.MsgUpdateCircuitBreakerParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	params := k.GetCircuitBreakerParams(ctx)
+	params.MaxPriceImpact = msg.MaxPriceImpact
+	params.MaxPriceChange = msg.MaxPriceChange
+	k.SetCircuitBreakerParams(ctx, params)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyCircuitBreakerParams, params.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgUpdateCircuitBreakerParamsResponse{}, nil
+}
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := k.Keeper.ClaimLiquidityProviderRewards(ctx, msg.ExternalAsset.Symbol, signer)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRewards,
+			sdk.NewAttribute(types.AttributeKeyPool, msg.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeyClaimedRewards, claimed.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgClaimRewardsResponse{Claimed: claimed}, nil
+}
+
+func (k msgServer) AppendRewardPeriod(goCtx context.Context, msg *types.MsgAppendRewardPeriod) (*types.MsgAppendRewardPeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	err = k.Keeper.InsertRewardPeriod(ctx, msg.RewardPeriod)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAppendRewardPeriod,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriodID, msg.RewardPeriod.RewardPeriodId),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgAppendRewardPeriodResponse{}, nil
+}
+
+func (k msgServer) EditRewardPeriod(goCtx context.Context, msg *types.MsgEditRewardPeriod) (*types.MsgEditRewardPeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	err = k.Keeper.UpdateRewardPeriod(ctx, msg.RewardPeriod)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditRewardPeriod,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriodID, msg.RewardPeriod.RewardPeriodId),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgEditRewardPeriodResponse{}, nil
+}
+
+func (k msgServer) DeleteRewardPeriod(goCtx context.Context, msg *types.MsgDeleteRewardPeriod) (*types.MsgDeleteRewardPeriodResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_PMTPREWARDS, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	err = k.Keeper.RemoveRewardPeriod(ctx, msg.RewardPeriodId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeleteRewardPeriod,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriodID, msg.RewardPeriodId),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgDeleteRewardPeriodResponse{}, nil
+}
+
+func (k msgServer) FundRewardEscrow(goCtx context.Context, msg *types.MsgFundRewardEscrow) (*types.MsgFundRewardEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	escrow, err := k.Keeper.FundRewardPeriodEscrow(ctx, signer, msg.RewardPeriodId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundRewardEscrow,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriodID, msg.RewardPeriodId),
+			sdk.NewAttribute(types.AttributeKeyEscrowAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowBalance, escrow.Balance.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgFundRewardEscrowResponse{}, nil
+}
+
+func (k msgServer) RefundRewardEscrow(goCtx context.Context, msg *types.MsgRefundRewardEscrow) (*types.MsgRefundRewardEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	refunded, err := k.Keeper.RefundRewardPeriodEscrow(ctx, signer, msg.RewardPeriodId)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundRewardEscrow,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriodID, msg.RewardPeriodId),
+			sdk.NewAttribute(types.AttributeKeyEscrowAmount, refunded.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgRefundRewardEscrowResponse{Refunded: refunded}, nil
+}
+
+func (k msgServer) AddLiquiditySingleSided(goCtx context.Context, msg *types.MsgAddLiquiditySingleSided) (*types.MsgAddLiquiditySingleSidedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := checkDeadline(ctx, types.EventTypeAddLiquidityFailed, msg.DeadlineHeight, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrTokenNotSupported
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(eAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return nil, tokenregistrytypes.ErrPermissionDenied
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	if err := checkAddsEnabled(pool); err != nil {
+		return nil, err
+	}
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	receivedAsset := types.GetSettlementAsset()
+	if msg.SentAsset.Equals(receivedAsset) {
+		receivedAsset = *msg.ExternalAsset
+	}
+	quote, swapFee, swappedPool, err := k.Keeper.QuoteAddLiquiditySingleSided(ctx, pool, *msg.SentAsset, msg.Amount)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+	err = k.Keeper.CheckSwapAllowed(ctx, pool, receivedAsset, quote.SwapAmount)
+	if err != nil {
+		return nil, err
+	}
+	if belowMinimum(quote.LpUnits, msg.MinPoolUnits) {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAddLiquidityFailed,
+				sdk.NewAttribute(types.AttributeKeyUnits, quote.LpUnits.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, msg.MinPoolUnits.String()),
+				sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+			),
+		})
+		return nil, sdkerrors.Wrap(types.ErrLiquidityBelowMinimum, fmt.Sprintf("received %s units", quote.LpUnits))
+	}
+	// Swap the share of the sent asset, then add both assets as a regular liquidity addition
+	if !quote.SwapAmount.IsZero() {
+		err = k.Keeper.InitiateSwap(ctx, sdk.NewCoin(msg.SentAsset.Symbol, sdk.NewIntFromBigInt(quote.SwapAmount.BigInt())), signer)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+		err = k.Keeper.SettleSwap(ctx, signer, &swappedPool, *msg.SentAsset, quote.SwapAmount, receivedAsset, quote.SwapResult, quote.LiquidityFee, swapFee)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer,
+			sdk.NewCoins(sdk.NewCoin(receivedAsset.Symbol, sdk.NewIntFromBigInt(quote.SwapResult.BigInt()))))
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+		}
+	}
+	normalizationFactor, adjustExternalToken := k.GetNormalizationFactor(eAsset.Decimals)
+	newPoolUnits, lpUnits, err := CalculatePoolUnits(
+		swappedPool.PoolUnits,
+		swappedPool.NativeAssetBalance,
+		swappedPool.ExternalAssetBalance,
+		quote.NativeAssetAmount,
+		quote.ExternalAssetAmount,
+		normalizationFactor,
+		adjustExternalToken)
+	if err != nil {
+		return nil, err
+	}
+	addMsg := types.NewMsgAddLiquidity(signer, *msg.ExternalAsset, quote.NativeAssetAmount, quote.ExternalAssetAmount)
+	lp, err := k.Keeper.AddLiquidity(ctx, &addMsg, swappedPool, newPoolUnits, lpUnits)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToAddLiquidity, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddLiquiditySingleSided,
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, lp.String()),
+			sdk.NewAttribute(types.AttributeKeySentAsset, msg.SentAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeySentAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySwapAmount, quote.SwapAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityFee, quote.LiquidityFee.String()),
+			sdk.NewAttribute(types.AttributeKeyUnits, lpUnits.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgAddLiquiditySingleSidedResponse{
+		SwapAmount: quote.SwapAmount,
+		SwapResult: quote.SwapResult,
+		LpUnits:    lpUnits,
+	}, nil
+}
+
+// checkDeadline fails with ErrDeadlineExceeded and emits eventType when the optional deadline height of a message
+// has passed
+func checkDeadline(ctx sdk.Context, eventType string, deadlineHeight int64, signer string) error {
+	if deadlineHeight <= 0 || ctx.BlockHeight() <= deadlineHeight {
+		return nil
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDeadlineHeight, strconv.FormatInt(deadlineHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, signer),
+		),
+	})
+	return sdkerrors.Wrap(types.ErrDeadlineExceeded, fmt.Sprintf("deadline height %d", deadlineHeight))
+}
+
+// belowMinimum returns whether amount is below an optional minimum of a message, minimums missing from the message
+// are left nil
+func belowMinimum(amount sdk.Uint, minimum sdk.Uint) bool {
+	return minimum != (sdk.Uint{}) && amount.LT(minimum)
+}
+
+// checkRemovalMinimums fails with ErrLiquidityBelowMinimum and emits EventTypeRemoveLiquidityFailed when a liquidity
+// removal pays out less than the minimums accepted by the signer
+func checkRemovalMinimums(ctx sdk.Context, pool types.Pool, nativeOut, externalOut, minNativeOut, minExternalOut sdk.Uint, signer string) error {
+	if minNativeOut == (sdk.Uint{}) {
+		minNativeOut = sdk.ZeroUint()
+	}
+	if minExternalOut == (sdk.Uint{}) {
+		minExternalOut = sdk.ZeroUint()
+	}
+	if !belowMinimum(nativeOut, minNativeOut) && !belowMinimum(externalOut, minExternalOut) {
+		return nil
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveLiquidityFailed,
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, nativeOut.String()),
+			sdk.NewAttribute(types.AttributeKeyExternalAmount, externalOut.String()),
+			sdk.NewAttribute(types.AttributeKeyMinNativeOut, minNativeOut.String()),
+			sdk.NewAttribute(types.AttributeKeyMinExternalOut, minExternalOut.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, signer),
+		),
+	})
+	return sdkerrors.Wrap(types.ErrLiquidityBelowMinimum, fmt.Sprintf("received %s%s and %s%s",
+		nativeOut, types.GetSettlementAsset().Symbol, externalOut, pool.ExternalAsset.Symbol))
+}
+
+func (k msgServer) UpdatePoolSwapMode(goCtx context.Context, msg *types.MsgUpdatePoolSwapMode) (*types.MsgUpdatePoolSwapModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	// Swaps queued earlier in the block are still cleared by the EndBlocker
+	pool.SwapMode = msg.SwapMode
+	err = k.Keeper.SetPool(ctx, &pool)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePoolSwapMode,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeySwapMode, pool.SwapMode.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgUpdatePoolSwapModeResponse{}, nil
+}
+
+func (k msgServer) ApprovePendingPool(goCtx context.Context, msg *types.MsgApprovePendingPool) (*types.MsgApprovePendingPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	if !pool.Pending {
+		return nil, sdkerrors.Wrap(types.ErrPoolNotPending, msg.ExternalAsset.Symbol)
+	}
+	pool.Pending = false
+	err = k.Keeper.SetPool(ctx, &pool)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApprovePendingPool,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgApprovePendingPoolResponse{}, nil
+}
+
+func (k msgServer) CancelPoolWindDown(goCtx context.Context, msg *types.MsgCancelPoolWindDown) (*types.MsgCancelPoolWindDownResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !k.tokenRegistryKeeper.IsAdminAccount(ctx, tokenregistrytypes.AdminType_CLPDEX, signer) {
+		return nil, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
+	}
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	err = k.Keeper.CancelPoolWindDown(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+	return &types.MsgCancelPoolWindDownResponse{}, nil
+}
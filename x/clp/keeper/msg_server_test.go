@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -54,7 +55,7 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 			externalBalance:     sdk.NewInt(10000),
 			nativeAssetAmount:   sdk.NewUint(1000),
 			externalAssetAmount: sdk.NewUint(1000),
-			poolUnits:           sdk.NewUint(1000),
+			poolUnits:           sdk.ZeroUint(),
 			msg: &types.MsgDecommissionPool{
 				Signer: "xxx",
 				Symbol: "eth",
@@ -72,7 +73,7 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 			externalBalance:     sdk.NewInt(10000),
 			nativeAssetAmount:   sdk.NewUint(1000),
 			externalAssetAmount: sdk.NewUint(1000),
-			poolUnits:           sdk.NewUint(1000),
+			poolUnits:           sdk.ZeroUint(),
 			msg: &types.MsgDecommissionPool{
 				Signer: "sif1jv65s3grqf6v6jl3dp4t6c9t9rk99cd8zzt2x5",
 				Symbol: "eth",
@@ -108,7 +109,7 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 			externalBalance:     sdk.NewInt(10000),
 			nativeAssetAmount:   sdk.NewUintFromString(types.PoolThrehold),
 			externalAssetAmount: sdk.NewUint(1000),
-			poolUnits:           sdk.NewUint(1000),
+			poolUnits:           sdk.ZeroUint(),
 			msg: &types.MsgDecommissionPool{
 				Signer: "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd",
 				Symbol: "eth",
@@ -116,7 +117,7 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 			errString: errors.New("Pool Balance too high to be decommissioned"),
 		},
 		{
-			name:                "insufficient funds",
+			name:                "successful decommission",
 			createBalance:       true,
 			createPool:          true,
 			createLPs:           true,
@@ -131,7 +132,6 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 				Signer: "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd",
 				Symbol: "eth",
 			},
-			errString: errors.New("0eth is smaller than 1000eth: insufficient funds: unable to add balance: Unable to add liquidity provider"),
 		},
 	}
 
@@ -151,21 +151,17 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
-						},
+					coins := sdk.NewCoins(sdk.NewCoin(tc.poolAsset, tc.externalBalance), sdk.NewCoin("rowan", tc.nativeBalance))
+					if tc.createLPs {
+						// The units of the liquidity provider are backed by its tokens
+						coins = coins.Add(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(tc.poolAsset), sdk.NewIntFromBigInt(tc.poolUnits.BigInt())))
 					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins:   coins,
+					})
 				}
 
 				if tc.createPool {
@@ -177,13 +173,14 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -196,6 +193,10 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -213,6 +214,7 @@ func TestMsgServer_DecommissionPool(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
 		})
 	}
 }
@@ -361,21 +363,15 @@ func TestMsgServer_Swap(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -387,13 +383,14 @@ func TestMsgServer_Swap(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -406,6 +403,10 @@ func TestMsgServer_Swap(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -414,7 +415,12 @@ func TestMsgServer_Swap(t *testing.T) {
 
 			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
 
-			_, err := msgServer.Swap(sdk.WrapSDKContext(ctx), tc.msg)
+			// A failed swap is reverted as it would be when delivering the transaction
+			cacheCtx, write := ctx.CacheContext()
+			_, err := msgServer.Swap(sdk.WrapSDKContext(cacheCtx), tc.msg)
+			if err == nil {
+				write()
+			}
 
 			//if tc.errString != nil {
 			//	require.EqualError(t, err, tc.errString.Error())
@@ -425,6 +431,7 @@ func TestMsgServer_Swap(t *testing.T) {
 				return
 			}
 			//require.NoError(t, err)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
 		})
 	}
 }
@@ -515,7 +522,7 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 			externalBalance:      sdk.NewInt(10000),
 			nativeAssetAmount:    sdk.NewUint(1000),
 			externalAssetAmount:  sdk.NewUint(1000),
-			poolUnits:            sdk.NewUint(1000),
+			poolUnits:            sdk.ZeroUint(),
 			poolAssetPermissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
 			msg: &types.MsgRemoveLiquidity{
 				Signer:        "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd",
@@ -585,21 +592,17 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
-						},
+					coins := sdk.NewCoins(sdk.NewCoin(tc.poolAsset, tc.externalBalance), sdk.NewCoin("rowan", tc.nativeBalance))
+					if tc.createLPs {
+						// The units of the liquidity provider are backed by its tokens
+						coins = coins.Add(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(tc.poolAsset), sdk.NewIntFromBigInt(tc.poolUnits.BigInt())))
 					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins:   coins,
+					})
 				}
 
 				if tc.createPool {
@@ -611,13 +614,14 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -630,6 +634,10 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -649,6 +657,7 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
 		})
 	}
 }
@@ -792,21 +801,15 @@ func TestMsgServer_CreatePool(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -818,13 +821,14 @@ func TestMsgServer_CreatePool(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -837,6 +841,10 @@ func TestMsgServer_CreatePool(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -856,6 +864,7 @@ func TestMsgServer_CreatePool(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
 		})
 	}
 }
@@ -986,21 +995,15 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -1012,13 +1015,14 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -1031,6 +1035,10 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -1050,6 +1058,344 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
 		})
 	}
 }
+
+func TestMsgServer_SwapRoute(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	poolDepth := sdk.NewUint(1000000000000)
+	testcases := []struct {
+		name           string
+		tokenSymbols   []string
+		poolSymbols    []string
+		msg            *types.MsgSwapRoute
+		expectedOutput sdk.Int
+		err            error
+	}{
+		{
+			name:         "token not supported",
+			tokenSymbols: []string{"ceth", "rowan"},
+			poolSymbols:  []string{"ceth"},
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             []*types.Asset{{Symbol: "ceth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}},
+				SentAmount:         sdk.NewUint(1000000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			err: types.ErrTokenNotSupported,
+		},
+		{
+			name:         "pool does not exist",
+			tokenSymbols: []string{"ceth", "rowan", "cusdc"},
+			poolSymbols:  []string{"ceth"},
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             []*types.Asset{{Symbol: "ceth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}},
+				SentAmount:         sdk.NewUint(1000000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			err: types.ErrPoolDoesNotExist,
+		},
+		{
+			name:         "received amount below expected",
+			tokenSymbols: []string{"ceth", "rowan", "cusdc"},
+			poolSymbols:  []string{"ceth", "cusdc"},
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             []*types.Asset{{Symbol: "ceth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}},
+				SentAmount:         sdk.NewUint(1000000),
+				MinReceivingAmount: sdk.NewUint(1000000),
+			},
+			err: types.ErrReceivedAmountBelowExpected,
+		},
+		{
+			name:         "successful two hop route",
+			tokenSymbols: []string{"ceth", "rowan", "cusdc"},
+			poolSymbols:  []string{"ceth", "cusdc"},
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             []*types.Asset{{Symbol: "ceth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}},
+				SentAmount:         sdk.NewUint(1000000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			expectedOutput: sdk.NewInt(999996),
+		},
+		{
+			name:         "successful route through the same pool twice",
+			tokenSymbols: []string{"ceth", "rowan", "cusdc"},
+			poolSymbols:  []string{"ceth", "cusdc"},
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             []*types.Asset{{Symbol: "ceth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}, {Symbol: "rowan"}, {Symbol: "ceth"}},
+				SentAmount:         sdk.NewUint(1000000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			expectedOutput: sdk.NewInt(999996),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+				entries := []*tokenregistrytypes.RegistryEntry{}
+				for _, symbol := range tc.tokenSymbols {
+					entries = append(entries, &tokenregistrytypes.RegistryEntry{Denom: symbol, BaseDenom: symbol, Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+				}
+				trGs := &tokenregistrytypes.GenesisState{
+					AdminAccounts: test.GetAdmins(address),
+					Registry:      &tokenregistrytypes.Registry{Entries: entries},
+				}
+				bz, _ := app.AppCodec().MarshalJSON(trGs)
+				genesisState["tokenregistry"] = bz
+
+				clpGs := types.DefaultGenesisState()
+				for _, symbol := range tc.poolSymbols {
+					clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+						ExternalAsset:        &types.Asset{Symbol: symbol},
+						NativeAssetBalance:   poolDepth,
+						ExternalAssetBalance: poolDepth,
+						PoolUnits:            poolDepth,
+					})
+					clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, &types.LiquidityProvider{
+						Asset:                    &types.Asset{Symbol: symbol},
+						LiquidityProviderUnits:   poolDepth,
+						LiquidityProviderAddress: address,
+					})
+				}
+				bz, _ = app.AppCodec().MarshalJSON(clpGs)
+				genesisState["clp"] = bz
+
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances,
+					banktypes.Balance{
+						Address: address,
+						Coins:   sdk.NewCoins(sdk.NewCoin("ceth", sdk.NewInt(1000000))),
+					},
+					test.GetPoolsModuleBalance(clpGs.PoolList),
+				)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
+				return genesisState
+			})
+			app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.ZeroDec())
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+
+			_, err := msgServer.SwapRoute(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			signer, _ := sdk.AccAddressFromBech32(address)
+			received := tc.msg.Assets[len(tc.msg.Assets)-1].Symbol
+			require.Equal(t, tc.expectedOutput, app.BankKeeper.GetBalance(ctx, signer, received).Amount)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
+		})
+	}
+}
+
+func TestMsgServer_TransferLiquidityPosition(t *testing.T) {
+	sender := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	recipient := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	testcases := []struct {
+		name              string
+		sentAway          sdk.Int
+		units             sdk.Uint
+		expectedSender    *types.LiquidityProvider
+		expectedRecipient types.LiquidityProvider
+		err               error
+	}{
+		{
+			name:  "units greater than position",
+			units: sdk.NewUint(1001),
+			err:   types.ErrBalanceNotAvailable,
+		},
+		{
+			name:     "tokens sent away",
+			sentAway: sdk.NewInt(900),
+			units:    sdk.NewUint(500),
+			err:      types.ErrBalanceNotAvailable,
+		},
+		{
+			name:  "partial transfer moves oldest unlocks first",
+			units: sdk.NewUint(500),
+			expectedSender: &types.LiquidityProvider{
+				Asset:                    &types.Asset{Symbol: "ceth"},
+				LiquidityProviderAddress: sender,
+				LiquidityProviderUnits:   sdk.NewUint(500),
+				Unlocks:                  []*types.LiquidityUnlock{{RequestHeight: 2, Units: sdk.NewUint(200)}},
+			},
+			expectedRecipient: types.LiquidityProvider{
+				Asset:                    &types.Asset{Symbol: "ceth"},
+				LiquidityProviderAddress: recipient,
+				LiquidityProviderUnits:   sdk.NewUint(600),
+				Unlocks: []*types.LiquidityUnlock{
+					{RequestHeight: 1, Units: sdk.NewUint(300)},
+					{RequestHeight: 2, Units: sdk.NewUint(200)},
+					{RequestHeight: 3, Units: sdk.NewUint(50)},
+				},
+			},
+		},
+		{
+			name:  "full transfer removes the sender position",
+			units: sdk.NewUint(1000),
+			expectedRecipient: types.LiquidityProvider{
+				Asset:                    &types.Asset{Symbol: "ceth"},
+				LiquidityProviderAddress: recipient,
+				LiquidityProviderUnits:   sdk.NewUint(1100),
+				Unlocks: []*types.LiquidityUnlock{
+					{RequestHeight: 1, Units: sdk.NewUint(300)},
+					{RequestHeight: 2, Units: sdk.NewUint(400)},
+					{RequestHeight: 3, Units: sdk.NewUint(50)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+				clpGs := types.DefaultGenesisState()
+				clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+					ExternalAsset:        &types.Asset{Symbol: "ceth"},
+					NativeAssetBalance:   sdk.NewUint(1100),
+					ExternalAssetBalance: sdk.NewUint(1100),
+					PoolUnits:            sdk.NewUint(1100),
+				})
+				clpGs.LiquidityProviders = append(clpGs.LiquidityProviders,
+					&types.LiquidityProvider{
+						Asset:                    &types.Asset{Symbol: "ceth"},
+						LiquidityProviderAddress: sender,
+						LiquidityProviderUnits:   sdk.NewUint(1000),
+						Unlocks: []*types.LiquidityUnlock{
+							{RequestHeight: 1, Units: sdk.NewUint(300)},
+							{RequestHeight: 2, Units: sdk.NewUint(400)},
+						},
+					},
+					&types.LiquidityProvider{
+						Asset:                    &types.Asset{Symbol: "ceth"},
+						LiquidityProviderAddress: recipient,
+						LiquidityProviderUnits:   sdk.NewUint(100),
+						Unlocks:                  []*types.LiquidityUnlock{{RequestHeight: 3, Units: sdk.NewUint(50)}},
+					},
+				)
+				bz, _ := app.AppCodec().MarshalJSON(clpGs)
+				genesisState["clp"] = bz
+
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances,
+					banktypes.Balance{
+						Address: sender,
+						Coins:   sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), sdk.NewInt(1000))),
+					},
+					banktypes.Balance{
+						Address: recipient,
+						Coins:   sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), sdk.NewInt(100))),
+					},
+					test.GetPoolsModuleBalance(clpGs.PoolList),
+				)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
+				return genesisState
+			})
+			ctx = ctx.WithBlockHeight(10)
+			app.ClpKeeper.SetRewardParams(ctx, types.GetDefaultRewardParams())
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+			senderAddr, _ := sdk.AccAddressFromBech32(sender)
+			recipientAddr, _ := sdk.AccAddressFromBech32(recipient)
+			if !tc.sentAway.IsNil() {
+				sentAway := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), tc.sentAway))
+				require.NoError(t, app.BankKeeper.SendCoins(ctx, senderAddr, sdk.AccAddress("third_party_________"), sentAway))
+			}
+
+			msg := types.NewMsgTransferLiquidityPosition(senderAddr, recipientAddr, types.NewAsset("ceth"), tc.units)
+			_, err := msgServer.TransferLiquidityPosition(sdk.WrapSDKContext(ctx), &msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			from, err := app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", sender)
+			if tc.expectedSender == nil {
+				require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, *tc.expectedSender, from)
+			}
+			to, err := app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", recipient)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRecipient, to)
+			require.Equal(t, sdk.NewIntFromBigInt(tc.expectedRecipient.LiquidityProviderUnits.BigInt()), app.BankKeeper.GetBalance(ctx, recipientAddr, types.GetLiquidityProviderTokenDenom("ceth")).Amount)
+			pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewUint(1100), pool.PoolUnits)
+			test.RequireInvariants(t, ctx, app.ClpKeeper)
+		})
+	}
+}
+
+func TestMsgServer_MinimumsAndDeadline(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(10)
+	params := types.GetDefaultRewardParams()
+	params.LiquidityRemovalLockPeriod = 0
+	app.ClpKeeper.SetRewardParams(ctx, params)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	// Failed messages run on a cached context as their writes are discarded with the transaction
+	failCtx := func() context.Context {
+		cacheCtx, _ := ctx.CacheContext()
+		return sdk.WrapSDKContext(cacheCtx)
+	}
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+
+	addMsg := types.NewMsgAddLiquidity(signer, eth, sdk.NewUint(100000), sdk.NewUint(100000))
+	addMsg.DeadlineHeight = 9
+	_, err := msgServer.AddLiquidity(failCtx(), &addMsg)
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+	addMsg.DeadlineHeight = 10
+	addMsg.MinPoolUnits = sdk.NewUint(100001)
+	_, err = msgServer.AddLiquidity(failCtx(), &addMsg)
+	require.ErrorIs(t, err, types.ErrLiquidityBelowMinimum)
+	addMsg.MinPoolUnits = sdk.NewUint(100000)
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", address)
+	require.NoError(t, err)
+	lp.Unlocks = []*types.LiquidityUnlock{{RequestHeight: 1, Units: lp.LiquidityProviderUnits}}
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	removeMsg := types.NewMsgRemoveLiquidityUnits(signer, eth, sdk.NewUint(50000))
+	removeMsg.DeadlineHeight = 5
+	_, err = msgServer.RemoveLiquidityUnits(failCtx(), &removeMsg)
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+	removeMsg.DeadlineHeight = 0
+	removeMsg.MinExternalOut = sdk.NewUint(50001)
+	_, err = msgServer.RemoveLiquidityUnits(failCtx(), &removeMsg)
+	require.ErrorIs(t, err, types.ErrLiquidityBelowMinimum)
+	removeMsg.MinExternalOut = sdk.NewUint(50000)
+	_, err = msgServer.RemoveLiquidityUnits(sdk.WrapSDKContext(ctx), &removeMsg)
+	require.NoError(t, err)
+	removeAllMsg := types.NewMsgRemoveLiquidity(signer, eth, sdk.NewInt(10000), sdk.NewInt(0))
+	removeAllMsg.MinNativeOut = sdk.NewUint(50001)
+	_, err = msgServer.RemoveLiquidity(failCtx(), &removeAllMsg)
+	require.ErrorIs(t, err, types.ErrLiquidityBelowMinimum)
+	removeAllMsg.MinNativeOut = sdk.NewUint(50000)
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeAllMsg)
+	require.NoError(t, err)
+
+	swapMsg := types.NewMsgSwap(signer, eth, rowan, sdk.NewUint(1000), sdk.ZeroUint())
+	swapMsg.DeadlineHeight = 9
+	_, err = msgServer.Swap(failCtx(), &swapMsg)
+	require.ErrorIs(t, err, types.ErrDeadlineExceeded)
+	swapMsg.DeadlineHeight = 11
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+}
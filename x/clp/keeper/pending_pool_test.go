@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_PendingPool(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	admin, _ := sdk.AccAddressFromBech32(address)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:                 usdc.Symbol,
+		BaseDenom:             usdc.Symbol,
+		Decimals:              18,
+		Permissions:           []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+		MinPoolNativeAmount:   "1000000000",
+		MinPoolExternalAmount: "2000000000",
+		PoolApprovalRequired:  true,
+	})
+	creator := sdk.AccAddress("creator_____________")
+	funds := sdk.NewCoins(sdk.NewCoin(rowan.Symbol, sdk.NewInt(100000000000)), sdk.NewCoin(usdc.Symbol, sdk.NewInt(100000000000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, funds))
+
+	// The thresholds of the registry entry replace the default native threshold
+	createMsg := types.NewMsgCreatePool(creator, usdc, sdk.NewUint(999999999), sdk.NewUint(2000000000))
+	_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), &createMsg)
+	require.ErrorIs(t, err, types.ErrTotalAmountTooLow)
+	createMsg = types.NewMsgCreatePool(creator, usdc, sdk.NewUint(1000000000), sdk.NewUint(1999999999))
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(ctx), &createMsg)
+	require.ErrorIs(t, err, types.ErrTotalAmountTooLow)
+	createMsg = types.NewMsgCreatePool(creator, usdc, sdk.NewUint(10000000000), sdk.NewUint(10000000000))
+	_, err = msgServer.CreatePool(sdk.WrapSDKContext(ctx), &createMsg)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	require.True(t, pool.Pending)
+
+	res, err := querier.GetPendingPools(sdk.WrapSDKContext(ctx), &types.PendingPoolsReq{})
+	require.NoError(t, err)
+	require.Len(t, res.Pools, 1)
+	require.Equal(t, usdc.Symbol, res.Pools[0].ExternalAsset.Symbol)
+
+	// Pending pools take liquidity but cannot be swapped against
+	swapMsg := types.NewMsgSwap(creator, rowan, usdc, sdk.NewUint(1000000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.ErrorIs(t, err, types.ErrPoolPending)
+	addMsg := types.NewMsgAddLiquidity(creator, usdc, sdk.NewUint(1000000), sdk.NewUint(1000000))
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+
+	approveMsg := types.NewMsgApprovePendingPool(creator, usdc)
+	_, err = msgServer.ApprovePendingPool(sdk.WrapSDKContext(ctx), &approveMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	approveMsg = types.NewMsgApprovePendingPool(admin, usdc)
+	_, err = msgServer.ApprovePendingPool(sdk.WrapSDKContext(ctx), &approveMsg)
+	require.NoError(t, err)
+	_, err = msgServer.ApprovePendingPool(sdk.WrapSDKContext(ctx), &approveMsg)
+	require.ErrorIs(t, err, types.ErrPoolNotPending)
+
+	res, err = querier.GetPendingPools(sdk.WrapSDKContext(ctx), &types.PendingPoolsReq{})
+	require.NoError(t, err)
+	require.Empty(t, res.Pools)
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,13 +19,7 @@ func (k Keeper) PolicyStart(ctx sdk.Context) {
 	// compute number of epochs in policy period
 	numEpochsInPolicyPeriod := numBlocksInPolicyPeriod / pmtpPeriodEpochLength
 	// compute pmtp period block rate
-	//pmtpPeriodBlockRate = (1 + pmtpPeriodGovernanceRate).Pow(numEpochsInPolicyPeriod / numBlocksInPolicyPeriod) - 1
-	// set block rate
-	base := sdk.NewDec(1).Add(pmtpPeriodGovernanceRate).MustFloat64()
-	pow := float64(numEpochsInPolicyPeriod) / float64(numBlocksInPolicyPeriod)
-	firstSection := math.Pow(base, pow)
-	pmtpPeriodBlockRate := firstSection - 1
-	decBlockrate, err := sdk.NewDecFromStr(fmt.Sprintf("%.18f", pmtpPeriodBlockRate))
+	decBlockrate, err := CalcPmtpBlockRate(pmtpPeriodGovernanceRate, numEpochsInPolicyPeriod, numBlocksInPolicyPeriod)
 	if err != nil {
 		panic(err)
 	}
@@ -39,6 +32,34 @@ func (k Keeper) PolicyStart(ctx sdk.Context) {
 	})
 }
 
+// CalcPmtpBlockRate computes the block rate of a policy,
+// pmtpPeriodBlockRate = (1 + pmtpPeriodGovernanceRate).Pow(numEpochsInPolicyPeriod / numBlocksInPolicyPeriod) - 1
+// The fractional power is taken as a root followed by an integer power of sdk.Dec so that the rate is
+// computed with fixed point arithmetic only and is the same on every validator.
+func CalcPmtpBlockRate(pmtpPeriodGovernanceRate sdk.Dec, numEpochsInPolicyPeriod, numBlocksInPolicyPeriod int64) (sdk.Dec, error) {
+	if numBlocksInPolicyPeriod <= 0 || numEpochsInPolicyPeriod < 0 {
+		return sdk.Dec{}, fmt.Errorf("invalid policy period of %d epochs in %d blocks", numEpochsInPolicyPeriod, numBlocksInPolicyPeriod)
+	}
+	base := sdk.NewDec(1).Add(pmtpPeriodGovernanceRate)
+	if !base.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("governance rate %s must be greater than -1", pmtpPeriodGovernanceRate)
+	}
+	// reduce the exponent so that the root is as small as possible
+	divisor := gcd(numEpochsInPolicyPeriod, numBlocksInPolicyPeriod)
+	root, err := base.ApproxRoot(uint64(numBlocksInPolicyPeriod / divisor))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return root.Power(uint64(numEpochsInPolicyPeriod / divisor)).Sub(sdk.NewDec(1)), nil
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
 func (k Keeper) PolicyCalculations(ctx sdk.Context) sdk.Dec {
 	currentHeight := ctx.BlockHeight()
 	pmtpPeriodStartBlock := k.GetPmtpParams(ctx).PmtpPeriodStartBlock
@@ -46,27 +67,38 @@ func (k Keeper) PolicyCalculations(ctx sdk.Context) sdk.Dec {
 	pmtpPeriodBlockRate := rateParams.PmtpPeriodBlockRate
 	pmtpInterPolicyRate := rateParams.PmtpInterPolicyRate
 	// compute running rate
-	pmtpCurrentRunningRate := (sdk.NewDec(1).Add(pmtpPeriodBlockRate)).Power(uint64(currentHeight - pmtpPeriodStartBlock + 1)).Sub(sdk.NewDec(1))
-	pmtpCurrentRunningRate = pmtpCurrentRunningRate.Add(pmtpInterPolicyRate)
+	pmtpCurrentRunningRate := CalcPmtpRunningRate(pmtpPeriodBlockRate, pmtpInterPolicyRate, currentHeight-pmtpPeriodStartBlock+1)
 	// set running rate
 	k.SetPmtpCurrentRunningRate(ctx, pmtpCurrentRunningRate)
 	return pmtpCurrentRunningRate
 }
 
+// CalcPmtpRunningRate computes the running rate after numBlocks blocks of a policy,
+// pmtpCurrentRunningRate = (1 + pmtpPeriodBlockRate).Pow(numBlocks) - 1 + pmtpInterPolicyRate
+func CalcPmtpRunningRate(pmtpPeriodBlockRate, pmtpInterPolicyRate sdk.Dec, numBlocks int64) sdk.Dec {
+	return sdk.NewDec(1).Add(pmtpPeriodBlockRate).Power(uint64(numBlocks)).Sub(sdk.NewDec(1)).Add(pmtpInterPolicyRate)
+}
+
+// CalcPoolSwapPrices computes swap_price_native and swap_price_external of a pool for a running rate
+func (k Keeper) CalcPoolSwapPrices(ctx sdk.Context, pool types.Pool, pmtpCurrentRunningRate sdk.Dec) (sdk.Dec, sdk.Dec) {
+	normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+	// compute swap_price_native
+	swapPriceNative := CalcSwapPrice(types.GetSettlementAsset(), sdk.OneUint(), *pool.ExternalAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	// compute swap_price_external
+	swapPriceExternal := CalcSwapPrice(*pool.ExternalAsset, sdk.OneUint(), types.GetSettlementAsset(), pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	return sdk.MustNewDecFromStr(swapPriceNative.String()), sdk.MustNewDecFromStr(swapPriceExternal.String())
+}
+
 func (k Keeper) PolicyRun(ctx sdk.Context, pmtpCurrentRunningRate sdk.Dec) error {
 	pools := k.GetPools(ctx)
+	maxPriceChange := k.GetCircuitBreakerParams(ctx).MaxPriceChange
 	// compute swap prices for each pool
 	for _, pool := range pools {
-		normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
-		// compute swap_price_native
-		swapPriceNative := CalcSwapPrice(types.GetSettlementAsset(), sdk.OneUint(), *pool.ExternalAsset, *pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
-		// compute swap_price_external
-		swapPriceExternal := CalcSwapPrice(*pool.ExternalAsset, sdk.OneUint(), types.GetSettlementAsset(), *pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
-
-		pn := sdk.MustNewDecFromStr(swapPriceNative.String())
-		pe := sdk.MustNewDecFromStr(swapPriceExternal.String())
+		previousPriceNative := pool.SwapPriceNative
+		pn, pe := k.CalcPoolSwapPrices(ctx, *pool, pmtpCurrentRunningRate)
 		pool.SwapPriceNative = &pn
 		pool.SwapPriceExternal = &pe
+		k.checkPriceChange(ctx, pool, previousPriceNative, maxPriceChange)
 		// set pool
 		err := k.SetPool(ctx, pool)
 		if err != nil {
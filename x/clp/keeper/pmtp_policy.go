@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// SetPmtpPolicy stores a pmtp policy, pending policies are also indexed by start block
+func (k Keeper) SetPmtpPolicy(ctx sdk.Context, policy *types.PmtpPolicy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPmtpPolicyKey(policy.Id), k.cdc.MustMarshal(policy))
+	queueKey := types.GetPmtpPolicyQueueKey(policy.Params.PmtpPeriodStartBlock)
+	if policy.Status == types.PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING {
+		store.Set(queueKey, sdk.Uint64ToBigEndian(policy.Id))
+	} else if bz := store.Get(queueKey); bz != nil && sdk.BigEndianToUint64(bz) == policy.Id {
+		store.Delete(queueKey)
+	}
+}
+
+func (k Keeper) GetPmtpPolicy(ctx sdk.Context, id uint64) (types.PmtpPolicy, error) {
+	var policy types.PmtpPolicy
+	key := types.GetPmtpPolicyKey(id)
+	if !k.Exists(ctx, key) {
+		return policy, types.ErrPmtpPolicyDoesNotExist
+	}
+	store := ctx.KVStore(k.storeKey)
+	k.cdc.MustUnmarshal(store.Get(key), &policy)
+	return policy, nil
+}
+
+func (k Keeper) GetNextPmtpPolicyID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PmtpPolicyNextIDPrefix)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextPmtpPolicyID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PmtpPolicyNextIDPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// GetActivePmtpPolicy returns the policy currently copied to the pmtp params, if it was scheduled through the queue
+func (k Keeper) GetActivePmtpPolicy(ctx sdk.Context) (types.PmtpPolicy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PmtpActivePolicyPrefix)
+	if bz == nil {
+		return types.PmtpPolicy{}, false
+	}
+	policy, err := k.GetPmtpPolicy(ctx, sdk.BigEndianToUint64(bz))
+	if err != nil {
+		return types.PmtpPolicy{}, false
+	}
+	return policy, true
+}
+
+func (k Keeper) SetActivePmtpPolicyID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PmtpActivePolicyPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// GetPmtpPolicies Use GetPmtpPoliciesPaginated for RPC queries
+func (k Keeper) GetPmtpPolicies(ctx sdk.Context) []*types.PmtpPolicy {
+	var policies []*types.PmtpPolicy
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PmtpPolicyPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.PmtpPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, &policy)
+	}
+	return policies
+}
+
+// GetPendingPmtpPolicies returns the queued policies in ascending start block
+func (k Keeper) GetPendingPmtpPolicies(ctx sdk.Context) []types.PmtpPolicy {
+	var policies []types.PmtpPolicy
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PmtpPolicyQueuePrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		policy, err := k.GetPmtpPolicy(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+// GetPmtpPoliciesPaginated lists the policies in ascending id, an unspecified status lists all policies
+func (k Keeper) GetPmtpPoliciesPaginated(ctx sdk.Context, policyStatus types.PmtpPolicyStatus, pagination *query.PageRequest) ([]*types.PmtpPolicy, *query.PageResponse, error) {
+	var policies []*types.PmtpPolicy
+	store := ctx.KVStore(k.storeKey)
+	policyStore := prefix.NewStore(store, types.PmtpPolicyPrefix)
+	pageRes, err := query.FilteredPaginate(policyStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var policy types.PmtpPolicy
+		err := k.cdc.Unmarshal(value, &policy)
+		if err != nil {
+			return false, err
+		}
+		if policyStatus != types.PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED && policy.Status != policyStatus {
+			return false, nil
+		}
+		if accumulate {
+			policies = append(policies, &policy)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return policies, pageRes, nil
+}
+
+// SchedulePmtpPolicy queues a policy, policies may not overlap the running policy or each other
+func (k Keeper) SchedulePmtpPolicy(ctx sdk.Context, params types.PmtpParams) (types.PmtpPolicy, error) {
+	// Check to make sure new policy starts in the future so that PolicyStart from begin-block can be triggered
+	if params.PmtpPeriodStartBlock <= ctx.BlockHeight() {
+		return types.PmtpPolicy{}, fmt.Errorf("Start block cannot be in the past/current block")
+	}
+	// Check to see if the policy starts before the current policy has ended
+	current := k.GetPmtpParams(ctx)
+	if current.PmtpPeriodEndBlock >= ctx.BlockHeight() && params.PmtpPeriodStartBlock <= current.PmtpPeriodEndBlock {
+		return types.PmtpPolicy{}, types.ErrCannotStartPolicy
+	}
+	for _, pending := range k.GetPendingPmtpPolicies(ctx) {
+		if params.PmtpPeriodStartBlock <= pending.Params.PmtpPeriodEndBlock &&
+			pending.Params.PmtpPeriodStartBlock <= params.PmtpPeriodEndBlock {
+			return types.PmtpPolicy{}, types.ErrPmtpPolicyOverlap
+		}
+	}
+	id := k.GetNextPmtpPolicyID(ctx)
+	policy := types.PmtpPolicy{
+		Id:               id,
+		Params:           params,
+		Status:           types.PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING,
+		FinalRunningRate: sdk.ZeroDec(),
+	}
+	k.SetPmtpPolicy(ctx, &policy)
+	k.SetNextPmtpPolicyID(ctx, id+1)
+	return policy, nil
+}
+
+// AddPmtpPolicy queues a policy for the period, an empty governance rate keeps the rate of the
+// last queued policy or of the current params
+func (k Keeper) AddPmtpPolicy(ctx sdk.Context, governanceRate string, epochLength, startBlock, endBlock int64) (types.PmtpPolicy, error) {
+	params := types.PmtpParams{
+		PmtpPeriodGovernanceRate: k.GetPmtpParams(ctx).PmtpPeriodGovernanceRate,
+		PmtpPeriodEpochLength:    epochLength,
+		PmtpPeriodStartBlock:     startBlock,
+		PmtpPeriodEndBlock:       endBlock,
+	}
+	// Default to the governance rate of the last queued policy
+	pending := k.GetPendingPmtpPolicies(ctx)
+	if len(pending) > 0 {
+		params.PmtpPeriodGovernanceRate = pending[len(pending)-1].Params.PmtpPeriodGovernanceRate
+	}
+	if governanceRate != "" {
+		rGov, err := sdk.NewDecFromStr(governanceRate)
+		if err != nil {
+			return types.PmtpPolicy{}, err
+		}
+		params.PmtpPeriodGovernanceRate = rGov
+	}
+	policy, err := k.SchedulePmtpPolicy(ctx, params)
+	if err != nil {
+		return policy, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddNewPmtpPolicy,
+		sdk.NewAttribute(types.AttributeKeyPmtpPolicyID, strconv.FormatUint(policy.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyPmtpPolicyParams, params.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return policy, nil
+}
+
+// DequeuePmtpPolicy cancels a pending policy and removes it from the queue
+func (k Keeper) DequeuePmtpPolicy(ctx sdk.Context, id uint64) (types.PmtpPolicy, error) {
+	policy, err := k.GetPmtpPolicy(ctx, id)
+	if err != nil {
+		return policy, err
+	}
+	if policy.Status != types.PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING {
+		return policy, types.ErrPmtpPolicyNotPending
+	}
+	policy.Status = types.PmtpPolicyStatus_PMTP_POLICY_STATUS_CANCELLED
+	k.SetPmtpPolicy(ctx, &policy)
+	return policy, nil
+}
+
+// ActivatePmtpPolicy copies the params of the policy queued for the current block to the pmtp params
+func (k Keeper) ActivatePmtpPolicy(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPmtpPolicyQueueKey(ctx.BlockHeight()))
+	if bz == nil {
+		return false
+	}
+	policy, err := k.GetPmtpPolicy(ctx, sdk.BigEndianToUint64(bz))
+	if err != nil {
+		panic(err)
+	}
+	policy.Status = types.PmtpPolicyStatus_PMTP_POLICY_STATUS_ACTIVE
+	k.SetPmtpPolicy(ctx, &policy)
+	k.SetActivePmtpPolicyID(ctx, policy.Id)
+	params := policy.Params
+	k.SetPmtpParams(ctx, &params)
+	// Setting it to zero so that PolicyStart is triggered
+	k.SetPmtpEpoch(ctx, types.PmtpEpoch{
+		EpochCounter: 0,
+		BlockCounter: 0,
+	})
+	return true
+}
+
+// CompleteActivePmtpPolicy records the final running rate of the running policy
+func (k Keeper) CompleteActivePmtpPolicy(ctx sdk.Context, finalRunningRate sdk.Dec) {
+	policy, found := k.GetActivePmtpPolicy(ctx)
+	if !found {
+		return
+	}
+	policy.Status = types.PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED
+	policy.FinalRunningRate = finalRunningRate
+	policy.Params.PmtpPeriodEndBlock = k.GetPmtpParams(ctx).PmtpPeriodEndBlock
+	k.SetPmtpPolicy(ctx, &policy)
+	ctx.KVStore(k.storeKey).Delete(types.PmtpActivePolicyPrefix)
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_PmtpPolicyQueue(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	ctx = ctx.WithBlockHeight(10)
+	app.TokenRegistryKeeper.SetAdminAccount(ctx, &tokenregistrytypes.AdminAccount{
+		AdminType:    tokenregistrytypes.AdminType_PMTPREWARDS,
+		AdminAddress: admin,
+	})
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	adminAddr, _ := sdk.AccAddressFromBech32(admin)
+	otherAddr, _ := sdk.AccAddressFromBech32(other)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+
+	policyMsg := func(signer, rate string, start, end int64) *types.MsgUpdatePmtpParams {
+		return &types.MsgUpdatePmtpParams{
+			Signer:                   signer,
+			PmtpPeriodGovernanceRate: rate,
+			PmtpPeriodEpochLength:    1,
+			PmtpPeriodStartBlock:     start,
+			PmtpPeriodEndBlock:       end,
+		}
+	}
+
+	_, err := msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(other, "0.1", 12, 15))
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	_, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.1", 10, 15))
+	require.Error(t, err)
+
+	res, err := msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.1", 12, 15))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.PolicyId)
+	_, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.1", 14, 20))
+	require.ErrorIs(t, err, types.ErrPmtpPolicyOverlap)
+	_, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.1", 11, 12))
+	require.ErrorIs(t, err, types.ErrPmtpPolicyOverlap)
+	// A policy queued after a later one is still ordered by start block
+	res, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.2", 30, 39))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.PolicyId)
+	// The governance rate defaults to the one of the last queued policy
+	res, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "", 16, 19))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.PolicyId)
+	policy, err := app.ClpKeeper.GetPmtpPolicy(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), policy.Params.PmtpPeriodGovernanceRate)
+	pending := app.ClpKeeper.GetPendingPmtpPolicies(ctx)
+	require.Len(t, pending, 3)
+	require.Equal(t, []uint64{1, 3, 2}, []uint64{pending[0].Id, pending[1].Id, pending[2].Id})
+
+	cancelMsg := types.NewMsgCancelPmtpPolicy(otherAddr, 2)
+	_, err = msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	cancelMsg = types.NewMsgCancelPmtpPolicy(adminAddr, 2)
+	_, err = msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.NoError(t, err)
+	_, err = msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrPmtpPolicyNotPending)
+	cancelMsg = types.NewMsgCancelPmtpPolicy(adminAddr, 99)
+	_, err = msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrPmtpPolicyDoesNotExist)
+	// The start block of a cancelled policy can be reused
+	_, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.3", 30, 31))
+	require.NoError(t, err)
+
+	queryRes, err := querier.GetPmtpPolicies(sdk.WrapSDKContext(ctx), &types.PmtpPoliciesReq{})
+	require.NoError(t, err)
+	require.Len(t, queryRes.Policies, 4)
+	queryRes, err = querier.GetPmtpPolicies(sdk.WrapSDKContext(ctx), &types.PmtpPoliciesReq{Status: types.PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING})
+	require.NoError(t, err)
+	require.Len(t, queryRes.Policies, 3)
+	queryRes, err = querier.GetPmtpPolicies(sdk.WrapSDKContext(ctx), &types.PmtpPoliciesReq{Status: types.PmtpPolicyStatus_PMTP_POLICY_STATUS_CANCELLED})
+	require.NoError(t, err)
+	require.Len(t, queryRes.Policies, 1)
+	require.Equal(t, uint64(2), queryRes.Policies[0].Id)
+
+	// Activating copies the params of the policy starting at the current block
+	require.False(t, app.ClpKeeper.ActivatePmtpPolicy(ctx.WithBlockHeight(11)))
+	ctx = ctx.WithBlockHeight(12)
+	require.True(t, app.ClpKeeper.ActivatePmtpPolicy(ctx))
+	require.Equal(t, int64(15), app.ClpKeeper.GetPmtpParams(ctx).PmtpPeriodEndBlock)
+	// Policies may not start before the running policy has ended
+	_, err = msgServer.UpdatePmtpParams(sdk.WrapSDKContext(ctx), policyMsg(admin, "0.1", 13, 14))
+	require.ErrorIs(t, err, types.ErrCannotStartPolicy)
+	cancelMsg = types.NewMsgCancelPmtpPolicy(adminAddr, 1)
+	_, err = msgServer.CancelPmtpPolicy(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrPmtpPolicyNotPending)
+
+	app.ClpKeeper.CompleteActivePmtpPolicy(ctx, sdk.MustNewDecFromStr("0.4641"))
+	policy, err = app.ClpKeeper.GetPmtpPolicy(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED, policy.Status)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4641"), policy.FinalRunningRate)
+	_, found := app.ClpKeeper.GetActivePmtpPolicy(ctx)
+	require.False(t, found)
+}
+
+func TestQuerier_SimulatePmtpPolicy(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	app.ClpKeeper.SetPmtpRateParams(ctx, types.PmtpRateParams{
+		PmtpPeriodBlockRate:    sdk.ZeroDec(),
+		PmtpCurrentRunningRate: sdk.ZeroDec(),
+		PmtpInterPolicyRate:    sdk.ZeroDec(),
+	})
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+
+	req := types.NewQueryReqSimulatePmtpPolicy(sdk.MustNewDecFromStr("0.1"), 1, 10, 13, []int64{5, 12, 20})
+	res, err := querier.SimulatePmtpPolicy(sdk.WrapSDKContext(ctx), &req)
+	require.NoError(t, err)
+	require.Equal(t, "0.100000000000000000", res.BlockRate.String())
+	require.Len(t, res.Epochs, 4)
+	for i, rate := range []string{"0.100000000000000000", "0.210000000000000000", "0.331000000000000000", "0.464100000000000000"} {
+		require.Equal(t, int64(10+i), res.Epochs[i].EndHeight)
+		require.Equal(t, rate, res.Epochs[i].RunningRate.String())
+	}
+	require.Len(t, res.Heights, 3)
+	// The rate is flat before the policy starts and stays at its final value after it ends
+	require.Equal(t, "0.000000000000000000", res.Heights[0].RunningRate.String())
+	require.Equal(t, "0.331000000000000000", res.Heights[1].RunningRate.String())
+	require.Equal(t, "0.464100000000000000", res.Heights[2].RunningRate.String())
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Len(t, res.Heights[1].Pools, 1)
+	swapPriceNative, swapPriceExternal := app.ClpKeeper.CalcPoolSwapPrices(ctx, pool, res.Heights[1].RunningRate)
+	require.Equal(t, "ceth", res.Heights[1].Pools[0].Symbol)
+	require.Equal(t, swapPriceNative.String(), res.Heights[1].Pools[0].SwapPriceNative.String())
+	require.Equal(t, swapPriceExternal.String(), res.Heights[1].Pools[0].SwapPriceExternal.String())
+
+	req = types.NewQueryReqSimulatePmtpPolicy(sdk.MustNewDecFromStr("0.1"), 1, 10, 13, make([]int64, clpkeeper.MaxSimulatedHeights+1))
+	_, err = querier.SimulatePmtpPolicy(sdk.WrapSDKContext(ctx), &req)
+	require.Error(t, err)
+	req = types.NewQueryReqSimulatePmtpPolicy(sdk.MustNewDecFromStr("0.1"), 1, 13, 10, nil)
+	_, err = querier.SimulatePmtpPolicy(sdk.WrapSDKContext(ctx), &req)
+	require.Error(t, err)
+	req = types.NewQueryReqSimulatePmtpPolicy(sdk.Dec{}, 1, 10, 13, nil)
+	_, err = querier.SimulatePmtpPolicy(sdk.WrapSDKContext(ctx), &req)
+	require.Error(t, err)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -35,3 +37,50 @@ func (k Keeper) SetPmtpInterPolicyRate(ctx sdk.Context, interPolicyRate sdk.Dec)
 	currentParams.PmtpInterPolicyRate = interPolicyRate
 	k.SetPmtpRateParams(ctx, currentParams)
 }
+
+// UpdatePmtpRates sets the non-empty block and running rates while no policy is running, and ends
+// the running policy if endPolicy is set. It returns whether a policy was ended.
+func (k Keeper) UpdatePmtpRates(ctx sdk.Context, blockRate, runningRate string, endPolicy bool) (bool, error) {
+	params := k.GetPmtpParams(ctx)
+	rateParams := k.GetPmtpRateParams(ctx)
+
+	// Set Block Rate is needed only if no policy is presently executing
+	if blockRate != "" && !k.IsInsidePmtpWindow(ctx) {
+		rate, err := sdk.NewDecFromStr(blockRate)
+		if err != nil {
+			return false, err
+		}
+		rateParams.PmtpPeriodBlockRate = rate
+	}
+
+	// Set Running Rate if Needed only if no policy is presently executing
+	if runningRate != "" && !k.IsInsidePmtpWindow(ctx) {
+		rate, err := sdk.NewDecFromStr(runningRate)
+		if err != nil {
+			return false, err
+		}
+		rateParams.PmtpCurrentRunningRate = rate
+		// inter policy rate should always equal running rate between policies
+		rateParams.PmtpInterPolicyRate = rate
+	}
+	k.SetPmtpRateParams(ctx, rateParams)
+	// End Policy If Needed , returns if not policy is presently
+	if !endPolicy || !k.IsInsidePmtpWindow(ctx) {
+		return false, nil
+	}
+	params.PmtpPeriodEndBlock = ctx.BlockHeight()
+	k.SetPmtpParams(ctx, params)
+	k.SetPmtpEpoch(ctx, types.PmtpEpoch{
+		EpochCounter: 0,
+		BlockCounter: 0,
+	})
+	k.SetPmtpInterPolicyRate(ctx, rateParams.PmtpCurrentRunningRate)
+	k.CompleteActivePmtpPolicy(ctx, rateParams.PmtpCurrentRunningRate)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEndPmtpPolicy,
+		sdk.NewAttribute(types.AttributeKeyPmtpPolicyParams, params.String()),
+		sdk.NewAttribute(types.AttributeKeyPmtpRateParams, rateParams.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
 	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -108,21 +109,15 @@ func TestKeeper_PolicyRun(t *testing.T) {
 				bz, _ := app.AppCodec().MarshalJSON(trGs)
 				genesisState["tokenregistry"] = bz
 
+				var balances []banktypes.Balance
 				if tc.createBalance {
-					balances := []banktypes.Balance{
-						{
-							Address: tc.address,
-							Coins: sdk.Coins{
-								sdk.NewCoin(tc.poolAsset, tc.externalBalance),
-								sdk.NewCoin("rowan", tc.nativeBalance),
-							},
+					balances = append(balances, banktypes.Balance{
+						Address: tc.address,
+						Coins: sdk.Coins{
+							sdk.NewCoin(tc.poolAsset, tc.externalBalance),
+							sdk.NewCoin("rowan", tc.nativeBalance),
 						},
-					}
-
-					bankGs := banktypes.DefaultGenesisState()
-					bankGs.Balances = append(bankGs.Balances, balances...)
-					bz, _ = app.AppCodec().MarshalJSON(bankGs)
-					genesisState["bank"] = bz
+					})
 				}
 
 				if tc.createPool {
@@ -134,13 +129,14 @@ func TestKeeper_PolicyRun(t *testing.T) {
 							PoolUnits:            tc.poolUnits,
 						},
 					}
+					balances = append(balances, test.GetPoolsModuleBalance(pools))
 					clpGs := types.DefaultGenesisState()
 					if tc.createLPs {
 						lps := []*types.LiquidityProvider{
 							{
 								Asset:                    &types.Asset{Symbol: tc.poolAsset},
 								LiquidityProviderAddress: tc.address,
-								LiquidityProviderUnits:   tc.nativeAssetAmount,
+								LiquidityProviderUnits:   tc.poolUnits,
 							},
 						}
 						clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -153,6 +149,10 @@ func TestKeeper_PolicyRun(t *testing.T) {
 					bz, _ = app.AppCodec().MarshalJSON(clpGs)
 					genesisState["clp"] = bz
 				}
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances, balances...)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
 
 				return genesisState
 			})
@@ -180,3 +180,38 @@ func TestKeeper_PolicyRun(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcPmtpBlockRate(t *testing.T) {
+	testcases := []struct {
+		name           string
+		governanceRate string
+		numEpochs      int64
+		numBlocks      int64
+		expected       string
+		// legacy is the rate computed with math.Pow before the fixed point implementation
+		legacy string
+	}{
+		{name: "one block epochs", governanceRate: "0.1", numEpochs: 40, numBlocks: 40, expected: "0.100000000000000000", legacy: "0.100000000000000089"},
+		{name: "two block epochs", governanceRate: "0.1", numEpochs: 20, numBlocks: 40, expected: "0.048808848170151547", legacy: "0.048808848170151631"},
+		{name: "single epoch", governanceRate: "0.1", numEpochs: 1, numBlocks: 40, expected: "0.002385595510632814", legacy: "0.002385595510632887"},
+		{name: "uneven epochs", governanceRate: "0.1", numEpochs: 13, numBlocks: 40, expected: "0.031460550941185325", legacy: "0.031460550941185383"},
+		{name: "daily epochs", governanceRate: "0.05", numEpochs: 30, numBlocks: 432000, expected: "0.000003388211585076", legacy: "0.000003388211585076"},
+		{name: "large governance rate", governanceRate: "2", numEpochs: 7, numBlocks: 1000, expected: "0.007719932217399943", legacy: "0.007719932217399972"},
+		{name: "negative governance rate", governanceRate: "-0.5", numEpochs: 1, numBlocks: 10, expected: "-0.066967008463192584", legacy: "-0.066967008463192590"},
+		{name: "zero governance rate", governanceRate: "0", numEpochs: 1, numBlocks: 10, expected: "0.000000000000000000", legacy: "0.000000000000000000"},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rate, err := clpkeeper.CalcPmtpBlockRate(sdk.MustNewDecFromStr(tc.governanceRate), tc.numEpochs, tc.numBlocks)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rate.String())
+			require.True(t, rate.Sub(sdk.MustNewDecFromStr(tc.legacy)).Abs().LTE(sdk.NewDecWithPrec(1, 15)))
+		})
+	}
+
+	_, err := clpkeeper.CalcPmtpBlockRate(sdk.MustNewDecFromStr("0.1"), 1, 0)
+	require.Error(t, err)
+	_, err = clpkeeper.CalcPmtpBlockRate(sdk.MustNewDecFromStr("-1"), 1, 10)
+	require.Error(t, err)
+}
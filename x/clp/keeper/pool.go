@@ -90,6 +90,31 @@ func (k Keeper) GetPoolsPaginated(ctx sdk.Context, pagination *query.PageRequest
 	return poolList, pageRes, nil
 }
 
+// GetPendingPoolsPaginated returns the pools waiting for approval before they can be swapped against
+func (k Keeper) GetPendingPoolsPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]*types.Pool, *query.PageResponse, error) {
+	var poolList []*types.Pool
+	store := ctx.KVStore(k.storeKey)
+	poolStore := prefix.NewStore(store, types.PoolPrefix)
+	pageRes, err := query.FilteredPaginate(poolStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var pool types.Pool
+		err := k.cdc.Unmarshal(value, &pool)
+		if err != nil {
+			return false, err
+		}
+		if !pool.Pending {
+			return false, nil
+		}
+		if accumulate {
+			poolList = append(poolList, &pool)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return poolList, pageRes, nil
+}
+
 func (k Keeper) DestroyPool(ctx sdk.Context, symbol string) error {
 	store := ctx.KVStore(k.storeKey)
 	key, err := types.GetPoolKey(symbol, types.GetSettlementAsset().Symbol)
@@ -100,6 +125,11 @@ func (k Keeper) DestroyPool(ctx sdk.Context, symbol string) error {
 		return types.ErrPoolDoesNotExist
 	}
 	store.Delete(key)
+	// A pool created again for the same asset starts a new price history
+	k.DeleteTwapRecords(ctx, symbol)
+	k.DeletePoolFeeAccrual(ctx, symbol)
+	k.DeletePoolHistory(ctx, symbol)
+	k.DeletePoolStats(ctx, symbol)
 	return nil
 }
 
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+func (k Keeper) SetPoolSnapshot(ctx sdk.Context, symbol string, snapshot types.PoolSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolSnapshotKey(symbol, snapshot.Height), k.cdc.MustMarshal(&snapshot))
+}
+
+func (k Keeper) GetPoolSnapshot(ctx sdk.Context, symbol string, height int64) (types.PoolSnapshot, bool) {
+	var snapshot types.PoolSnapshot
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolSnapshotKey(symbol, height))
+	if bz == nil {
+		return snapshot, false
+	}
+	k.cdc.MustUnmarshal(bz, &snapshot)
+	return snapshot, true
+}
+
+func (k Keeper) SetPoolHistoryIndex(ctx sdk.Context, symbol string, index types.PoolHistoryIndex) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolHistoryIndexKey(symbol), k.cdc.MustMarshal(&index))
+}
+
+func (k Keeper) GetPoolHistoryIndex(ctx sdk.Context, symbol string) (types.PoolHistoryIndex, bool) {
+	var index types.PoolHistoryIndex
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolHistoryIndexKey(symbol))
+	if bz == nil {
+		return index, false
+	}
+	k.cdc.MustUnmarshal(bz, &index)
+	return index, true
+}
+
+// GetPoolSnapshots lists the snapshots of a pool in ascending height
+func (k Keeper) GetPoolSnapshots(ctx sdk.Context, symbol string) []types.PoolSnapshot {
+	var snapshots []types.PoolSnapshot
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolSnapshotPoolPrefix(symbol))
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PoolSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// GetPoolSnapshotsPaginated lists the snapshots of a pool in ascending height between fromHeight and toHeight,
+// zero heights leave the range open
+func (k Keeper) GetPoolSnapshotsPaginated(ctx sdk.Context, symbol string, fromHeight, toHeight int64, pagination *query.PageRequest) ([]types.PoolSnapshot, *query.PageResponse, error) {
+	snapshots := make([]types.PoolSnapshot, 0)
+	store := ctx.KVStore(k.storeKey)
+	snapshotStore := prefix.NewStore(store, types.GetPoolSnapshotPoolPrefix(symbol))
+	pageRes, err := query.FilteredPaginate(snapshotStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var snapshot types.PoolSnapshot
+		err := k.cdc.Unmarshal(value, &snapshot)
+		if err != nil {
+			return false, err
+		}
+		if snapshot.Height < fromHeight || (toHeight > 0 && snapshot.Height > toHeight) {
+			return false, nil
+		}
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return snapshots, pageRes, nil
+}
+
+// DeletePoolHistory removes every snapshot of a pool
+func (k Keeper) DeletePoolHistory(ctx sdk.Context, symbol string) {
+	k.prunePoolSnapshots(ctx, symbol, func(types.PoolSnapshot) bool { return true })
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolHistoryIndexKey(symbol))
+}
+
+// prunePoolSnapshots deletes the snapshots of a pool in ascending height until shouldDelete returns false
+func (k Keeper) prunePoolSnapshots(ctx sdk.Context, symbol string, shouldDelete func(types.PoolSnapshot) bool) {
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolSnapshotPoolPrefix(symbol))
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.PoolSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if !shouldDelete(snapshot) {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	err := iterator.Close()
+	if err != nil {
+		panic(err)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordPoolSnapshots stores a snapshot of every pool every PoolSnapshotInterval blocks
+// and prunes the snapshots older than the retention period
+func (k Keeper) RecordPoolSnapshots(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	if height%types.PoolSnapshotInterval != 0 {
+		return
+	}
+	cutoff := height - types.PoolSnapshotRetention
+	pmtpRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	for _, pool := range k.GetPools(ctx) {
+		symbol := pool.ExternalAsset.Symbol
+		snapshot := types.PoolSnapshot{
+			Height:                 height,
+			Timestamp:              ctx.BlockTime().Unix(),
+			NativeAssetBalance:     pool.NativeAssetBalance,
+			ExternalAssetBalance:   pool.ExternalAssetBalance,
+			PoolUnits:              pool.PoolUnits,
+			SwapPriceNative:        sdk.ZeroDec(),
+			SwapPriceExternal:      sdk.ZeroDec(),
+			PmtpCurrentRunningRate: pmtpRate,
+		}
+		if pool.SwapPriceNative != nil {
+			snapshot.SwapPriceNative = *pool.SwapPriceNative
+		}
+		if pool.SwapPriceExternal != nil {
+			snapshot.SwapPriceExternal = *pool.SwapPriceExternal
+		}
+		k.SetPoolSnapshot(ctx, symbol, snapshot)
+		index, found := k.GetPoolHistoryIndex(ctx, symbol)
+		if !found {
+			index.FirstHeight = height
+		}
+		index.LastHeight = height
+		if index.FirstHeight < cutoff {
+			k.prunePoolSnapshots(ctx, symbol, func(s types.PoolSnapshot) bool {
+				if s.Height < cutoff {
+					return true
+				}
+				index.FirstHeight = s.Height
+				return false
+			})
+		}
+		k.SetPoolHistoryIndex(ctx, symbol, index)
+	}
+}
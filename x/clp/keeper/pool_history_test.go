@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_RecordPoolSnapshots(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	querier := clpkeeper.Querier{Keeper: clpKeeper}
+	pool := types.Pool{
+		ExternalAsset:        &types.Asset{Symbol: "eth"},
+		NativeAssetBalance:   sdk.NewUint(1000),
+		ExternalAssetBalance: sdk.NewUint(1000),
+		PoolUnits:            sdk.NewUint(1000),
+	}
+	require.NoError(t, clpKeeper.SetPool(ctx, &pool))
+	record := func(height int64, nativeBalance uint64) {
+		ctx = ctx.WithBlockHeight(height)
+		pool.NativeAssetBalance = sdk.NewUint(nativeBalance)
+		require.NoError(t, clpKeeper.SetPool(ctx, &pool))
+		clpKeeper.RecordPoolSnapshots(ctx)
+	}
+
+	// Only heights on the interval are recorded
+	record(types.PoolSnapshotInterval-1, 500)
+	_, found := clpKeeper.GetPoolHistoryIndex(ctx, "eth")
+	require.False(t, found)
+	for i := int64(1); i <= 4; i++ {
+		record(i*types.PoolSnapshotInterval, uint64(1000*i))
+	}
+	snapshot, found := clpKeeper.GetPoolSnapshot(ctx, "eth", 2*types.PoolSnapshotInterval)
+	require.True(t, found)
+	require.Equal(t, "2000", snapshot.NativeAssetBalance.String())
+	require.Equal(t, "1000", snapshot.PoolUnits.String())
+
+	res, err := querier.GetPoolHistory(sdk.WrapSDKContext(ctx), &types.PoolHistoryReq{
+		Symbol:     "eth",
+		FromHeight: 2 * types.PoolSnapshotInterval,
+		ToHeight:   3 * types.PoolSnapshotInterval,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 2)
+	require.Equal(t, int64(2*types.PoolSnapshotInterval), res.Snapshots[0].Height)
+	require.Equal(t, "3000", res.Snapshots[1].NativeAssetBalance.String())
+	require.Equal(t, int64(types.PoolSnapshotInterval), res.FirstHeight)
+	require.Equal(t, int64(4*types.PoolSnapshotInterval), res.LastHeight)
+	res, err = querier.GetPoolHistory(sdk.WrapSDKContext(ctx), &types.PoolHistoryReq{
+		Symbol:     "eth",
+		Pagination: &query.PageRequest{Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 3)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// Snapshots older than the retention period are pruned
+	record(types.PoolSnapshotRetention+2*types.PoolSnapshotInterval, 9000)
+	index, found := clpKeeper.GetPoolHistoryIndex(ctx, "eth")
+	require.True(t, found)
+	require.Equal(t, int64(2*types.PoolSnapshotInterval), index.FirstHeight)
+	_, found = clpKeeper.GetPoolSnapshot(ctx, "eth", types.PoolSnapshotInterval)
+	require.False(t, found)
+
+	_, err = querier.GetPoolHistory(sdk.WrapSDKContext(ctx), &types.PoolHistoryReq{Symbol: "cusdc"})
+	require.Error(t, err)
+	require.NoError(t, clpKeeper.DestroyPool(ctx, "eth"))
+	_, found = clpKeeper.GetPoolHistoryIndex(ctx, "eth")
+	require.False(t, found)
+}
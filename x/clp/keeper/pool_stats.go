@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+func (k Keeper) SetPoolStatsBucket(ctx sdk.Context, symbol string, bucket types.PoolStatsBucket) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolStatsBucketKey(symbol, bucket.Hour), k.cdc.MustMarshal(&bucket))
+}
+
+func (k Keeper) GetPoolStatsBucket(ctx sdk.Context, symbol string, hour int64) (types.PoolStatsBucket, bool) {
+	var bucket types.PoolStatsBucket
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolStatsBucketKey(symbol, hour))
+	if bz == nil {
+		return bucket, false
+	}
+	k.cdc.MustUnmarshal(bz, &bucket)
+	return bucket, true
+}
+
+// GetPoolStatsBuckets lists the stats buckets of a pool in ascending time
+func (k Keeper) GetPoolStatsBuckets(ctx sdk.Context, symbol string) []types.PoolStatsBucket {
+	var buckets []types.PoolStatsBucket
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolStatsPoolPrefix(symbol))
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.PoolStatsBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// DeletePoolStats removes every stats bucket of a pool
+func (k Keeper) DeletePoolStats(ctx sdk.Context, symbol string) {
+	k.prunePoolStats(ctx, symbol, func(types.PoolStatsBucket) bool { return true })
+}
+
+// prunePoolStats deletes the stats buckets of a pool in ascending time until shouldDelete returns false
+func (k Keeper) prunePoolStats(ctx sdk.Context, symbol string, shouldDelete func(types.PoolStatsBucket) bool) {
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolStatsPoolPrefix(symbol))
+	for ; iterator.Valid(); iterator.Next() {
+		var bucket types.PoolStatsBucket
+		k.cdc.MustUnmarshal(iterator.Value(), &bucket)
+		if !shouldDelete(bucket) {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	err := iterator.Close()
+	if err != nil {
+		panic(err)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// updatePoolStatsBucket applies update to the bucket of the current hour of a pool. Opening a new bucket
+// prunes the buckets older than the retention period.
+func (k Keeper) updatePoolStatsBucket(ctx sdk.Context, symbol string, update func(*types.PoolStatsBucket)) {
+	now := ctx.BlockTime().Unix()
+	hour := now - now%types.PoolStatsBucketDuration
+	bucket, found := k.GetPoolStatsBucket(ctx, symbol, hour)
+	if !found {
+		bucket = types.NewPoolStatsBucket(hour)
+		cutoff := now - types.PoolStatsRetention
+		k.prunePoolStats(ctx, symbol, func(b types.PoolStatsBucket) bool {
+			return b.Hour+types.PoolStatsBucketDuration <= cutoff
+		})
+	}
+	update(&bucket)
+	k.SetPoolStatsBucket(ctx, symbol, bucket)
+}
+
+// RecordSwapStats adds a swap of sentAmount to the pool of symbol and its liquidity fee, paid in receivedAsset,
+// to the pool stats
+func (k Keeper) RecordSwapStats(ctx sdk.Context, symbol string, sentAmount sdk.Uint, receivedAsset types.Asset, liquidityFee sdk.Uint) {
+	k.updatePoolStatsBucket(ctx, symbol, func(bucket *types.PoolStatsBucket) {
+		if receivedAsset.Equals(types.GetSettlementAsset()) {
+			bucket.VolumeExternal = bucket.VolumeExternal.Add(sentAmount)
+			bucket.FeesNative = bucket.FeesNative.Add(liquidityFee)
+		} else {
+			bucket.VolumeNative = bucket.VolumeNative.Add(sentAmount)
+			bucket.FeesExternal = bucket.FeesExternal.Add(liquidityFee)
+		}
+		bucket.SwapCount++
+	})
+}
+
+// RecordRewardStats adds the liquidity mining rewards emitted to the pool of symbol to the pool stats
+func (k Keeper) RecordRewardStats(ctx sdk.Context, symbol string, rewards sdk.Coin) {
+	k.updatePoolStatsBucket(ctx, symbol, func(bucket *types.PoolStatsBucket) {
+		bucket.RewardEmissions = bucket.RewardEmissions.Add(rewards)
+	})
+}
+
+// GetPoolStatsWindow sums the stats buckets of a pool that overlap the last duration seconds
+func (k Keeper) GetPoolStatsWindow(ctx sdk.Context, symbol string, duration int64) types.PoolStatsWindow {
+	window := types.PoolStatsWindow{
+		Duration:        duration,
+		VolumeNative:    sdk.ZeroUint(),
+		VolumeExternal:  sdk.ZeroUint(),
+		FeesNative:      sdk.ZeroUint(),
+		FeesExternal:    sdk.ZeroUint(),
+		RewardEmissions: sdk.NewCoins(),
+	}
+	cutoff := ctx.BlockTime().Unix() - duration
+	for _, bucket := range k.GetPoolStatsBuckets(ctx, symbol) {
+		if bucket.Hour+types.PoolStatsBucketDuration <= cutoff {
+			continue
+		}
+		window.VolumeNative = window.VolumeNative.Add(bucket.VolumeNative)
+		window.VolumeExternal = window.VolumeExternal.Add(bucket.VolumeExternal)
+		window.FeesNative = window.FeesNative.Add(bucket.FeesNative)
+		window.FeesExternal = window.FeesExternal.Add(bucket.FeesExternal)
+		window.RewardEmissions = window.RewardEmissions.Add(bucket.RewardEmissions...)
+		window.SwapCount += bucket.SwapCount
+	}
+	return window
+}
+
+// GetPoolStats returns the stats of a pool over the last day and week, the yearly rates extrapolate the week
+// without compounding
+func (k Keeper) GetPoolStats(ctx sdk.Context, pool types.Pool) types.PoolStatsRes {
+	symbol := pool.ExternalAsset.Symbol
+	week := k.GetPoolStatsWindow(ctx, symbol, types.PoolStatsRetention)
+	res := types.PoolStatsRes{
+		Symbol:    symbol,
+		Day:       k.GetPoolStatsWindow(ctx, symbol, 24*60*60),
+		Week:      week,
+		FeeApr:    sdk.ZeroDec(),
+		RewardApr: sdk.ZeroDec(),
+		Height:    ctx.BlockHeight(),
+	}
+	if pool.NativeAssetBalance.IsZero() || pool.ExternalAssetBalance.IsZero() {
+		return res
+	}
+	nativeBalance := sdk.NewDecFromBigInt(pool.NativeAssetBalance.BigInt())
+	// External amounts are valued in rowan at the pool price
+	toNative := func(external sdk.Uint) sdk.Dec {
+		return sdk.NewDecFromBigInt(external.BigInt()).Mul(nativeBalance).Quo(sdk.NewDecFromBigInt(pool.ExternalAssetBalance.BigInt()))
+	}
+	poolValue := nativeBalance.MulInt64(2)
+	weeksPerYear := sdk.NewDec(365).QuoInt64(7)
+	fees := sdk.NewDecFromBigInt(week.FeesNative.BigInt()).Add(toNative(week.FeesExternal))
+	res.FeeApr = fees.Quo(poolValue).Mul(weeksPerYear)
+	rewards := sdk.NewDecFromInt(week.RewardEmissions.AmountOf(types.GetSettlementAsset().Symbol)).
+		Add(toNative(sdk.NewUintFromBigInt(week.RewardEmissions.AmountOf(symbol).BigInt())))
+	res.RewardApr = rewards.Quo(poolValue).Mul(weeksPerYear)
+	return res
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_PoolStats(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	clpKeeper := app.ClpKeeper
+	msgServer := clpkeeper.NewMsgServerImpl(clpKeeper)
+	querier := clpkeeper.Querier{Keeper: clpKeeper}
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+	start := time.Unix(1000*types.PoolStatsBucketDuration, 0)
+	swap := func(at time.Time, sent, received types.Asset, amount uint64) {
+		ctx = ctx.WithBlockTime(at)
+		msg := types.NewMsgSwap(signer, sent, received, sdk.NewUint(amount), sdk.ZeroUint())
+		_, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+	}
+
+	swap(start, eth, rowan, 2000000)
+	swap(start.Add(time.Minute), rowan, eth, 1000000)
+	bucket, found := clpKeeper.GetPoolStatsBucket(ctx, "ceth", start.Unix())
+	require.True(t, found)
+	require.Equal(t, uint64(2), bucket.SwapCount)
+	require.Equal(t, "2000000", bucket.VolumeExternal.String())
+	require.Equal(t, "1000000", bucket.VolumeNative.String())
+	require.False(t, bucket.FeesNative.IsZero())
+	require.False(t, bucket.FeesExternal.IsZero())
+
+	// Two days later only the week window still counts the first swaps
+	later := start.Add(48 * time.Hour)
+	swap(later, eth, rowan, 10000)
+	allocation := sdk.NewUint(1000000)
+	oneDec := sdk.OneDec()
+	period := &types.RewardPeriod{RewardPeriodId: "RP1", RewardPeriodStartBlock: 1, RewardPeriodEndBlock: 10, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec}
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, clpKeeper.DistributeDepthRewards(ctx, period, clpKeeper.GetPools(ctx)))
+	res, err := querier.GetPoolStats(sdk.WrapSDKContext(ctx), &types.PoolStatsReq{Symbol: "ceth"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Day.SwapCount)
+	require.Equal(t, "10000", res.Day.VolumeExternal.String())
+	require.Equal(t, "100000rowan", res.Day.RewardEmissions.String())
+	require.Equal(t, uint64(3), res.Week.SwapCount)
+	require.Equal(t, "2010000", res.Week.VolumeExternal.String())
+	require.True(t, res.FeeApr.IsPositive())
+	require.True(t, res.RewardApr.IsPositive())
+
+	// Buckets older than the retention period are pruned when a new bucket opens
+	swap(start.Add(8*24*time.Hour), eth, rowan, 10000)
+	_, found = clpKeeper.GetPoolStatsBucket(ctx, "ceth", start.Unix())
+	require.False(t, found)
+	require.Len(t, clpKeeper.GetPoolStatsBuckets(ctx, "ceth"), 2)
+
+	_, err = querier.GetPoolStats(sdk.WrapSDKContext(ctx), &types.PoolStatsReq{Symbol: "cusdc"})
+	require.Error(t, err)
+	require.NoError(t, clpKeeper.DestroyPool(ctx, "ceth"))
+	require.Empty(t, clpKeeper.GetPoolStatsBuckets(ctx, "ceth"))
+}
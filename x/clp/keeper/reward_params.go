@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func (k Keeper) SetRewardParams(ctx sdk.Context, params *types.RewardParams) {
@@ -17,3 +18,41 @@ func (k Keeper) GetRewardsParams(ctx sdk.Context) *types.RewardParams {
 	k.cdc.MustUnmarshal(bz, &params)
 	return &params
 }
+
+// SetLiquidityRemovalPeriods sets the lock and cancel periods of liquidity unlocks, in blocks
+func (k Keeper) SetLiquidityRemovalPeriods(ctx sdk.Context, lockPeriod, cancelPeriod uint64) {
+	params := k.GetRewardsParams(ctx)
+	params.LiquidityRemovalLockPeriod = lockPeriod
+	params.LiquidityRemovalCancelPeriod = cancelPeriod
+	k.SetRewardParams(ctx, params)
+}
+
+// SetRewardPeriodFundingModules sets the module accounts allowed to fund reward periods
+func (k Keeper) SetRewardPeriodFundingModules(ctx sdk.Context, modules []string) {
+	params := k.GetRewardsParams(ctx)
+	params.RewardPeriodFundingModules = modules
+	k.SetRewardParams(ctx, params)
+}
+
+// IsRewardPeriodFundingModule returns whether a module account is allowed to fund reward periods, the clp module never
+// is since it holds the pools
+func (k Keeper) IsRewardPeriodFundingModule(ctx sdk.Context, module string) bool {
+	if module == types.ModuleName {
+		return false
+	}
+	for _, allowed := range k.GetRewardsParams(ctx).RewardPeriodFundingModules {
+		if allowed == module {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStakingRewardParams sets the mint params, the minter is only replaced when it is not empty
+func (k Keeper) SetStakingRewardParams(ctx sdk.Context, minter minttypes.Minter, params minttypes.Params) {
+	isEmpty := func(d sdk.Dec) bool { return d.IsNil() || d.IsZero() }
+	if !(isEmpty(minter.AnnualProvisions) && isEmpty(minter.Inflation)) {
+		k.mintKeeper.SetMinter(ctx, minter)
+	}
+	k.mintKeeper.SetParams(ctx, params)
+}
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (k Keeper) GetCurrentRewardPeriod(ctx sdk.Context, params *types.RewardParams) *types.RewardPeriod {
@@ -17,6 +23,137 @@ func (k Keeper) GetCurrentRewardPeriod(ctx sdk.Context, params *types.RewardPara
 	return nil
 }
 
+func (k Keeper) getRewardPeriodIndex(periods []*types.RewardPeriod, rewardPeriodID string) int {
+	for i, period := range periods {
+		if period.RewardPeriodId == rewardPeriodID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (k Keeper) hasRewardPeriodStarted(ctx sdk.Context, period *types.RewardPeriod) bool {
+	return period.RewardPeriodStartBlock <= uint64(ctx.BlockHeight())
+}
+
+func (k Keeper) hasRewardPeriodEnded(ctx sdk.Context, period *types.RewardPeriod) bool {
+	return period.RewardPeriodEndBlock < uint64(ctx.BlockHeight())
+}
+
+// validateNewRewardPeriod checks that a new or edited reward period starts in the future, that its multipliers
+// reference existing pools and that its rewards can be funded
+func (k Keeper) validateNewRewardPeriod(ctx sdk.Context, period *types.RewardPeriod) error {
+	if k.hasRewardPeriodStarted(ctx, period) {
+		return sdkerrors.Wrap(types.ErrRewardPeriodStarted, fmt.Sprintf("start block %d must be after the current block %d", period.RewardPeriodStartBlock, ctx.BlockHeight()))
+	}
+	for _, multiplier := range period.RewardPeriodPoolMultipliers {
+		if !k.ExistsPool(ctx, multiplier.PoolMultiplierAsset) {
+			return sdkerrors.Wrap(types.ErrPoolDoesNotExist, fmt.Sprintf("reward period %s multiplier pool : %s", period.RewardPeriodId, multiplier.PoolMultiplierAsset))
+		}
+	}
+	_, err := k.tokenRegistryKeeper.GetEntry(k.tokenRegistryKeeper.GetRegistry(ctx), period.RewardDenom())
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrTokenNotSupported, period.RewardDenom())
+	}
+	if period.RewardPeriodFundingSource != types.RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT {
+		return nil
+	}
+	if !k.IsRewardPeriodFundingModule(ctx, period.RewardPeriodFundingModule) {
+		return sdkerrors.Wrap(types.ErrFundingModuleNotAllowed, period.RewardPeriodFundingModule)
+	}
+	// Only module accounts known to the auth keeper can send coins
+	if k.authKeeper.GetModuleAccount(ctx, period.RewardPeriodFundingModule) == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownAddress, fmt.Sprintf("funding module %s", period.RewardPeriodFundingModule))
+	}
+	return nil
+}
+
+// setRewardPeriods stores reward periods in ascending start block, reward periods may not overlap so that at most one
+// of them is current
+func (k Keeper) setRewardPeriods(ctx sdk.Context, periods []*types.RewardPeriod) error {
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].RewardPeriodStartBlock < periods[j].RewardPeriodStartBlock
+	})
+	for i := 1; i < len(periods); i++ {
+		if periods[i].RewardPeriodStartBlock <= periods[i-1].RewardPeriodEndBlock {
+			return sdkerrors.Wrap(types.ErrRewardPeriodOverlap, fmt.Sprintf("%s and %s", periods[i-1].RewardPeriodId, periods[i].RewardPeriodId))
+		}
+	}
+	params := k.GetRewardsParams(ctx)
+	params.RewardPeriods = periods
+	k.SetRewardParams(ctx, params)
+	return nil
+}
+
+// ReplaceRewardPeriods replaces all reward periods, running reward periods must be kept unchanged and started ones
+// may only be dropped once they have ended
+func (k Keeper) ReplaceRewardPeriods(ctx sdk.Context, periods []*types.RewardPeriod) error {
+	current := k.GetRewardsParams(ctx).RewardPeriods
+	for _, period := range current {
+		if !k.hasRewardPeriodStarted(ctx, period) || k.hasRewardPeriodEnded(ctx, period) {
+			continue
+		}
+		if k.getRewardPeriodIndex(periods, period.RewardPeriodId) < 0 {
+			return sdkerrors.Wrap(types.ErrRewardPeriodStarted, fmt.Sprintf("running reward period %s cannot be removed", period.RewardPeriodId))
+		}
+	}
+	for _, period := range periods {
+		i := k.getRewardPeriodIndex(current, period.RewardPeriodId)
+		if i >= 0 && k.hasRewardPeriodStarted(ctx, current[i]) {
+			if !bytes.Equal(k.cdc.MustMarshal(current[i]), k.cdc.MustMarshal(period)) {
+				return sdkerrors.Wrap(types.ErrRewardPeriodStarted, fmt.Sprintf("reward period %s cannot be changed", period.RewardPeriodId))
+			}
+			continue
+		}
+		if err := k.validateNewRewardPeriod(ctx, period); err != nil {
+			return err
+		}
+	}
+	return k.setRewardPeriods(ctx, append([]*types.RewardPeriod{}, periods...))
+}
+
+// InsertRewardPeriod adds a reward period that starts in the future
+func (k Keeper) InsertRewardPeriod(ctx sdk.Context, period *types.RewardPeriod) error {
+	periods := k.GetRewardsParams(ctx).RewardPeriods
+	if k.getRewardPeriodIndex(periods, period.RewardPeriodId) >= 0 {
+		return sdkerrors.Wrap(types.ErrRewardPeriodAlreadyExists, period.RewardPeriodId)
+	}
+	if err := k.validateNewRewardPeriod(ctx, period); err != nil {
+		return err
+	}
+	return k.setRewardPeriods(ctx, append(periods, period))
+}
+
+// UpdateRewardPeriod replaces a reward period that has not started yet
+func (k Keeper) UpdateRewardPeriod(ctx sdk.Context, period *types.RewardPeriod) error {
+	periods := k.GetRewardsParams(ctx).RewardPeriods
+	i := k.getRewardPeriodIndex(periods, period.RewardPeriodId)
+	if i < 0 {
+		return sdkerrors.Wrap(types.ErrRewardPeriodDoesNotExist, period.RewardPeriodId)
+	}
+	if k.hasRewardPeriodStarted(ctx, periods[i]) {
+		return sdkerrors.Wrap(types.ErrRewardPeriodStarted, period.RewardPeriodId)
+	}
+	if err := k.validateNewRewardPeriod(ctx, period); err != nil {
+		return err
+	}
+	periods[i] = period
+	return k.setRewardPeriods(ctx, periods)
+}
+
+// RemoveRewardPeriod deletes a reward period that has not started yet or has ended
+func (k Keeper) RemoveRewardPeriod(ctx sdk.Context, rewardPeriodID string) error {
+	periods := k.GetRewardsParams(ctx).RewardPeriods
+	i := k.getRewardPeriodIndex(periods, rewardPeriodID)
+	if i < 0 {
+		return sdkerrors.Wrap(types.ErrRewardPeriodDoesNotExist, rewardPeriodID)
+	}
+	if k.hasRewardPeriodStarted(ctx, periods[i]) && !k.hasRewardPeriodEnded(ctx, periods[i]) {
+		return sdkerrors.Wrap(types.ErrRewardPeriodStarted, rewardPeriodID)
+	}
+	return k.setRewardPeriods(ctx, append(periods[:i], periods[i+1:]...))
+}
+
 func (k Keeper) DistributeDepthRewards(ctx sdk.Context, period *types.RewardPeriod, pools []*types.Pool) error {
 	height := uint64(ctx.BlockHeight())
 	if height == period.RewardPeriodStartBlock {
@@ -26,6 +163,11 @@ func (k Keeper) DistributeDepthRewards(ctx sdk.Context, period *types.RewardPeri
 	}
 	periodLength := period.RewardPeriodEndBlock - period.RewardPeriodStartBlock + 1
 	blockDistribution := period.RewardPeriodAllocation.QuoUint64(periodLength)
+	// Rewards are capped by what the funding source can still pay
+	available := k.GetRewardPeriodAvailableFunds(ctx, period)
+	if blockDistribution.GT(available) {
+		blockDistribution = available
+	}
 
 	remaining := blockDistribution
 
@@ -59,12 +201,16 @@ func (k Keeper) DistributeDepthRewards(ctx sdk.Context, period *types.RewardPeri
 			if poolDistribution.IsZero() {
 				continue
 			}
-			rewardCoins := sdk.NewCoins(sdk.NewCoin(types.GetSettlementAsset().Symbol, sdk.NewIntFromBigInt(poolDistribution.BigInt())))
-			err := k.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
+			if pool.PoolUnits.IsZero() {
+				continue
+			}
+			// Rewards are held by the module until liquidity providers claim them
+			err := k.fundRewards(ctx, period, poolDistribution)
 			if err != nil {
 				return err
 			}
-			pool.NativeAssetBalance = pool.NativeAssetBalance.Add(poolDistribution)
+			k.AccumulatePoolRewards(ctx, *pool, period, poolDistribution)
+			k.RecordRewardStats(ctx, pool.ExternalAsset.Symbol, sdk.NewCoin(period.RewardDenom(), sdk.NewIntFromBigInt(poolDistribution.BigInt())))
 			pool.RewardPeriodNativeDistributed = pool.RewardPeriodNativeDistributed.Add(poolDistribution)
 			remaining = remaining.Sub(poolDistribution)
 			err = k.SetPool(ctx, pool)
@@ -77,6 +223,305 @@ func (k Keeper) DistributeDepthRewards(ctx sdk.Context, period *types.RewardPeri
 	return nil
 }
 
+// GetRewardPeriodAvailableFunds returns what the funding source of a reward period can still pay
+func (k Keeper) GetRewardPeriodAvailableFunds(ctx sdk.Context, period *types.RewardPeriod) sdk.Uint {
+	switch period.RewardPeriodFundingSource {
+	case types.RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT:
+		// Modules removed from the allowed funding modules stop paying the reward periods they fund
+		if !k.IsRewardPeriodFundingModule(ctx, period.RewardPeriodFundingModule) {
+			return sdk.ZeroUint()
+		}
+		balance := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(period.RewardPeriodFundingModule), period.RewardDenom())
+		return sdk.NewUintFromBigInt(balance.Amount.BigInt())
+	case types.RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW:
+		escrow, found := k.GetRewardEscrow(ctx, period.RewardPeriodId)
+		if !found {
+			return sdk.ZeroUint()
+		}
+		return escrow.Balance
+	default:
+		return *period.RewardPeriodAllocation
+	}
+}
+
+// fundRewards moves rewards from the funding source of a reward period to the clp module
+func (k Keeper) fundRewards(ctx sdk.Context, period *types.RewardPeriod, amount sdk.Uint) error {
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(period.RewardDenom(), sdk.NewIntFromBigInt(amount.BigInt())))
+	switch period.RewardPeriodFundingSource {
+	case types.RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT:
+		if !k.IsRewardPeriodFundingModule(ctx, period.RewardPeriodFundingModule) {
+			return sdkerrors.Wrap(types.ErrFundingModuleNotAllowed, period.RewardPeriodFundingModule)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, period.RewardPeriodFundingModule, types.ModuleName, rewardCoins)
+	case types.RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW:
+		// Escrowed funds are already held by the clp module
+		escrow, found := k.GetRewardEscrow(ctx, period.RewardPeriodId)
+		if !found || escrow.Balance.LT(amount) {
+			return sdkerrors.Wrap(types.ErrBalanceNotAvailable, fmt.Sprintf("reward escrow %s", period.RewardPeriodId))
+		}
+		escrow.Balance = escrow.Balance.Sub(amount)
+		k.SetRewardEscrow(ctx, &escrow)
+		return nil
+	default:
+		return k.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
+	}
+}
+
+func (k Keeper) SetRewardEscrow(ctx sdk.Context, escrow *types.RewardEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRewardEscrowKey(escrow.RewardPeriodId), k.cdc.MustMarshal(escrow))
+}
+
+func (k Keeper) GetRewardEscrow(ctx sdk.Context, rewardPeriodID string) (types.RewardEscrow, bool) {
+	var escrow types.RewardEscrow
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardEscrowKey(rewardPeriodID))
+	if bz == nil {
+		return escrow, false
+	}
+	k.cdc.MustUnmarshal(bz, &escrow)
+	return escrow, true
+}
+
+func (k Keeper) GetRewardEscrows(ctx sdk.Context) []types.RewardEscrow {
+	var escrows []types.RewardEscrow
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RewardEscrowPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var escrow types.RewardEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &escrow)
+		escrows = append(escrows, escrow)
+	}
+	return escrows
+}
+
+// FundRewardPeriodEscrow adds funds to the escrow of a reward period, the first funder of an escrow is the only one
+// allowed to fund it further and to refund it
+func (k Keeper) FundRewardPeriodEscrow(ctx sdk.Context, funder sdk.AccAddress, rewardPeriodID string, amount sdk.Uint) (types.RewardEscrow, error) {
+	periods := k.GetRewardsParams(ctx).RewardPeriods
+	i := k.getRewardPeriodIndex(periods, rewardPeriodID)
+	if i < 0 {
+		return types.RewardEscrow{}, sdkerrors.Wrap(types.ErrRewardPeriodDoesNotExist, rewardPeriodID)
+	}
+	period := periods[i]
+	if period.RewardPeriodFundingSource != types.RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW {
+		return types.RewardEscrow{}, sdkerrors.Wrap(types.ErrRewardPeriodNotEscrowed, rewardPeriodID)
+	}
+	if k.hasRewardPeriodEnded(ctx, period) {
+		return types.RewardEscrow{}, sdkerrors.Wrap(types.ErrRewardPeriodEnded, rewardPeriodID)
+	}
+	escrow, found := k.GetRewardEscrow(ctx, rewardPeriodID)
+	if !found {
+		escrow = types.RewardEscrow{
+			RewardPeriodId: rewardPeriodID,
+			Denom:          period.RewardDenom(),
+			Funder:         funder.String(),
+			Balance:        sdk.ZeroUint(),
+		}
+	}
+	if escrow.Funder != funder.String() {
+		return types.RewardEscrow{}, sdkerrors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("reward escrow %s is funded by %s", rewardPeriodID, escrow.Funder))
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(escrow.Denom, sdk.NewIntFromBigInt(amount.BigInt())))
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, coins)
+	if err != nil {
+		return types.RewardEscrow{}, err
+	}
+	escrow.Balance = escrow.Balance.Add(amount)
+	k.SetRewardEscrow(ctx, &escrow)
+	return escrow, nil
+}
+
+// RefundRewardPeriodEscrow returns the remaining funds of the escrow of a reward period to its funder, escrows of
+// running reward periods cannot be refunded
+func (k Keeper) RefundRewardPeriodEscrow(ctx sdk.Context, funder sdk.AccAddress, rewardPeriodID string) (sdk.Uint, error) {
+	escrow, found := k.GetRewardEscrow(ctx, rewardPeriodID)
+	if !found {
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrRewardEscrowDoesNotExist, rewardPeriodID)
+	}
+	if escrow.Funder != funder.String() {
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("reward escrow %s is funded by %s", rewardPeriodID, escrow.Funder))
+	}
+	periods := k.GetRewardsParams(ctx).RewardPeriods
+	if i := k.getRewardPeriodIndex(periods, rewardPeriodID); i >= 0 && k.hasRewardPeriodStarted(ctx, periods[i]) && !k.hasRewardPeriodEnded(ctx, periods[i]) {
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrRewardPeriodStarted, rewardPeriodID)
+	}
+	if !escrow.Balance.IsZero() {
+		coins := sdk.NewCoins(sdk.NewCoin(escrow.Denom, sdk.NewIntFromBigInt(escrow.Balance.BigInt())))
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, coins)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetRewardEscrowKey(rewardPeriodID))
+	return escrow.Balance, nil
+}
+
+func (k Keeper) SetPoolRewardAccumulator(ctx sdk.Context, accumulator *types.PoolRewardAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolRewardKey(accumulator.Symbol, accumulator.RewardPeriodId), k.cdc.MustMarshal(accumulator))
+}
+
+func (k Keeper) GetPoolRewardAccumulator(ctx sdk.Context, symbol string, rewardPeriodID string) (types.PoolRewardAccumulator, bool) {
+	var accumulator types.PoolRewardAccumulator
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolRewardKey(symbol, rewardPeriodID))
+	if bz == nil {
+		return accumulator, false
+	}
+	k.cdc.MustUnmarshal(bz, &accumulator)
+	return accumulator, true
+}
+
+// GetPoolRewardAccumulators returns the reward accumulators of all pools, or of the pool of symbol if it is not empty
+func (k Keeper) GetPoolRewardAccumulators(ctx sdk.Context, symbol string) []types.PoolRewardAccumulator {
+	var accumulators []types.PoolRewardAccumulator
+	keyPrefix := types.PoolRewardPrefix
+	if symbol != "" {
+		keyPrefix = types.GetPoolRewardPoolPrefix(symbol)
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var accumulator types.PoolRewardAccumulator
+		k.cdc.MustUnmarshal(iterator.Value(), &accumulator)
+		// Symbols may share a prefix up to the separator of another symbol
+		if symbol != "" && accumulator.Symbol != symbol {
+			continue
+		}
+		accumulators = append(accumulators, accumulator)
+	}
+	return accumulators
+}
+
+// AccumulatePoolRewards spreads rewards distributed to a pool over its liquidity units
+func (k Keeper) AccumulatePoolRewards(ctx sdk.Context, pool types.Pool, period *types.RewardPeriod, rewards sdk.Uint) {
+	accumulator, found := k.GetPoolRewardAccumulator(ctx, pool.ExternalAsset.Symbol, period.RewardPeriodId)
+	if !found {
+		accumulator = types.NewPoolRewardAccumulator(pool.ExternalAsset.Symbol, period.RewardPeriodId, period.RewardDenom())
+	}
+	rewardPerUnit := sdk.NewDecFromBigInt(rewards.BigInt()).Quo(sdk.NewDecFromBigInt(pool.PoolUnits.BigInt()))
+	accumulator.RewardPerUnit = accumulator.RewardPerUnit.Add(rewardPerUnit)
+	accumulator.Distributed = accumulator.Distributed.Add(rewards)
+	k.SetPoolRewardAccumulator(ctx, &accumulator)
+}
+
+func (k Keeper) SetLiquidityProviderRewards(ctx sdk.Context, rewards *types.LiquidityProviderRewards) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLiquidityProviderRewardKey(rewards.Symbol, rewards.LiquidityProviderAddress), k.cdc.MustMarshal(rewards))
+}
+
+func (k Keeper) GetLiquidityProviderRewards(ctx sdk.Context, symbol string, lpAddress string) types.LiquidityProviderRewards {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLiquidityProviderRewardKey(symbol, lpAddress))
+	if bz == nil {
+		return types.NewLiquidityProviderRewards(symbol, lpAddress)
+	}
+	var rewards types.LiquidityProviderRewards
+	k.cdc.MustUnmarshal(bz, &rewards)
+	return rewards
+}
+
+func (k Keeper) GetAllLiquidityProviderRewards(ctx sdk.Context) []types.LiquidityProviderRewards {
+	var allRewards []types.LiquidityProviderRewards
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProviderRewardPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards types.LiquidityProviderRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		allRewards = append(allRewards, rewards)
+	}
+	return allRewards
+}
+
+// ComputeLiquidityProviderRewards returns the rewards of a liquidity provider including the ones accrued by units since
+// it was last settled, without storing them
+func (k Keeper) ComputeLiquidityProviderRewards(ctx sdk.Context, symbol string, lpAddress string, units sdk.Uint) (types.LiquidityProviderRewards, bool) {
+	rewards := k.GetLiquidityProviderRewards(ctx, symbol, lpAddress)
+	var changed bool
+	for _, accumulator := range k.GetPoolRewardAccumulators(ctx, symbol) {
+		i := 0
+		for ; i < len(rewards.Periods); i++ {
+			if rewards.Periods[i].RewardPeriodId == accumulator.RewardPeriodId {
+				break
+			}
+		}
+		// Units held before the period started accrue from a zero reward per unit
+		if i == len(rewards.Periods) {
+			rewards.Periods = append(rewards.Periods, types.LiquidityProviderPeriodReward{
+				RewardPeriodId:    accumulator.RewardPeriodId,
+				RewardPerUnitPaid: sdk.ZeroDec(),
+				Accrued:           sdk.ZeroUint(),
+				Claimed:           sdk.ZeroUint(),
+				Denom:             accumulator.Denom,
+			})
+		}
+		period := &rewards.Periods[i]
+		if period.RewardPerUnitPaid.Equal(accumulator.RewardPerUnit) {
+			continue
+		}
+		accrued := accumulator.RewardPerUnit.Sub(period.RewardPerUnitPaid).MulInt(sdk.NewIntFromBigInt(units.BigInt())).TruncateInt()
+		period.Accrued = period.Accrued.Add(sdk.NewUintFromBigInt(accrued.BigInt()))
+		period.RewardPerUnitPaid = accumulator.RewardPerUnit
+		changed = true
+	}
+	return rewards, changed
+}
+
+// SettleLiquidityProviderRewards accrues the rewards earned by units of a liquidity provider since it was last settled,
+// it must be called before the units of the liquidity provider change
+func (k Keeper) SettleLiquidityProviderRewards(ctx sdk.Context, symbol string, lpAddress string, units sdk.Uint) types.LiquidityProviderRewards {
+	rewards, changed := k.ComputeLiquidityProviderRewards(ctx, symbol, lpAddress, units)
+	if changed {
+		k.SetLiquidityProviderRewards(ctx, &rewards)
+	}
+	return rewards
+}
+
+// ClaimLiquidityProviderRewards pays out the pending rewards of a liquidity provider, its units are synced with the
+// liquidity provider tokens it holds first so that only the units backed by tokens earn rewards
+func (k Keeper) ClaimLiquidityProviderRewards(ctx sdk.Context, symbol string, lpAddress sdk.AccAddress) (sdk.Coins, error) {
+	units := sdk.ZeroUint()
+	lp, err := k.SyncLiquidityProvider(ctx, symbol, lpAddress.String())
+	if err == nil {
+		units = lp.LiquidityProviderUnits
+	} else if !errors.Is(err, types.ErrLiquidityProviderDoesNotExist) {
+		return nil, err
+	}
+	rewards := k.SettleLiquidityProviderRewards(ctx, symbol, lpAddress.String(), units)
+	pending := rewards.Pending()
+	if pending.IsZero() {
+		return nil, types.ErrNoRewardsToClaim
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lpAddress, pending)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rewards.Periods {
+		rewards.Periods[i].Claimed = rewards.Periods[i].Accrued
+	}
+	k.SetLiquidityProviderRewards(ctx, &rewards)
+	return pending, nil
+}
+
 func (k Keeper) UseUnlockedLiquidity(ctx sdk.Context, lp types.LiquidityProvider, units sdk.Uint) error {
 	// Ensure there is enough liquidity requested for unlock, and also passed lock period.
 	// Reduce liquidity in one or more unlock records.
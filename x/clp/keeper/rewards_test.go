@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"testing"
 
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
 	"github.com/Sifchain/sifnode/x/clp/test"
 	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tenderminttypes "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -46,6 +49,23 @@ func TestEndBlock(t *testing.T) {
 		RewardPeriodNativeDistributed: sdk.ZeroUint(),
 	})
 	require.NoError(t, err)
+	// Back the pool units and balances so that the clp invariants hold
+	lpAddr, _ := sdk.AccAddressFromBech32("sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd")
+	for _, symbol := range []string{"atom", "cusdc", "ceth"} {
+		app.ClpKeeper.SetLiquidityProvider(ctx, &types.LiquidityProvider{
+			Asset:                    &types.Asset{Symbol: symbol},
+			LiquidityProviderUnits:   sdk.NewUint(1000),
+			LiquidityProviderAddress: lpAddr.String(),
+		})
+		require.NoError(t, app.ClpKeeper.MintLiquidityProviderTokens(ctx, symbol, sdk.NewUint(1000), lpAddr))
+	}
+	err = app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin("rowan", sdk.NewInt(3000)),
+		sdk.NewCoin("atom", sdk.NewInt(1000)),
+		sdk.NewCoin("cusdc", sdk.NewInt(1000)),
+		sdk.NewCoin("ceth", sdk.NewInt(1000)),
+	))
+	require.NoError(t, err)
 	startingSupply := app.BankKeeper.GetSupply(ctx, "rowan")
 	for block := 1; block <= 10; block++ {
 		app.BeginBlock(abci.RequestBeginBlock{Header: tenderminttypes.Header{Height: int64(block)}})
@@ -141,3 +161,244 @@ func TestUseUnlockedLiquidity(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_LiquidityProviderRewards(t *testing.T) {
+	lpA := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	lpB := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	ctx, app := createLimitOrderTestApp(t, lpA)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	lpAAddr, _ := sdk.AccAddressFromBech32(lpA)
+	lpBAddr, _ := sdk.AccAddressFromBech32(lpB)
+	asset := types.Asset{Symbol: "ceth"}
+	send := func(from, to sdk.AccAddress, units int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), sdk.NewInt(units)))
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, from, to, coins))
+	}
+	provider := sdk.AccAddress("genesis_provider____")
+	send(provider, lpAAddr, 600000000000)
+	send(provider, lpBAddr, 400000000000)
+	allocation := sdk.NewUint(10000000)
+	oneDec := sdk.OneDec()
+	period := &types.RewardPeriod{RewardPeriodId: "RP1", RewardPeriodStartBlock: 1, RewardPeriodEndBlock: 10, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec}
+	pending := func(lpAddress string) string {
+		res, err := querier.GetLiquidityProviderRewards(sdk.WrapSDKContext(ctx), &types.LiquidityProviderRewardsReq{Symbol: "ceth", LpAddress: lpAddress})
+		require.NoError(t, err)
+		return res.Pending.String()
+	}
+
+	ctx = ctx.WithBlockHeight(1)
+	err := app.ClpKeeper.DistributeDepthRewards(ctx, period, app.ClpKeeper.GetPools(ctx))
+	require.NoError(t, err)
+	require.Equal(t, "600000rowan", pending(lpA))
+	require.Equal(t, "400000rowan", pending(lpB))
+	// Rewards accrued before liquidity provider tokens are transferred are settled at the previous units
+	send(lpAAddr, lpBAddr, 400000000000)
+	ctx = ctx.WithBlockHeight(2)
+	err = app.ClpKeeper.DistributeDepthRewards(ctx, period, app.ClpKeeper.GetPools(ctx))
+	require.NoError(t, err)
+	require.Equal(t, "800000rowan", pending(lpA))
+	require.Equal(t, "1200000rowan", pending(lpB))
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, "1000000000000", pool.NativeAssetBalance.String())
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, lpAAddr, "rowan")
+	claimMsg := types.NewMsgClaimRewards(lpAAddr, asset)
+	res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &claimMsg)
+	require.NoError(t, err)
+	require.Equal(t, "800000rowan", res.Claimed.String())
+	require.Equal(t, balanceBefore.Amount.AddRaw(800000), app.BankKeeper.GetBalance(ctx, lpAAddr, "rowan").Amount)
+	require.Equal(t, "", pending(lpA))
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &claimMsg)
+	require.ErrorIs(t, err, types.ErrNoRewardsToClaim)
+
+	// Rewards can be claimed after all the liquidity provider tokens are sent away
+	send(lpBAddr, provider, 800000000000)
+	_, err = app.ClpKeeper.GetLiquidityProvider(ctx, "ceth", lpB)
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+	claimMsg = types.NewMsgClaimRewards(lpBAddr, asset)
+	res, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &claimMsg)
+	require.NoError(t, err)
+	require.Equal(t, "1200000rowan", res.Claimed.String())
+	rewards := app.ClpKeeper.GetLiquidityProviderRewards(ctx, "ceth", lpB)
+	require.Len(t, rewards.Periods, 1)
+	require.Equal(t, "RP1", rewards.Periods[0].RewardPeriodId)
+	require.Equal(t, "1200000", rewards.Periods[0].Accrued.String())
+
+	distributions, err := querier.GetRewardPeriodDistributions(sdk.WrapSDKContext(ctx), &types.RewardPeriodDistributionsReq{RewardPeriodId: "RP1"})
+	require.NoError(t, err)
+	require.Len(t, distributions.Accumulators, 1)
+	require.Equal(t, "2000000", distributions.Accumulators[0].Distributed.String())
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestMsgServer_RewardPeriods(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	ctx = ctx.WithBlockHeight(10)
+	app.TokenRegistryKeeper.SetAdminAccount(ctx, &tokenregistrytypes.AdminAccount{
+		AdminType:    tokenregistrytypes.AdminType_PMTPREWARDS,
+		AdminAddress: admin,
+	})
+	app.ClpKeeper.SetRewardParams(ctx, types.GetDefaultRewardParams())
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	adminAddr, _ := sdk.AccAddressFromBech32(admin)
+	allocation := sdk.NewUint(1000)
+	oneDec := sdk.OneDec()
+	period := func(id string, start, end uint64, pools ...string) types.RewardPeriod {
+		var multipliers []*types.PoolMultiplier
+		for _, pool := range pools {
+			multipliers = append(multipliers, &types.PoolMultiplier{PoolMultiplierAsset: pool, Multiplier: &oneDec})
+		}
+		return types.RewardPeriod{RewardPeriodId: id, RewardPeriodStartBlock: start, RewardPeriodEndBlock: end, RewardPeriodAllocation: &allocation, RewardPeriodPoolMultipliers: multipliers, RewardPeriodDefaultMultiplier: &oneDec}
+	}
+	periodIDs := func() []string {
+		var ids []string
+		for _, period := range app.ClpKeeper.GetRewardsParams(ctx).RewardPeriods {
+			ids = append(ids, period.RewardPeriodId)
+		}
+		return ids
+	}
+	appendPeriod := func(period types.RewardPeriod) error {
+		msg := types.NewMsgAppendRewardPeriod(adminAddr, period)
+		_, err := msgServer.AppendRewardPeriod(sdk.WrapSDKContext(ctx), &msg)
+		return err
+	}
+
+	require.NoError(t, appendPeriod(period("RP2", 30, 39, "ceth")))
+	require.NoError(t, appendPeriod(period("RP1", 11, 20)))
+	require.Equal(t, []string{"RP1", "RP2"}, periodIDs())
+	require.ErrorIs(t, appendPeriod(period("RP1", 50, 59)), types.ErrRewardPeriodAlreadyExists)
+	require.ErrorIs(t, appendPeriod(period("RP3", 20, 29)), types.ErrRewardPeriodOverlap)
+	require.ErrorIs(t, appendPeriod(period("RP3", 5, 9)), types.ErrRewardPeriodStarted)
+	require.ErrorIs(t, appendPeriod(period("RP3", 50, 59, "cusdc")), types.ErrPoolDoesNotExist)
+	// Module accounts fund reward periods only once allowed, the clp module never does
+	funded := period("RP3", 50, 59)
+	funded.RewardPeriodFundingSource = types.RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT
+	funded.RewardPeriodFundingModule = distrtypes.ModuleName
+	require.ErrorIs(t, appendPeriod(funded), types.ErrFundingModuleNotAllowed)
+	app.ClpKeeper.SetRewardPeriodFundingModules(ctx, []string{distrtypes.ModuleName, types.ModuleName})
+	funded.RewardPeriodFundingModule = types.ModuleName
+	require.ErrorIs(t, appendPeriod(funded), types.ErrFundingModuleNotAllowed)
+	funded.RewardPeriodFundingModule = distrtypes.ModuleName
+	require.NoError(t, appendPeriod(funded))
+	require.Equal(t, []string{"RP1", "RP2", "RP3"}, periodIDs())
+
+	edit := types.NewMsgEditRewardPeriod(adminAddr, period("RP2", 25, 29))
+	_, err := msgServer.EditRewardPeriod(sdk.WrapSDKContext(ctx), &edit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), app.ClpKeeper.GetRewardsParams(ctx).RewardPeriods[1].RewardPeriodStartBlock)
+	edit = types.NewMsgEditRewardPeriod(adminAddr, period("RP9", 25, 29))
+	_, err = msgServer.EditRewardPeriod(sdk.WrapSDKContext(ctx), &edit)
+	require.ErrorIs(t, err, types.ErrRewardPeriodDoesNotExist)
+
+	// Running reward periods can neither be edited, deleted nor dropped
+	ctx = ctx.WithBlockHeight(15)
+	edit = types.NewMsgEditRewardPeriod(adminAddr, period("RP1", 16, 20))
+	_, err = msgServer.EditRewardPeriod(sdk.WrapSDKContext(ctx), &edit)
+	require.ErrorIs(t, err, types.ErrRewardPeriodStarted)
+	deleteMsg := types.NewMsgDeleteRewardPeriod(adminAddr, "RP1")
+	_, err = msgServer.DeleteRewardPeriod(sdk.WrapSDKContext(ctx), &deleteMsg)
+	require.ErrorIs(t, err, types.ErrRewardPeriodStarted)
+	rp2 := period("RP2", 25, 29)
+	replace := types.MsgAddRewardPeriodRequest{Signer: admin, RewardPeriods: []*types.RewardPeriod{&rp2}}
+	_, err = msgServer.AddRewardPeriod(sdk.WrapSDKContext(ctx), &replace)
+	require.ErrorIs(t, err, types.ErrRewardPeriodStarted)
+	rp1 := period("RP1", 11, 20)
+	replace.RewardPeriods = []*types.RewardPeriod{&rp2, &rp1}
+	_, err = msgServer.AddRewardPeriod(sdk.WrapSDKContext(ctx), &replace)
+	require.NoError(t, err)
+
+	deleteMsg = types.NewMsgDeleteRewardPeriod(adminAddr, "RP2")
+	_, err = msgServer.DeleteRewardPeriod(sdk.WrapSDKContext(ctx), &deleteMsg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"RP1"}, periodIDs())
+	// Ended reward periods can be deleted
+	ctx = ctx.WithBlockHeight(21)
+	deleteMsg = types.NewMsgDeleteRewardPeriod(adminAddr, "RP1")
+	_, err = msgServer.DeleteRewardPeriod(sdk.WrapSDKContext(ctx), &deleteMsg)
+	require.NoError(t, err)
+	require.Empty(t, periodIDs())
+}
+
+func TestMsgServer_RewardEscrow(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	ctx = ctx.WithBlockHeight(10)
+	app.TokenRegistryKeeper.SetAdminAccount(ctx, &tokenregistrytypes.AdminAccount{
+		AdminType:    tokenregistrytypes.AdminType_PMTPREWARDS,
+		AdminAddress: admin,
+	})
+	app.ClpKeeper.SetRewardParams(ctx, types.GetDefaultRewardParams())
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	adminAddr, _ := sdk.AccAddressFromBech32(admin)
+	otherAddr, _ := sdk.AccAddressFromBech32(other)
+	asset := types.Asset{Symbol: "ceth"}
+	app.ClpKeeper.SetLiquidityProvider(ctx, &types.LiquidityProvider{Asset: &asset, LiquidityProviderUnits: sdk.NewUint(1000000000000), LiquidityProviderAddress: admin})
+	allocation := sdk.NewUint(1000000)
+	oneDec := sdk.OneDec()
+	appendMsg := types.NewMsgAppendRewardPeriod(adminAddr, types.RewardPeriod{
+		RewardPeriodId:                "RP1",
+		RewardPeriodStartBlock:        11,
+		RewardPeriodEndBlock:          20,
+		RewardPeriodAllocation:        &allocation,
+		RewardPeriodDefaultMultiplier: &oneDec,
+		RewardPeriodDenom:             "ceth",
+		RewardPeriodFundingSource:     types.RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW,
+	})
+	_, err := msgServer.AppendRewardPeriod(sdk.WrapSDKContext(ctx), &appendMsg)
+	require.NoError(t, err)
+	fund := func(signer sdk.AccAddress, amount uint64) error {
+		msg := types.NewMsgFundRewardEscrow(signer, "RP1", sdk.NewUint(amount))
+		_, err := msgServer.FundRewardEscrow(sdk.WrapSDKContext(ctx), &msg)
+		return err
+	}
+	refund := func(signer sdk.AccAddress) (*types.MsgRefundRewardEscrowResponse, error) {
+		msg := types.NewMsgRefundRewardEscrow(signer, "RP1")
+		return msgServer.RefundRewardEscrow(sdk.WrapSDKContext(ctx), &msg)
+	}
+	distribute := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		period := app.ClpKeeper.GetRewardsParams(ctx).RewardPeriods[0]
+		require.NoError(t, app.ClpKeeper.DistributeDepthRewards(ctx, period, app.ClpKeeper.GetPools(ctx)))
+	}
+
+	require.NoError(t, fund(adminAddr, 300000))
+	require.NoError(t, fund(adminAddr, 200000))
+	require.ErrorIs(t, fund(otherAddr, 100000), types.ErrNotEnoughPermissions)
+	require.Equal(t, sdk.NewInt(2500000), app.BankKeeper.GetBalance(ctx, adminAddr, "ceth").Amount)
+
+	// Rewards stop once the escrow is exhausted
+	for height := int64(11); height <= 16; height++ {
+		distribute(height)
+	}
+	res, err := querier.GetRewardPeriodAllocations(sdk.WrapSDKContext(ctx), &types.RewardPeriodAllocationsReq{RewardPeriodId: "RP1"})
+	require.NoError(t, err)
+	require.Len(t, res.Allocations, 1)
+	require.Equal(t, "ceth", res.Allocations[0].Denom)
+	require.Equal(t, "500000", res.Allocations[0].Distributed.String())
+	require.Equal(t, "500000", res.Allocations[0].Remaining.String())
+	require.Equal(t, "0", res.Allocations[0].Available.String())
+	require.Equal(t, admin, res.Allocations[0].Escrow.Funder)
+
+	_, err = refund(adminAddr)
+	require.ErrorIs(t, err, types.ErrRewardPeriodStarted)
+	claimMsg := types.NewMsgClaimRewards(adminAddr, asset)
+	claimed, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &claimMsg)
+	require.NoError(t, err)
+	require.Equal(t, "500000ceth", claimed.Claimed.String())
+	require.Equal(t, sdk.NewInt(3000000), app.BankKeeper.GetBalance(ctx, adminAddr, "ceth").Amount)
+
+	ctx = ctx.WithBlockHeight(21)
+	require.ErrorIs(t, fund(adminAddr, 100000), types.ErrRewardPeriodEnded)
+	_, err = refund(otherAddr)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	refunded, err := refund(adminAddr)
+	require.NoError(t, err)
+	require.Equal(t, "0", refunded.Refunded.String())
+	_, err = refund(adminAddr)
+	require.ErrorIs(t, err, types.ErrRewardEscrowDoesNotExist)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// QuoteAddLiquiditySingleSided simulates adding amount of sentAsset to pool by swapping the share returned by
+// CalculateSingleSidedSwapAmount into the other asset first. It returns the quote, the part of its liquidity fee
+// charged at the swap fee rate of the pool and the pool after the swap, before the protocol fee of the swap is collected.
+func (k Keeper) QuoteAddLiquiditySingleSided(ctx sdk.Context, pool types.Pool, sentAsset types.Asset, amount sdk.Uint) (types.SimulateAddLiquiditySingleSidedRes, sdk.Uint, types.Pool, error) {
+	normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	toNative := sentAsset.Equals(*pool.ExternalAsset)
+	toAsset := *pool.ExternalAsset
+	if toNative {
+		toAsset = types.GetSettlementAsset()
+	}
+
+	swapAmount := CalculateSingleSidedSwapAmount(sentAsset, amount, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	swapResult, liquidityFee, swapFee, _, swappedPool, err := SwapOneWithSwapFee(sentAsset, swapAmount, toAsset, pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	if err != nil {
+		return types.SimulateAddLiquiditySingleSidedRes{}, sdk.Uint{}, types.Pool{}, err
+	}
+	nativeAmount, externalAmount := swapResult, amount.Sub(swapAmount)
+	asymmetricNative, asymmetricExternal := sdk.ZeroUint(), amount
+	if !toNative {
+		nativeAmount, externalAmount = amount.Sub(swapAmount), swapResult
+		asymmetricNative, asymmetricExternal = amount, sdk.ZeroUint()
+	}
+
+	// The protocol fee of the swap leaves the pool before the liquidity is added
+	feePool := swappedPool
+	protocolFee := calcProtocolFee(swapFee, k.GetSwapFeeParams(ctx).ProtocolFeeRate)
+	if toNative {
+		feePool.NativeAssetBalance = feePool.NativeAssetBalance.Sub(protocolFee)
+	} else {
+		feePool.ExternalAssetBalance = feePool.ExternalAssetBalance.Sub(protocolFee)
+	}
+	_, lpUnits, err := CalculatePoolUnits(feePool.PoolUnits, feePool.NativeAssetBalance, feePool.ExternalAssetBalance,
+		nativeAmount, externalAmount, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return types.SimulateAddLiquiditySingleSidedRes{}, sdk.Uint{}, types.Pool{}, err
+	}
+	_, asymmetricLpUnits, err := CalculatePoolUnits(pool.PoolUnits, pool.NativeAssetBalance, pool.ExternalAssetBalance,
+		asymmetricNative, asymmetricExternal, normalizationFactor, adjustExternalToken)
+	if err != nil {
+		return types.SimulateAddLiquiditySingleSidedRes{}, sdk.Uint{}, types.Pool{}, err
+	}
+	return types.SimulateAddLiquiditySingleSidedRes{
+		SwapAmount:          swapAmount,
+		SwapResult:          swapResult,
+		LiquidityFee:        liquidityFee,
+		NativeAssetAmount:   nativeAmount,
+		ExternalAssetAmount: externalAmount,
+		LpUnits:             lpUnits,
+		AsymmetricLpUnits:   asymmetricLpUnits,
+		Height:              ctx.BlockHeight(),
+	}, swapFee, swappedPool, nil
+}
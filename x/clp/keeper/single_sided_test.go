@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_AddLiquiditySingleSided(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	signer, _ := sdk.AccAddressFromBech32(address)
+	eth := types.NewAsset("ceth")
+	rowan := types.GetSettlementAsset()
+	funds := sdk.NewCoins(sdk.NewCoin(rowan.Symbol, sdk.NewInt(100000000000)), sdk.NewCoin(eth.Symbol, sdk.NewInt(100000000000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, signer, funds))
+
+	for _, sent := range []types.Asset{rowan, eth} {
+		amount := sdk.NewUint(100000000000)
+		quote, err := querier.SimulateAddLiquiditySingleSided(sdk.WrapSDKContext(ctx), &types.SimulateAddLiquiditySingleSidedReq{
+			Symbol:     "ceth",
+			SentSymbol: sent.Symbol,
+			Amount:     amount,
+		})
+		require.NoError(t, err)
+		// Swapping about half of the amount first beats the slip adjustment of an asymmetric add
+		require.True(t, quote.SwapAmount.GT(amount.QuoUint64(2)))
+		require.True(t, quote.SwapAmount.LT(amount.QuoUint64(2).AddUint64(10000000000)))
+		require.True(t, quote.LpUnits.GT(quote.AsymmetricLpUnits))
+
+		rowanBefore := app.BankKeeper.GetBalance(ctx, signer, rowan.Symbol)
+		ethBefore := app.BankKeeper.GetBalance(ctx, signer, eth.Symbol)
+		msg := types.NewMsgAddLiquiditySingleSided(signer, eth, sent, amount)
+		msg.MinPoolUnits = quote.LpUnits.AddUint64(1)
+		_, err = msgServer.AddLiquiditySingleSided(sdk.WrapSDKContext(ctx), &msg)
+		require.ErrorIs(t, err, types.ErrLiquidityBelowMinimum)
+		msg.MinPoolUnits = quote.LpUnits
+		res, err := msgServer.AddLiquiditySingleSided(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+		require.Equal(t, quote.SwapAmount.String(), res.SwapAmount.String())
+		require.Equal(t, quote.LpUnits.String(), res.LpUnits.String())
+
+		// Only the sent asset leaves the account
+		spentRowan := rowanBefore.Sub(app.BankKeeper.GetBalance(ctx, signer, rowan.Symbol))
+		spentEth := ethBefore.Sub(app.BankKeeper.GetBalance(ctx, signer, eth.Symbol))
+		if sent.Equals(rowan) {
+			require.Equal(t, "100000000000", spentRowan.Amount.String())
+			require.True(t, spentEth.IsZero())
+		} else {
+			require.Equal(t, "100000000000", spentEth.Amount.String())
+			require.True(t, spentRowan.IsZero())
+		}
+	}
+
+	_, err := querier.SimulateAddLiquiditySingleSided(sdk.WrapSDKContext(ctx), &types.SimulateAddLiquiditySingleSidedReq{
+		Symbol:     "ceth",
+		SentSymbol: "cusdc",
+		Amount:     sdk.NewUint(1000),
+	})
+	require.Error(t, err)
+	msg := types.NewMsgAddLiquiditySingleSided(signer, eth, types.NewAsset("cusdc"), sdk.NewUint(1000))
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInValidAsset)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k Keeper) SetSwapFeeParams(ctx sdk.Context, params *types.SwapFeeParams) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SwapFeeParamsPrefix, k.cdc.MustMarshal(params))
+}
+
+// GetSwapFeeParams returns the swap fee params, chains upgraded from a version without them route no fees
+func (k Keeper) GetSwapFeeParams(ctx sdk.Context) *types.SwapFeeParams {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SwapFeeParamsPrefix)
+	if bz == nil {
+		return types.GetDefaultSwapFeeParams()
+	}
+	params := types.SwapFeeParams{}
+	k.cdc.MustUnmarshal(bz, &params)
+	return &params
+}
+
+func (k Keeper) SetPoolFeeAccrual(ctx sdk.Context, symbol string, accrual types.PoolFeeAccrual) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolFeeAccrualKey(symbol), k.cdc.MustMarshal(&accrual))
+}
+
+func (k Keeper) GetPoolFeeAccrual(ctx sdk.Context, symbol string) types.PoolFeeAccrual {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolFeeAccrualKey(symbol))
+	if bz == nil {
+		return types.NewPoolFeeAccrual()
+	}
+	accrual := types.PoolFeeAccrual{}
+	k.cdc.MustUnmarshal(bz, &accrual)
+	return accrual
+}
+
+func (k Keeper) DeletePoolFeeAccrual(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolFeeAccrualKey(symbol))
+}
+
+// calcProtocolFee returns the share of a pool swap fee sent to the fee collector
+func calcProtocolFee(swapFee sdk.Uint, protocolFeeRate sdk.Dec) sdk.Uint {
+	return sdk.NewUintFromBigInt(sdk.NewDecFromBigInt(swapFee.BigInt()).Mul(protocolFeeRate).TruncateInt().BigInt())
+}
+
+// CollectSwapFees splits the liquidity fee of a swap out of pool between the liquidity providers and the protocol.
+// The protocol share is taken out of swapFee, the part of the liquidity fee charged at the swap fee rate of the pool,
+// the slip fee always goes to the liquidity providers. The protocol share is taken out of the pool balance of
+// receivedAsset and sent to the fee collector, the rest stays in the pool. Both shares are added to the fee accrual
+// of the pool and the swap of sentAmount is added to the pool stats.
+func (k Keeper) CollectSwapFees(ctx sdk.Context, pool *types.Pool, sentAmount sdk.Uint, receivedAsset types.Asset, liquidityFee sdk.Uint, swapFee sdk.Uint) error {
+	k.RecordSwapStats(ctx, pool.ExternalAsset.Symbol, sentAmount, receivedAsset, liquidityFee)
+	if liquidityFee.IsZero() {
+		return nil
+	}
+	protocolFee := calcProtocolFee(swapFee, k.GetSwapFeeParams(ctx).ProtocolFeeRate)
+	lpFee := liquidityFee.Sub(protocolFee)
+
+	accrual := k.GetPoolFeeAccrual(ctx, pool.ExternalAsset.Symbol)
+	toRowan := receivedAsset.Equals(types.GetSettlementAsset())
+	if !protocolFee.IsZero() {
+		balance := pool.ExternalAssetBalance
+		if toRowan {
+			balance = pool.NativeAssetBalance
+		}
+		if protocolFee.GTE(balance) {
+			return sdkerrors.Wrap(types.ErrNotEnoughAssetTokens, "pool balance too low to pay the protocol fee")
+		}
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName,
+			sdk.NewCoins(sdk.NewCoin(receivedAsset.Symbol, sdk.NewIntFromBigInt(protocolFee.BigInt()))))
+		if err != nil {
+			return err
+		}
+	}
+	if toRowan {
+		pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(protocolFee)
+		accrual.LpFeesNative = accrual.LpFeesNative.Add(lpFee)
+		accrual.ProtocolFeesNative = accrual.ProtocolFeesNative.Add(protocolFee)
+	} else {
+		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(protocolFee)
+		accrual.LpFeesExternal = accrual.LpFeesExternal.Add(lpFee)
+		accrual.ProtocolFeesExternal = accrual.ProtocolFeesExternal.Add(protocolFee)
+	}
+	k.SetPoolFeeAccrual(ctx, pool.ExternalAsset.Symbol, accrual)
+	return nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_UpdateFeeRates(t *testing.T) {
+	admin := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif15ky9du8a2wlstz6fpx3p4mqpjyrm5cgqhns3lt"
+	ctx, app := createLimitOrderTestApp(t, admin)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	adminAddr, _ := sdk.AccAddressFromBech32(admin)
+	otherAddr, _ := sdk.AccAddressFromBech32(other)
+	rate := sdk.MustNewDecFromStr("0.003")
+
+	msg := types.NewMsgUpdateSwapFeeRate(otherAddr, types.NewAsset("ceth"), rate)
+	_, err := msgServer.UpdateSwapFeeRate(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	msg = types.NewMsgUpdateSwapFeeRate(adminAddr, types.NewAsset("cusdc"), rate)
+	_, err = msgServer.UpdateSwapFeeRate(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+	msg = types.NewMsgUpdateSwapFeeRate(adminAddr, types.NewAsset("ceth"), rate)
+	_, err = msgServer.UpdateSwapFeeRate(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, rate, pool.SwapFeeRateOrZero())
+
+	require.Equal(t, sdk.ZeroDec(), app.ClpKeeper.GetSwapFeeParams(ctx).ProtocolFeeRate)
+	protocolMsg := types.NewMsgUpdateProtocolFeeRate(otherAddr, sdk.MustNewDecFromStr("0.1"))
+	_, err = msgServer.UpdateProtocolFeeRate(sdk.WrapSDKContext(ctx), &protocolMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	protocolMsg = types.NewMsgUpdateProtocolFeeRate(adminAddr, sdk.MustNewDecFromStr("0.1"))
+	_, err = msgServer.UpdateProtocolFeeRate(sdk.WrapSDKContext(ctx), &protocolMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), app.ClpKeeper.GetSwapFeeParams(ctx).ProtocolFeeRate)
+}
+
+func TestKeeper_SwapWithFees(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	signer, _ := sdk.AccAddressFromBech32(address)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	rateMsg := types.NewMsgUpdateSwapFeeRate(signer, types.NewAsset("ceth"), sdk.MustNewDecFromStr("0.01"))
+	_, err := msgServer.UpdateSwapFeeRate(sdk.WrapSDKContext(ctx), &rateMsg)
+	require.NoError(t, err)
+	protocolMsg := types.NewMsgUpdateProtocolFeeRate(signer, sdk.MustNewDecFromStr("0.5"))
+	_, err = msgServer.UpdateProtocolFeeRate(sdk.WrapSDKContext(ctx), &protocolMsg)
+	require.NoError(t, err)
+
+	// Without fees the swap returns 999998 ceth with a slip fee of 1, the pool keeps 1% of the output on top.
+	// The protocol takes half of that swap fee of 9999, the slip fee goes to the liquidity providers only.
+	swapMsg := types.NewMsgSwap(signer, types.GetSettlementAsset(), types.NewAsset("ceth"), sdk.NewUint(1000000), sdk.NewUint(1))
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(3000000+989999), app.BankKeeper.GetBalance(ctx, signer, "ceth").Amount)
+	require.Equal(t, sdk.NewInt(4999), app.BankKeeper.GetBalance(ctx, feeCollector, "ceth").Amount)
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(1000000000000-989999-4999), pool.ExternalAssetBalance)
+	require.Equal(t, sdk.NewUint(1000000000000+1000000), pool.NativeAssetBalance)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	res, err := querier.GetPoolFees(sdk.WrapSDKContext(ctx), &types.PoolFeesReq{Symbol: "ceth"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), res.SwapFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), res.ProtocolFeeRate)
+	require.Equal(t, types.PoolFeeAccrual{
+		LpFeesNative:         sdk.ZeroUint(),
+		LpFeesExternal:       sdk.NewUint(5001),
+		ProtocolFeesNative:   sdk.ZeroUint(),
+		ProtocolFeesExternal: sdk.NewUint(4999),
+	}, res.Accrual)
+
+	_, err = querier.GetPoolFees(sdk.WrapSDKContext(ctx), &types.PoolFeesReq{Symbol: "cusdc"})
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+}
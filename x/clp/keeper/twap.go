@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+func (k Keeper) SetTwapRecord(ctx sdk.Context, symbol string, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTwapRecordKey(symbol, record.Timestamp), k.cdc.MustMarshal(&record))
+}
+
+func (k Keeper) GetTwapRecord(ctx sdk.Context, symbol string, timestamp int64) (types.TwapRecord, error) {
+	var record types.TwapRecord
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTwapRecordKey(symbol, timestamp))
+	if bz == nil {
+		return record, types.ErrTwapRecordNotFound
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, nil
+}
+
+func (k Keeper) SetTwapAccumulator(ctx sdk.Context, symbol string, accumulator types.TwapAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTwapAccumulatorKey(symbol), k.cdc.MustMarshal(&accumulator))
+}
+
+func (k Keeper) GetTwapAccumulator(ctx sdk.Context, symbol string) (types.TwapAccumulator, bool) {
+	var accumulator types.TwapAccumulator
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTwapAccumulatorKey(symbol))
+	if bz == nil {
+		return accumulator, false
+	}
+	k.cdc.MustUnmarshal(bz, &accumulator)
+	return accumulator, true
+}
+
+// GetTwapRecords lists the cumulative price records of a pool in ascending time
+func (k Keeper) GetTwapRecords(ctx sdk.Context, symbol string) []types.TwapRecord {
+	var records []types.TwapRecord
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTwapRecordPoolPrefix(symbol))
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetLatestTwapRecord returns the most recent cumulative price record of a pool
+func (k Keeper) GetLatestTwapRecord(ctx sdk.Context, symbol string) (types.TwapRecord, error) {
+	accumulator, found := k.GetTwapAccumulator(ctx, symbol)
+	if !found {
+		return types.TwapRecord{}, types.ErrTwapRecordNotFound
+	}
+	return k.GetTwapRecord(ctx, symbol, accumulator.LastTimestamp)
+}
+
+// GetTwapRecordAtHeight returns the last cumulative price record of a pool at or before height
+func (k Keeper) GetTwapRecordAtHeight(ctx sdk.Context, symbol string, height int64) (types.TwapRecord, error) {
+	return k.getLastTwapRecordBefore(ctx, symbol, func(record types.TwapRecord) bool {
+		return record.Height <= height
+	})
+}
+
+// GetTwapRecordAtTime returns the last cumulative price record of a pool at or before timestamp
+func (k Keeper) GetTwapRecordAtTime(ctx sdk.Context, symbol string, timestamp int64) (types.TwapRecord, error) {
+	return k.getLastTwapRecordBefore(ctx, symbol, func(record types.TwapRecord) bool {
+		return record.Timestamp <= timestamp
+	})
+}
+
+// getLastTwapRecordBefore walks the records of a pool from the latest one and returns the first match
+func (k Keeper) getLastTwapRecordBefore(ctx sdk.Context, symbol string, match func(types.TwapRecord) bool) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetTwapRecordPoolPrefix(symbol))
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if match(record) {
+			return record, nil
+		}
+	}
+	return types.TwapRecord{}, types.ErrTwapRecordNotFound
+}
+
+// DeleteTwapRecords removes every cumulative price record of a pool
+func (k Keeper) DeleteTwapRecords(ctx sdk.Context, symbol string) {
+	k.pruneTwapRecords(ctx, symbol, func(types.TwapRecord) bool { return true })
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTwapAccumulatorKey(symbol))
+}
+
+// pruneTwapRecords deletes the records of a pool in ascending time until shouldDelete returns false
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, symbol string, shouldDelete func(types.TwapRecord) bool) {
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTwapRecordPoolPrefix(symbol))
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if !shouldDelete(record) {
+			break
+		}
+		keys = append(keys, iterator.Key())
+	}
+	err := iterator.Close()
+	if err != nil {
+		panic(err)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateTwapRecords accumulates the swap prices set by PolicyRun into a new record for every pool
+// and prunes the records older than the retention period
+func (k Keeper) UpdateTwapRecords(ctx sdk.Context) {
+	timestamp := ctx.BlockTime().Unix()
+	cutoff := timestamp - types.TwapRecordRetentionPeriod
+	for _, pool := range k.GetPools(ctx) {
+		if pool.SwapPriceNative == nil || pool.SwapPriceExternal == nil {
+			continue
+		}
+		symbol := pool.ExternalAsset.Symbol
+		record := types.TwapRecord{
+			Height:                  ctx.BlockHeight(),
+			Timestamp:               timestamp,
+			PriceNativeCumulative:   sdk.ZeroDec(),
+			PriceExternalCumulative: sdk.ZeroDec(),
+			LastPriceNative:         *pool.SwapPriceNative,
+			LastPriceExternal:       *pool.SwapPriceExternal,
+		}
+		accumulator, found := k.GetTwapAccumulator(ctx, symbol)
+		if !found {
+			accumulator.FirstTimestamp = timestamp
+		} else if last, err := k.GetTwapRecord(ctx, symbol, accumulator.LastTimestamp); err == nil {
+			// The previous prices were in effect from the previous record until now.
+			// A record within the same second as the previous one replaces it.
+			elapsed := sdk.ZeroDec()
+			if timestamp > last.Timestamp {
+				elapsed = sdk.NewDec(timestamp - last.Timestamp)
+			}
+			record.PriceNativeCumulative = last.PriceNativeCumulative.Add(last.LastPriceNative.Mul(elapsed))
+			record.PriceExternalCumulative = last.PriceExternalCumulative.Add(last.LastPriceExternal.Mul(elapsed))
+		}
+		k.SetTwapRecord(ctx, symbol, record)
+		accumulator.LastTimestamp = timestamp
+		if accumulator.FirstTimestamp < cutoff {
+			k.pruneTwapRecords(ctx, symbol, func(r types.TwapRecord) bool {
+				if r.Timestamp < cutoff {
+					return true
+				}
+				accumulator.FirstTimestamp = r.Timestamp
+				return false
+			})
+		}
+		k.SetTwapAccumulator(ctx, symbol, accumulator)
+	}
+}
+
+// CalculateTwap returns the time weighted average native and external prices of a pool between
+// the start record and the latest record, along with the latest record
+func (k Keeper) CalculateTwap(ctx sdk.Context, symbol string, start types.TwapRecord) (sdk.Dec, sdk.Dec, types.TwapRecord, error) {
+	end, err := k.GetLatestTwapRecord(ctx, symbol)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, types.TwapRecord{}, err
+	}
+	if end.Timestamp <= start.Timestamp {
+		return end.LastPriceNative, end.LastPriceExternal, end, nil
+	}
+	elapsed := sdk.NewDec(end.Timestamp - start.Timestamp)
+	priceNative := end.PriceNativeCumulative.Sub(start.PriceNativeCumulative).Quo(elapsed)
+	priceExternal := end.PriceExternalCumulative.Sub(start.PriceExternalCumulative).Quo(elapsed)
+	return priceNative, priceExternal, end, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_UpdateTwapRecords(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	clpKeeper := app.ClpKeeper
+	startTime := time.Unix(1600000000, 0)
+	pool := types.Pool{
+		ExternalAsset:        &types.Asset{Symbol: "eth"},
+		NativeAssetBalance:   sdk.NewUint(1000),
+		ExternalAssetBalance: sdk.NewUint(1000),
+		PoolUnits:            sdk.NewUint(1000),
+	}
+	setPrices := func(ctx sdk.Context, native, external sdk.Dec) {
+		pool.SwapPriceNative = &native
+		pool.SwapPriceExternal = &external
+		err := clpKeeper.SetPool(ctx, &pool)
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(startTime)
+	setPrices(ctx, sdk.NewDec(1), sdk.NewDec(1))
+	clpKeeper.UpdateTwapRecords(ctx)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+	setPrices(ctx, sdk.NewDec(3), sdk.MustNewDecFromStr("0.5"))
+	clpKeeper.UpdateTwapRecords(ctx)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(30 * time.Second))
+	clpKeeper.UpdateTwapRecords(ctx)
+
+	latest, err := clpKeeper.GetLatestTwapRecord(ctx, "eth")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), latest.Height)
+	require.Equal(t, sdk.NewDec(70), latest.PriceNativeCumulative)
+	require.Equal(t, sdk.NewDec(20), latest.PriceExternalCumulative)
+
+	start, err := clpKeeper.GetTwapRecordAtHeight(ctx, "eth", 1)
+	require.NoError(t, err)
+	priceNative, priceExternal, end, err := clpKeeper.CalculateTwap(ctx, "eth", start)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), end.Height)
+	require.Equal(t, sdk.MustNewDecFromStr("2.333333333333333333"), priceNative)
+	require.Equal(t, sdk.MustNewDecFromStr("0.666666666666666667"), priceExternal)
+
+	start, err = clpKeeper.GetTwapRecordAtTime(ctx, "eth", startTime.Add(15*time.Second).Unix())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), start.Height)
+	priceNative, priceExternal, _, err = clpKeeper.CalculateTwap(ctx, "eth", start)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), priceNative)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), priceExternal)
+
+	_, err = clpKeeper.GetTwapRecordAtTime(ctx, "eth", startTime.Add(-time.Second).Unix())
+	require.ErrorIs(t, err, types.ErrTwapRecordNotFound)
+
+	// Records older than the retention period are pruned
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add((types.TwapRecordRetentionPeriod + 31) * time.Second))
+	clpKeeper.UpdateTwapRecords(ctx)
+	_, err = clpKeeper.GetTwapRecordAtHeight(ctx, "eth", 3)
+	require.ErrorIs(t, err, types.ErrTwapRecordNotFound)
+	latest, err = clpKeeper.GetLatestTwapRecord(ctx, "eth")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), latest.Height)
+
+	err = clpKeeper.DestroyPool(ctx, "eth")
+	require.NoError(t, err)
+	_, err = clpKeeper.GetLatestTwapRecord(ctx, "eth")
+	require.ErrorIs(t, err, types.ErrTwapRecordNotFound)
+}
+
+func TestQuerier_GetTwap(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	startTime := time.Unix(1600000000, 0)
+	native, external := sdk.NewDec(2), sdk.MustNewDecFromStr("0.5")
+	pool := types.Pool{
+		ExternalAsset:        &types.Asset{Symbol: "eth"},
+		NativeAssetBalance:   sdk.NewUint(1000),
+		ExternalAssetBalance: sdk.NewUint(1000),
+		PoolUnits:            sdk.NewUint(1000),
+		SwapPriceNative:      &native,
+		SwapPriceExternal:    &external,
+	}
+	err := app.ClpKeeper.SetPool(ctx, &pool)
+	require.NoError(t, err)
+	for height := int64(1); height <= 5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(startTime.Add(time.Duration(height*6) * time.Second))
+		app.ClpKeeper.UpdateTwapRecords(ctx)
+	}
+
+	_, err = querier.GetTwap(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	_, err = querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "eth"})
+	require.Error(t, err)
+	_, err = querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "eth", StartHeight: 1, StartTime: startTime.Unix()})
+	require.Error(t, err)
+	_, err = querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "cusdc", StartHeight: 1})
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+
+	res, err := querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "eth", StartHeight: 2})
+	require.NoError(t, err)
+	require.Equal(t, native, res.PriceNative)
+	require.Equal(t, external, res.PriceExternal)
+	require.Equal(t, int64(2), res.StartHeight)
+	require.Equal(t, int64(5), res.EndHeight)
+	require.Equal(t, int64(18), res.EndTime-res.StartTime)
+
+	res, err = querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "eth", StartTime: startTime.Add(20 * time.Second).Unix()})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.StartHeight)
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// WindDownPool starts the wind down of pool, its liquidity providers are settled gracePeriod blocks from now and paid in
+// payoutSymbol, or in both assets when it is empty
+func (k Keeper) WindDownPool(ctx sdk.Context, pool types.Pool, gracePeriod int64, payoutSymbol string) error {
+	pool.WindDownEndHeight = ctx.BlockHeight() + gracePeriod
+	pool.WindDownSettleFailures = 0
+	pool.WindDownPayoutSymbol = payoutSymbol
+	err := k.SetPool(ctx, &pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWindDownPool,
+		sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+		sdk.NewAttribute(types.AttributeKeyWindDownEndHeight, strconv.FormatInt(pool.WindDownEndHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyPayoutSymbol, pool.WindDownPayoutSymbol),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return nil
+}
+
+// CancelPoolWindDown ends the wind down of pool, its swaps and liquidity additions are enabled again
+func (k Keeper) CancelPoolWindDown(ctx sdk.Context, pool types.Pool) error {
+	if pool.WindDownEndHeight == 0 {
+		return sdkerrors.Wrap(types.ErrPoolNotWindingDown, pool.ExternalAsset.Symbol)
+	}
+	pool.WindDownEndHeight = 0
+	pool.WindDownSettleFailures = 0
+	pool.WindDownPayoutSymbol = ""
+	err := k.SetPool(ctx, &pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelPoolWindDown,
+		sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+	return nil
+}
+
+// SettlePool pays the balances of pool to its liquidity providers pro rata to their units, emitting a settlement
+// event per provider, and decommissions it. Pools with a payout asset swap the share of each provider into it against
+// the liquidity of the providers not settled yet. Positions other modules hold against the pool are settled first, then
+// the open limit orders and queued swaps of the pool are refunded. Holders of liquidity provider tokens received by
+// transfer are paid like any other liquidity provider, as transfers give them a liquidity provider record.
+func (k Keeper) SettlePool(ctx sdk.Context, pool types.Pool) error {
+	k.BeforePoolDecommissioned(ctx, pool)
+	err := k.refundPoolOrders(ctx, pool.ExternalAsset.Symbol)
+	if err != nil {
+		return err
+	}
+	pool, err = k.GetPool(ctx, pool.ExternalAsset.Symbol)
+	if err != nil {
+		return err
+	}
+	lpList, err := k.getLiquidityProvidersForAsset(ctx, *pool.ExternalAsset)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrLiquidityProviderDoesNotExist, err.Error())
+	}
+	for _, record := range lpList {
+		// Only the units backed by liquidity provider tokens are paid out
+		lp, err := k.SyncLiquidityProvider(ctx, pool.ExternalAsset.Symbol, record.LiquidityProviderAddress)
+		if errors.Is(err, types.ErrLiquidityProviderDoesNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Shares are taken from what is left of the pool, so that the rounding remainders go to the last provider
+		units := sdk.MinUint(lp.LiquidityProviderUnits, pool.PoolUnits)
+		withdrawNativeAsset := sdk.ZeroUint()
+		withdrawExternalAsset := sdk.ZeroUint()
+		if !pool.PoolUnits.IsZero() {
+			withdrawNativeAsset = pool.NativeAssetBalance.Mul(units).Quo(pool.PoolUnits)
+			withdrawExternalAsset = pool.ExternalAssetBalance.Mul(units).Quo(pool.PoolUnits)
+		}
+		pool.PoolUnits = pool.PoolUnits.Sub(units)
+		pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(withdrawNativeAsset)
+		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(withdrawExternalAsset)
+		withdrawNativeAsset, withdrawExternalAsset, err = k.swapSettlementPayout(ctx, &pool, lp, withdrawNativeAsset, withdrawExternalAsset)
+		if err != nil {
+			return err
+		}
+
+		withdrawNativeAssetInt, ok := k.ParseToInt(withdrawNativeAsset.String())
+		if !ok {
+			return types.ErrUnableToParseInt
+		}
+		withdrawExternalAssetInt, ok := k.ParseToInt(withdrawExternalAsset.String())
+		if !ok {
+			return types.ErrUnableToParseInt
+		}
+		withdrawNativeCoins := sdk.NewCoin(types.GetSettlementAsset().Symbol, withdrawNativeAssetInt)
+		withdrawExternalCoins := sdk.NewCoin(pool.ExternalAsset.Symbol, withdrawExternalAssetInt)
+		refundingCoins := sdk.NewCoins(withdrawExternalCoins, withdrawNativeCoins)
+		err = k.RemoveLiquidityProvider(ctx, refundingCoins, lp)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrUnableToRemoveLiquidityProvider, err.Error())
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSettleLiquidity,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, lp.LiquidityProviderAddress),
+			sdk.NewAttribute(types.AttributeKeyUnits, lp.LiquidityProviderUnits.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, withdrawNativeAsset.String()),
+			sdk.NewAttribute(types.AttributeKeyExternalAmount, withdrawExternalAsset.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+	// Pool should be empty at this point
+	err = k.DecommissionPool(ctx, pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToDecommissionPool, err.Error())
+	}
+	return nil
+}
+
+// swapSettlementPayout swaps the share of the liquidity provider lp of a settled pool into the payout asset of pool,
+// against the liquidity left in pool. The share is paid in both assets when too little liquidity is left to swap it,
+// which is always the case for the last liquidity provider.
+func (k Keeper) swapSettlementPayout(ctx sdk.Context, pool *types.Pool, lp types.LiquidityProvider, nativeAmount, externalAmount sdk.Uint) (sdk.Uint, sdk.Uint, error) {
+	if pool.WindDownPayoutSymbol == "" || pool.NativeAssetBalance.IsZero() || pool.ExternalAssetBalance.IsZero() {
+		return nativeAmount, externalAmount, nil
+	}
+	sentAsset, sentAmount, receivedAsset := *pool.ExternalAsset, externalAmount, types.GetSettlementAsset()
+	if pool.WindDownPayoutSymbol == pool.ExternalAsset.Symbol {
+		sentAsset, sentAmount, receivedAsset = receivedAsset, nativeAmount, sentAsset
+	}
+	if sentAmount.IsZero() {
+		return nativeAmount, externalAmount, nil
+	}
+	normalizationFactor, adjustExternalToken := k.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+	if normalizationFactor.IsNil() {
+		return nativeAmount, externalAmount, nil
+	}
+	lpAddress, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	var swapResult sdk.Uint
+	var swappedPool types.Pool
+	err = ApplyCached(ctx, func(ctx sdk.Context) error {
+		var liquidityFee, swapFee sdk.Uint
+		var err error
+		swapResult, liquidityFee, swapFee, _, swappedPool, err = SwapOneWithSwapFee(sentAsset, sentAmount, receivedAsset, *pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+		if err != nil {
+			return err
+		}
+		return k.SettleSwap(ctx, lpAddress, &swappedPool, sentAsset, sentAmount, receivedAsset, swapResult, liquidityFee, swapFee)
+	})
+	if errors.Is(err, types.ErrNotEnoughAssetTokens) {
+		return nativeAmount, externalAmount, nil
+	}
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	*pool = swappedPool
+	if receivedAsset.Equals(types.GetSettlementAsset()) {
+		return nativeAmount.Add(swapResult), sdk.ZeroUint(), nil
+	}
+	return sdk.ZeroUint(), externalAmount.Add(swapResult), nil
+}
+
+// getLiquidityProvidersForAsset returns every liquidity provider of the pool of asset. The filtered pagination cannot be
+// used to list them all, as its end overflows with an unbounded limit and stops at the first provider of another pool.
+func (k Keeper) getLiquidityProvidersForAsset(ctx sdk.Context, asset types.Asset) ([]types.LiquidityProvider, error) {
+	var lpList []types.LiquidityProvider
+	iterator := k.GetLiquidityProviderIterator(ctx)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var lp types.LiquidityProvider
+		err := k.cdc.Unmarshal(iterator.Value(), &lp)
+		if err != nil {
+			return nil, err
+		}
+		if lp.Asset != nil && lp.Asset.Equals(asset) {
+			lpList = append(lpList, lp)
+		}
+	}
+	return lpList, nil
+}
+
+// refundPoolOrders refunds the open limit orders and the queued swaps of the pool of symbol
+func (k Keeper) refundPoolOrders(ctx sdk.Context, symbol string) error {
+	for _, order := range k.GetLimitOrders(ctx) {
+		if order.PoolAsset.Symbol != symbol {
+			continue
+		}
+		err := k.RefundLimitOrder(ctx, *order)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+	for _, swap := range k.GetQueuedSwaps(ctx) {
+		if swap.PoolAsset.Symbol != symbol {
+			continue
+		}
+		err := k.refundQueuedSwap(ctx, *swap, "pool decommissioned")
+		if err != nil {
+			return err
+		}
+		k.DeleteQueuedSwap(ctx, *swap)
+	}
+	return nil
+}
+
+// SettleWoundDownPools settles the pools whose grace period ended, a pool failing to settle emits a failure event and
+// is retried at the next blocks. Once its settlement failed MaxSettlePoolFailures times in a row it is left winding down
+// until an admin reschedules or cancels its wind down.
+func (k Keeper) SettleWoundDownPools(ctx sdk.Context) {
+	for _, pool := range k.GetPools(ctx) {
+		if pool.WindDownEndHeight == 0 || pool.WindDownEndHeight > ctx.BlockHeight() ||
+			pool.WindDownSettleFailures >= types.MaxSettlePoolFailures {
+			continue
+		}
+		err := ApplyCached(ctx, func(ctx sdk.Context) error {
+			return k.SettlePool(ctx, *pool)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to settle pool %s : %s", pool.ExternalAsset.Symbol, err.Error()))
+			pool.WindDownSettleFailures++
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSettlePoolFailed,
+				sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeySettleFailures, strconv.FormatUint(uint64(pool.WindDownSettleFailures), 10)),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			))
+			if pool.WindDownSettleFailures >= types.MaxSettlePoolFailures {
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.EventTypeSettlePoolStopped,
+					sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+					sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				))
+			}
+			err = k.SetPool(ctx, pool)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to count the settlement failure of pool %s : %s", pool.ExternalAsset.Symbol, err.Error()))
+			}
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDecommissionPool,
+			sdk.NewAttribute(types.AttributeKeyPool, pool.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+}
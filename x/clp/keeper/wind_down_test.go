@@ -0,0 +1,303 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+)
+
+// createWindDownTestApp creates a cusdc pool with creator as its first liquidity provider and funds provider
+func createWindDownTestApp(t *testing.T, address string, creator, provider sdk.AccAddress) (sdk.Context, *sifapp.SifchainApp) {
+	ctx, app := createLimitOrderTestApp(t, address)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:               usdc.Symbol,
+		BaseDenom:           usdc.Symbol,
+		Decimals:            18,
+		Permissions:         []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+		MinPoolNativeAmount: "1",
+	})
+	funds := sdk.NewCoins(sdk.NewCoin(rowan.Symbol, sdk.NewInt(100000000000)), sdk.NewCoin(usdc.Symbol, sdk.NewInt(100000000000)))
+	for _, addr := range []sdk.AccAddress{creator, provider} {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, funds))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, funds))
+	}
+	createMsg := types.NewMsgCreatePool(creator, usdc, sdk.NewUint(10000000000), sdk.NewUint(10000000000))
+	_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), &createMsg)
+	require.NoError(t, err)
+	return ctx, app
+}
+
+func TestMsgServer_WindDownPool(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	creator := sdk.AccAddress("creator_____________")
+	provider := sdk.AccAddress("provider____________")
+	ctx, app := createWindDownTestApp(t, address, creator, provider)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+	addMsg := types.NewMsgAddLiquidity(provider, usdc, sdk.NewUint(3333333333), sdk.NewUint(3333333333))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+
+	// Only admins can wind down pools
+	decommissionMsg := types.NewMsgDecommissionPool(creator, usdc.Symbol, 10, "")
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	decommissionMsg = types.NewMsgDecommissionPool(admin, usdc.Symbol, 10, "")
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	endHeight := ctx.BlockHeight() + 10
+	require.Equal(t, endHeight, pool.WindDownEndHeight)
+	thresholdMsg := types.NewMsgDecommissionPool(admin, usdc.Symbol, 0, "")
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &thresholdMsg)
+	require.ErrorIs(t, err, types.ErrPoolWindingDown)
+
+	// Swaps and additions fail while providers withdraw without unlocking first
+	swapMsg := types.NewMsgSwap(creator, rowan, usdc, sdk.NewUint(1000000), sdk.ZeroUint())
+	// The swap moves the sent coins before failing, a failing tx reverts it
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Swap(sdk.WrapSDKContext(cacheCtx), &swapMsg)
+	require.ErrorIs(t, err, types.ErrPoolWindingDown)
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.ErrorIs(t, err, types.ErrPoolWindingDown)
+	orderMsg := types.NewMsgPlaceLimitOrder(creator, usdc, rowan, sdk.NewUint(1000000), sdk.MustNewDecFromStr("0.5"), 0)
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &orderMsg)
+	require.ErrorIs(t, err, types.ErrPoolWindingDown)
+	removeMsg := types.NewMsgRemoveLiquidity(creator, usdc, sdk.NewInt(5000), sdk.ZeroInt())
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &removeMsg)
+	require.NoError(t, err)
+
+	app.ClpKeeper.SettleWoundDownPools(ctx.WithBlockHeight(endHeight - 1))
+	_, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+
+	// The remaining balances are paid pro rata with a settlement event per provider
+	ctx = ctx.WithBlockHeight(endHeight).WithEventManager(sdk.NewEventManager())
+	pool, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	providerBalance := app.BankKeeper.GetBalance(ctx, provider, usdc.Symbol)
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, usdc.Symbol, provider.String())
+	require.NoError(t, err)
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+	_, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+	_, err = app.ClpKeeper.GetLiquidityProvider(ctx, usdc.Symbol, provider.String())
+	require.Error(t, err)
+	var settled int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettleLiquidity {
+			settled++
+		}
+	}
+	require.Equal(t, 2, settled)
+	providerShare := pool.ExternalAssetBalance.Mul(lp.LiquidityProviderUnits).Quo(pool.PoolUnits)
+	require.Equal(t, providerBalance.Amount.Add(sdk.NewIntFromBigInt(providerShare.BigInt())), app.BankKeeper.GetBalance(ctx, provider, usdc.Symbol).Amount)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_SettlePool_RefundsOrdersAndPaysTokenHolders(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	creator := sdk.AccAddress("creator_____________")
+	provider := sdk.AccAddress("provider____________")
+	holder := sdk.AccAddress("holder______________")
+	ctx, app := createWindDownTestApp(t, address, creator, provider)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+	lpDenom := types.GetLiquidityProviderTokenDenom(usdc.Symbol)
+
+	// Half of the units of the creator are sent away by bank transfer, their holder becomes a liquidity provider
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, usdc.Symbol, creator.String())
+	require.NoError(t, err)
+	sent := sdk.NewCoin(lpDenom, sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.QuoUint64(2).BigInt()))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, creator, holder, sdk.NewCoins(sent)))
+	_, err = app.ClpKeeper.GetLiquidityProvider(ctx, usdc.Symbol, holder.String())
+	require.NoError(t, err)
+
+	orderMsg := types.NewMsgPlaceLimitOrder(provider, usdc, rowan, sdk.NewUint(1000000), sdk.NewDec(2), 0)
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &orderMsg)
+	require.NoError(t, err)
+	modeMsg := types.NewMsgUpdatePoolSwapMode(admin, usdc, types.SwapMode_SWAP_MODE_BATCH)
+	_, err = msgServer.UpdatePoolSwapMode(sdk.WrapSDKContext(ctx), &modeMsg)
+	require.NoError(t, err)
+	swapMsg := types.NewMsgSwap(provider, rowan, usdc, sdk.NewUint(2000000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), 1)
+	require.Len(t, app.ClpKeeper.GetQueuedSwaps(ctx), 1)
+	require.Equal(t, sdk.NewInt(99999000000), app.BankKeeper.GetBalance(ctx, provider, usdc.Symbol).Amount)
+	require.Equal(t, sdk.NewInt(99998000000), app.BankKeeper.GetBalance(ctx, provider, rowan.Symbol).Amount)
+
+	decommissionMsg := types.NewMsgDecommissionPool(admin, usdc.Symbol, 10, "")
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(pool.WindDownEndHeight).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+
+	_, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+	require.Empty(t, app.ClpKeeper.GetLimitOrders(ctx))
+	require.Empty(t, app.ClpKeeper.GetQueuedSwaps(ctx))
+	require.Equal(t, sdk.NewInt(100000000000), app.BankKeeper.GetBalance(ctx, provider, usdc.Symbol).Amount)
+	require.Equal(t, sdk.NewInt(100000000000), app.BankKeeper.GetBalance(ctx, provider, rowan.Symbol).Amount)
+	// The holder is paid its share of the pool like any other liquidity provider
+	holderShare := pool.ExternalAssetBalance.Mul(sdk.NewUintFromBigInt(sent.Amount.BigInt())).Quo(pool.PoolUnits)
+	require.Equal(t, sdk.NewIntFromBigInt(holderShare.BigInt()), app.BankKeeper.GetBalance(ctx, holder, usdc.Symbol).Amount)
+	require.True(t, app.BankKeeper.GetSupply(ctx, lpDenom).IsZero())
+	var settled int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettleLiquidity {
+			settled++
+		}
+	}
+	require.Equal(t, 2, settled)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)).AmountOf(usdc.Symbol).IsZero())
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestMsgServer_WindDownPool_SettlementFails(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	creator := sdk.AccAddress("creator_____________")
+	provider := sdk.AccAddress("provider____________")
+	ctx, app := createWindDownTestApp(t, address, creator, provider)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+
+	// Module accounts cannot receive funds, so liquidity provider tokens sent to one cannot be settled
+	lpCoins := sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom(usdc.Symbol), sdk.NewInt(1000)))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, creator, authtypes.NewModuleAddress(distrtypes.ModuleName), lpCoins))
+	decommissionMsg := types.NewMsgDecommissionPool(admin, usdc.Symbol, 10, "")
+	_, err := msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+	pool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	var failed int
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeSettleLiquidity, event.Type)
+		if event.Type == types.EventTypeSettlePoolFailed {
+			failed++
+		}
+	}
+	require.Equal(t, 1, failed)
+	require.Equal(t, uint32(1), pool.WindDownSettleFailures)
+	_, err = app.ClpKeeper.GetLiquidityProvider(ctx, usdc.Symbol, creator.String())
+	require.NoError(t, err)
+
+	// The settlement is retried at the next blocks until it failed too many times
+	for i := 1; i < types.MaxSettlePoolFailures; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		app.ClpKeeper.SettleWoundDownPools(ctx)
+	}
+	stopped := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettlePoolStopped {
+			stopped++
+		}
+	}
+	require.Equal(t, 1, stopped)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	pool, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.MaxSettlePoolFailures), pool.WindDownSettleFailures)
+
+	// The admin can reschedule the settlement
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	pool, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+10, pool.WindDownEndHeight)
+	require.Zero(t, pool.WindDownSettleFailures)
+
+	// Or cancel the wind down, swaps are enabled again
+	cancelMsg := types.NewMsgCancelPoolWindDown(creator, usdc)
+	_, err = msgServer.CancelPoolWindDown(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	cancelMsg = types.NewMsgCancelPoolWindDown(admin, usdc)
+	_, err = msgServer.CancelPoolWindDown(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.NoError(t, err)
+	_, err = msgServer.CancelPoolWindDown(sdk.WrapSDKContext(ctx), &cancelMsg)
+	require.ErrorIs(t, err, types.ErrPoolNotWindingDown)
+	pool, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	require.Zero(t, pool.WindDownEndHeight)
+	swapMsg := types.NewMsgSwap(provider, rowan, usdc, sdk.NewUint(1000000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &swapMsg)
+	require.NoError(t, err)
+	app.ClpKeeper.SettleWoundDownPools(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	_, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
+
+func TestKeeper_SettlePool_PayoutAsset(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	creator := sdk.AccAddress("creator_____________")
+	provider := sdk.AccAddress("provider____________")
+	ctx, app := createWindDownTestApp(t, address, creator, provider)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin, _ := sdk.AccAddressFromBech32(address)
+	usdc := types.NewAsset("cusdc")
+	rowan := types.GetSettlementAsset()
+	addMsg := types.NewMsgAddLiquidity(provider, usdc, sdk.NewUint(3333333333), sdk.NewUint(3333333333))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &addMsg)
+	require.NoError(t, err)
+
+	decommissionMsg := types.NewMsgDecommissionPool(admin, usdc.Symbol, 10, rowan.Symbol)
+	_, err = msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, rowan.Symbol, pool.WindDownPayoutSymbol)
+	balances := map[string]sdk.Coins{}
+	for _, addr := range []sdk.AccAddress{creator, provider} {
+		balances[addr.String()] = app.BankKeeper.GetAllBalances(ctx, addr)
+	}
+	ctx = ctx.WithBlockHeight(pool.WindDownEndHeight).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+	_, err = app.ClpKeeper.GetPool(ctx, usdc.Symbol)
+	require.ErrorIs(t, err, types.ErrPoolDoesNotExist)
+
+	// The first provider is paid in rowan only, the last one is paid in both assets as no liquidity is left to swap
+	var settled []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSettleLiquidity {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyLiquidityProvider {
+				settled = append(settled, string(attribute.Value))
+			}
+		}
+	}
+	require.Len(t, settled, 2)
+	first, _ := sdk.AccAddressFromBech32(settled[0])
+	last, _ := sdk.AccAddressFromBech32(settled[1])
+	require.Equal(t, balances[first.String()].AmountOf(usdc.Symbol), app.BankKeeper.GetBalance(ctx, first, usdc.Symbol).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, first, rowan.Symbol).Amount.GT(balances[first.String()].AmountOf(rowan.Symbol)))
+	require.True(t, app.BankKeeper.GetBalance(ctx, last, usdc.Symbol).Amount.GT(balances[last.String()].AmountOf(usdc.Symbol)))
+	require.True(t, app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), usdc.Symbol).IsZero())
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+}
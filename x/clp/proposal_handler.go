@@ -0,0 +1,38 @@
+package clp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// NewProposalHandler creates a govtypes.Handler executing the clp admin actions approved by governance
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdatePmtpParamsProposal:
+			_, err := k.AddPmtpPolicy(ctx, c.PmtpPeriodGovernanceRate, c.PmtpPeriodEpochLength, c.PmtpPeriodStartBlock, c.PmtpPeriodEndBlock)
+			return err
+		case *types.ModifyPmtpRatesProposal:
+			_, err := k.UpdatePmtpRates(ctx, c.BlockRate, c.RunningRate, c.EndPolicy)
+			return err
+		case *types.AddRewardPeriodProposal:
+			return k.ReplaceRewardPeriods(ctx, c.RewardPeriods)
+		case *types.UpdateRewardsParamsProposal:
+			k.SetLiquidityRemovalPeriods(ctx, c.LiquidityRemovalLockPeriod, c.LiquidityRemovalCancelPeriod)
+			k.SetRewardPeriodFundingModules(ctx, c.RewardPeriodFundingModules)
+			return nil
+		case *types.UpdateStakingRewardParamsProposal:
+			k.SetStakingRewardParams(ctx, c.Minter, c.Params)
+			return nil
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s proposal content type: %T", types.ModuleName, c)
+			return errors.Wrap(errors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package clp_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/clp"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+)
+
+func TestProposalHandler(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	// After the pmtp window of the test app, so that the rates can be modified
+	ctx = ctx.WithBlockHeight(5)
+	handler := clp.NewProposalHandler(app.ClpKeeper)
+
+	err := handler(ctx, govtypes.NewTextProposal("title", "description"))
+	require.Error(t, err)
+
+	err = handler(ctx, clptypes.NewUpdatePmtpParamsProposal("title", "description", "0.2", 1, 10, 19))
+	require.NoError(t, err)
+	pending := app.ClpKeeper.GetPendingPmtpPolicies(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), pending[0].Params.PmtpPeriodGovernanceRate)
+	err = handler(ctx, clptypes.NewUpdatePmtpParamsProposal("title", "description", "0.2", 1, 15, 24))
+	require.ErrorIs(t, err, clptypes.ErrPmtpPolicyOverlap)
+
+	err = handler(ctx, clptypes.NewModifyPmtpRatesProposal("title", "description", "0.01", "0.5", false))
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), app.ClpKeeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.01"), app.ClpKeeper.GetPmtpRateParams(ctx).PmtpPeriodBlockRate)
+
+	// Rewards are paid in rowan, which the registry of the test app lacks
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: clptypes.NativeSymbol, Decimals: 18})
+	allocation := sdk.NewUint(1000)
+	oneDec := sdk.OneDec()
+	periods := []*clptypes.RewardPeriod{
+		{RewardPeriodId: "RP1", RewardPeriodStartBlock: 10, RewardPeriodEndBlock: 20, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec},
+	}
+	err = handler(ctx, clptypes.NewAddRewardPeriodProposal("title", "description", periods))
+	require.NoError(t, err)
+	require.Len(t, app.ClpKeeper.GetRewardsParams(ctx).RewardPeriods, 1)
+
+	err = handler(ctx, clptypes.NewUpdateRewardsParamsProposal("title", "description", 5, 7, []string{"reserve"}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), app.ClpKeeper.GetRewardsParams(ctx).LiquidityRemovalLockPeriod)
+	require.Equal(t, uint64(7), app.ClpKeeper.GetRewardsParams(ctx).LiquidityRemovalCancelPeriod)
+	require.True(t, app.ClpKeeper.IsRewardPeriodFundingModule(ctx, "reserve"))
+	require.False(t, app.ClpKeeper.IsRewardPeriodFundingModule(ctx, clptypes.ModuleName))
+
+	params := minttypes.DefaultParams()
+	params.InflationMax = sdk.MustNewDecFromStr("0.3")
+	err = handler(ctx, clptypes.NewUpdateStakingRewardParamsProposal("title", "description", minttypes.Minter{}, params))
+	require.NoError(t, err)
+	require.Equal(t, params, app.MintKeeper.GetParams(ctx))
+}
+
+func TestProposalHandler_GovRoute(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	ctx = ctx.WithBlockHeight(1)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, clptypes.NewUpdateRewardsParamsProposal("title", "description", 5, 7, nil))
+	require.NoError(t, err)
+	require.Equal(t, clptypes.ProposalTypeUpdateRewardsParams, proposal.ProposalType())
+	_, err = app.GovKeeper.SubmitProposal(ctx, clptypes.NewUpdatePmtpParamsProposal("title", "description", "0.2", 1, 0, 9))
+	require.Error(t, err)
+}
@@ -69,21 +69,15 @@ func TestScenarios(t *testing.T) {
 			bz, _ := app.AppCodec().MarshalJSON(trGs)
 			genesisState["tokenregistry"] = bz
 
+			var balances []banktypes.Balance
 			if tc.CreateBalance {
-				balances := []banktypes.Balance{
-					{
-						Address: tc.Address,
-						Coins: sdk.Coins{
-							sdk.NewCoin(tc.PoolAsset, tc.ExternalBalance),
-							sdk.NewCoin("rowan", tc.NativeBalance),
-						},
+				balances = append(balances, banktypes.Balance{
+					Address: tc.Address,
+					Coins: sdk.Coins{
+						sdk.NewCoin(tc.PoolAsset, tc.ExternalBalance),
+						sdk.NewCoin("rowan", tc.NativeBalance),
 					},
-				}
-
-				bankGs := banktypes.DefaultGenesisState()
-				bankGs.Balances = append(bankGs.Balances, balances...)
-				bz, _ = app.AppCodec().MarshalJSON(bankGs)
-				genesisState["bank"] = bz
+				})
 			}
 
 			if tc.CreatePool {
@@ -95,13 +89,14 @@ func TestScenarios(t *testing.T) {
 						PoolUnits:            tc.PoolUnits,
 					},
 				}
+				balances = append(balances, test.GetPoolsModuleBalance(pools))
 				clpGs := types.DefaultGenesisState()
 				if tc.CreateLPs {
 					lps := []*types.LiquidityProvider{
 						{
 							Asset:                    &types.Asset{Symbol: tc.PoolAsset},
 							LiquidityProviderAddress: tc.Address,
-							LiquidityProviderUnits:   tc.NativeAssetAmount,
+							LiquidityProviderUnits:   tc.PoolUnits,
 						},
 					}
 					clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, lps...)
@@ -114,6 +109,10 @@ func TestScenarios(t *testing.T) {
 				bz, _ = app.AppCodec().MarshalJSON(clpGs)
 				genesisState["clp"] = bz
 			}
+			bankGs := banktypes.DefaultGenesisState()
+			bankGs.Balances = append(bankGs.Balances, balances...)
+			bz, _ = app.AppCodec().MarshalJSON(bankGs)
+			genesisState["bank"] = bz
 
 			return genesisState
 		})
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"testing"
 	"time"
 	"unicode/utf8"
 
@@ -15,6 +16,8 @@ import (
 	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sifapp "github.com/Sifchain/sifnode/app"
@@ -78,7 +81,7 @@ func CreateTestAppClp(isCheckTx bool) (sdk.Context, *sifapp.SifchainApp) {
 
 func CreateTestAppClpFromGenesis(isCheckTx bool, genesisTransformer func(*sifapp.SifchainApp, sifapp.GenesisState) sifapp.GenesisState) (sdk.Context, *sifapp.SifchainApp) {
 	sifapp.SetConfig(false)
-	app := sifapp.SetupFromGenesis(isCheckTx, genesisTransformer)
+	app := sifapp.SetupFromGenesis(isCheckTx, withLiquidityProviderTokens(genesisTransformer))
 	ctx := app.BaseApp.NewContext(isCheckTx, tmproto.Header{})
 
 	app.ClpKeeper.SetPmtpRateParams(ctx, types.PmtpRateParams{
@@ -103,6 +106,37 @@ func CreateTestAppClpFromGenesis(isCheckTx bool, genesisTransformer func(*sifapp
 	return ctx, app
 }
 
+// withLiquidityProviderTokens gives the liquidity providers of the clp genesis the tokens of their units, as the clp
+// module mints them when liquidity is added, unless the bank genesis already holds their tokens
+func withLiquidityProviderTokens(genesisTransformer func(*sifapp.SifchainApp, sifapp.GenesisState) sifapp.GenesisState) func(*sifapp.SifchainApp, sifapp.GenesisState) sifapp.GenesisState {
+	return func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+		genesisState = genesisTransformer(app, genesisState)
+		var clpGs types.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesisState[types.ModuleName], &clpGs)
+		var bankGs banktypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGs)
+		held := make(map[string]bool)
+		for _, balance := range bankGs.Balances {
+			for _, coin := range balance.Coins {
+				held[balance.Address+coin.Denom] = true
+			}
+		}
+		for _, lp := range clpGs.LiquidityProviders {
+			denom := types.GetLiquidityProviderTokenDenom(lp.Asset.Symbol)
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.BigInt())))
+			if coins.Empty() || held[lp.LiquidityProviderAddress+denom] {
+				continue
+			}
+			bankGs.Balances = append(bankGs.Balances, banktypes.Balance{Address: lp.LiquidityProviderAddress, Coins: coins})
+			if !bankGs.Supply.Empty() {
+				bankGs.Supply = bankGs.Supply.Add(coins...)
+			}
+		}
+		genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGs)
+		return genesisState
+	}
+}
+
 func GenerateRandomPool(numberOfPools int) []types.Pool {
 	var poolList []types.Pool
 	tokens := []string{"ceth", "cbtc", "ceos", "cbch", "cbnb", "cusdt", "cada", "ctrx"}
@@ -257,3 +291,73 @@ func GetAdmins(address string) *tokenregistrytypes.AdminAccounts {
 		},
 	}}
 }
+
+// GetPoolsModuleBalance returns the clp module account balance backing the given pools, so genesis states built
+// by tests satisfy the module balance invariant
+func GetPoolsModuleBalance(pools []*types.Pool) banktypes.Balance {
+	coins := sdk.NewCoins()
+	for _, pool := range pools {
+		coins = coins.Add(sdk.NewCoins(
+			sdk.NewCoin(types.GetSettlementAsset().Symbol, sdk.NewIntFromBigInt(pool.NativeAssetBalance.BigInt())),
+			sdk.NewCoin(pool.ExternalAsset.Symbol, sdk.NewIntFromBigInt(pool.ExternalAssetBalance.BigInt())),
+		)...)
+	}
+	return banktypes.Balance{
+		Address: authtypes.NewModuleAddress(types.ModuleName).String(),
+		Coins:   coins,
+	}
+}
+
+// CreateTestAppWithPool returns an app with a ceth pool whose units are all held by a genesis provider, so address
+// starts without a liquidity position. Address is a clp and token registry admin holding balances.
+func CreateTestAppWithPool(address string, balances sdk.Coins, entries []*tokenregistrytypes.RegistryEntry) (sdk.Context, *sifapp.SifchainApp) {
+	poolDepth := sdk.NewUint(1000000000000)
+	ctx, app := CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+		provider := sdk.AccAddress("genesis_provider____").String()
+		trGs := &tokenregistrytypes.GenesisState{
+			AdminAccounts: GetAdmins(address),
+			Registry:      &tokenregistrytypes.Registry{Entries: entries},
+		}
+		bz, _ := app.AppCodec().MarshalJSON(trGs)
+		genesisState["tokenregistry"] = bz
+
+		clpGs := types.DefaultGenesisState()
+		clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+			ExternalAsset:        &types.Asset{Symbol: "ceth"},
+			NativeAssetBalance:   poolDepth,
+			ExternalAssetBalance: poolDepth,
+			PoolUnits:            poolDepth,
+		})
+		clpGs.LiquidityProviders = append(clpGs.LiquidityProviders, &types.LiquidityProvider{
+			Asset:                    &types.Asset{Symbol: "ceth"},
+			LiquidityProviderUnits:   poolDepth,
+			LiquidityProviderAddress: provider,
+		})
+		bz, _ = app.AppCodec().MarshalJSON(clpGs)
+		genesisState["clp"] = bz
+
+		bankGs := banktypes.DefaultGenesisState()
+		bankGs.Balances = append(bankGs.Balances,
+			banktypes.Balance{
+				Address: address,
+				Coins:   balances,
+			},
+			banktypes.Balance{
+				Address: provider,
+				Coins:   sdk.NewCoins(sdk.NewCoin(types.GetLiquidityProviderTokenDenom("ceth"), sdk.NewIntFromBigInt(poolDepth.BigInt()))),
+			},
+			GetPoolsModuleBalance(clpGs.PoolList),
+		)
+		bz, _ = app.AppCodec().MarshalJSON(bankGs)
+		genesisState["bank"] = bz
+		return genesisState
+	})
+	app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.ZeroDec())
+	return ctx, app
+}
+
+// RequireInvariants fails the test when any of the clp invariants is broken
+func RequireInvariants(t *testing.T, ctx sdk.Context, k clpkeeper.Keeper) {
+	msg, broken := clpkeeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers concrete types on codec
@@ -15,8 +16,27 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "clp/RemoveLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidityUnits{}, "clp/RemoveLiquidityUnits", nil)
 	cdc.RegisterConcrete(&MsgSwap{}, "clp/Swap", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "clp/SwapRoute", nil)
 	cdc.RegisterConcrete(&MsgDecommissionPool{}, "clp/DecommissionPool", nil)
 	cdc.RegisterConcrete(&MsgUnlockLiquidityRequest{}, "clp/UnlockLiquidity", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "clp/PlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "clp/CancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgTransferLiquidityPosition{}, "clp/TransferLiquidityPosition", nil)
+	cdc.RegisterConcrete(&MsgUpdateSwapFeeRate{}, "clp/UpdateSwapFeeRate", nil)
+	cdc.RegisterConcrete(&MsgUpdateProtocolFeeRate{}, "clp/UpdateProtocolFeeRate", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolPauseState{}, "clp/UpdatePoolPauseState", nil)
+	cdc.RegisterConcrete(&MsgUpdateCircuitBreakerParams{}, "clp/UpdateCircuitBreakerParams", nil)
+	cdc.RegisterConcrete(&MsgCancelPmtpPolicy{}, "clp/CancelPmtpPolicy", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "clp/ClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgAppendRewardPeriod{}, "clp/AppendRewardPeriod", nil)
+	cdc.RegisterConcrete(&MsgEditRewardPeriod{}, "clp/EditRewardPeriod", nil)
+	cdc.RegisterConcrete(&MsgDeleteRewardPeriod{}, "clp/DeleteRewardPeriod", nil)
+	cdc.RegisterConcrete(&MsgFundRewardEscrow{}, "clp/FundRewardEscrow", nil)
+	cdc.RegisterConcrete(&MsgRefundRewardEscrow{}, "clp/RefundRewardEscrow", nil)
+	cdc.RegisterConcrete(&MsgAddLiquiditySingleSided{}, "clp/AddLiquiditySingleSided", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolSwapMode{}, "clp/UpdatePoolSwapMode", nil)
+	cdc.RegisterConcrete(&MsgApprovePendingPool{}, "clp/ApprovePendingPool", nil)
+	cdc.RegisterConcrete(&MsgCancelPoolWindDown{}, "clp/CancelPoolWindDown", nil)
 }
 
 var (
@@ -38,8 +58,35 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreatePool{},
 		&MsgAddLiquidity{},
 		&MsgSwap{},
+		&MsgSwapRoute{},
 		&MsgDecommissionPool{},
 		&MsgUnlockLiquidityRequest{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgTransferLiquidityPosition{},
+		&MsgUpdateSwapFeeRate{},
+		&MsgUpdateProtocolFeeRate{},
+		&MsgUpdatePoolPauseState{},
+		&MsgUpdateCircuitBreakerParams{},
+		&MsgCancelPmtpPolicy{},
+		&MsgClaimRewards{},
+		&MsgAppendRewardPeriod{},
+		&MsgEditRewardPeriod{},
+		&MsgDeleteRewardPeriod{},
+		&MsgFundRewardEscrow{},
+		&MsgRefundRewardEscrow{},
+		&MsgAddLiquiditySingleSided{},
+		&MsgUpdatePoolSwapMode{},
+		&MsgApprovePendingPool{},
+		&MsgCancelPoolWindDown{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdatePmtpParamsProposal{},
+		&ModifyPmtpRatesProposal{},
+		&AddRewardPeriodProposal{},
+		&UpdateRewardsParamsProposal{},
+		&UpdateStakingRewardParamsProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
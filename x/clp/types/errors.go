@@ -34,4 +34,33 @@ var (
 	ErrAmountTooLow                    = sdkerrors.Register(ModuleName, 32, "Tx amount is too low")
 	ErrNotEnoughPermissions            = sdkerrors.Register(ModuleName, 33, "Signer does not have permissions to execute this action")
 	ErrCannotStartPolicy               = sdkerrors.Register(ModuleName, 34, "A new policy can be started only after the current policy has ended")
+	ErrInvalidSwapRoute                = sdkerrors.Register(ModuleName, 35, "Invalid swap route")
+	ErrLimitOrderDoesNotExist          = sdkerrors.Register(ModuleName, 36, "Limit order does not exist")
+	ErrInvalidExpiryHeight             = sdkerrors.Register(ModuleName, 37, "Expiry height must be in the future")
+	ErrTwapRecordNotFound              = sdkerrors.Register(ModuleName, 38, "No price record found for the requested period")
+	ErrNotEnoughLiquidityProviderToken = sdkerrors.Register(ModuleName, 39, "Not enough liquidity provider tokens to remove the liquidity units")
+	ErrInvalidFeeRate                  = sdkerrors.Register(ModuleName, 40, "Fee rate must be between 0 (inclusive) and 1 (exclusive)")
+	ErrPoolPaused                      = sdkerrors.Register(ModuleName, 41, "Action is paused for this pool")
+	ErrPriceImpactTooHigh              = sdkerrors.Register(ModuleName, 42, "Price impact exceeds the circuit breaker limit")
+	ErrInvalidCircuitBreakerLimit      = sdkerrors.Register(ModuleName, 43, "Circuit breaker limits must not be negative")
+	ErrPmtpPolicyOverlap               = sdkerrors.Register(ModuleName, 44, "Pmtp policy overlaps with a scheduled policy")
+	ErrPmtpPolicyDoesNotExist          = sdkerrors.Register(ModuleName, 45, "Pmtp policy does not exist")
+	ErrPmtpPolicyNotPending            = sdkerrors.Register(ModuleName, 46, "Only pending pmtp policies can be cancelled")
+	ErrNoRewardsToClaim                = sdkerrors.Register(ModuleName, 47, "No pending rewards to claim")
+	ErrRewardPeriodOverlap             = sdkerrors.Register(ModuleName, 48, "Reward period overlaps with another reward period")
+	ErrRewardPeriodDoesNotExist        = sdkerrors.Register(ModuleName, 49, "Reward period does not exist")
+	ErrRewardPeriodAlreadyExists       = sdkerrors.Register(ModuleName, 50, "Reward period already exists")
+	ErrRewardPeriodStarted             = sdkerrors.Register(ModuleName, 51, "Reward period has already started")
+	ErrRewardPeriodNotEscrowed         = sdkerrors.Register(ModuleName, 52, "Reward period is not funded by an escrow")
+	ErrRewardEscrowDoesNotExist        = sdkerrors.Register(ModuleName, 53, "Reward escrow does not exist")
+	ErrRewardPeriodEnded               = sdkerrors.Register(ModuleName, 54, "Reward period has ended")
+	ErrLiquidityBelowMinimum           = sdkerrors.Register(ModuleName, 55, "Liquidity amount is below the accepted minimum")
+	ErrDeadlineExceeded                = sdkerrors.Register(ModuleName, 56, "Deadline height has passed")
+	ErrBatchSwapNotSupported           = sdkerrors.Register(ModuleName, 57, "Swaps through a pool in batch swap mode must send or receive rowan")
+	ErrPoolPending                     = sdkerrors.Register(ModuleName, 58, "Pool is pending approval")
+	ErrPoolNotPending                  = sdkerrors.Register(ModuleName, 59, "Pool is not pending approval")
+	ErrPoolWindingDown                 = sdkerrors.Register(ModuleName, 60, "Pool is winding down")
+	ErrPoolInBatchMode                 = sdkerrors.Register(ModuleName, 61, "Pool only accepts swaps queued in batch swap mode")
+	ErrPoolNotWindingDown              = sdkerrors.Register(ModuleName, 62, "Pool is not winding down")
+	ErrFundingModuleNotAllowed         = sdkerrors.Register(ModuleName, 63, "Module is not allowed to fund reward periods")
 )
@@ -5,20 +5,57 @@ package types
 const (
 	EventTypeCreatePool              = "created_new_pool"
 	EventTypeDecommissionPool        = "decommission_pool"
+	EventTypeWindDownPool            = "wind_down_pool"
+	EventTypeSettleLiquidity         = "settle_liquidity_provider"
+	EventTypeSettlePoolFailed        = "settle_pool_failed"
+	EventTypeSettlePoolStopped       = "settle_pool_stopped"
+	EventTypeCancelPoolWindDown      = "cancel_pool_wind_down"
 	EventTypeAddNewPmtpPolicy        = "pmtp_new_policy"
 	EventTypeEndPmtpPolicy           = "pmtp_end_policy"
+	EventTypeCancelPmtpPolicy        = "pmtp_cancel_policy"
 	EventTypeCreateLiquidityProvider = "created_new_liquidity_provider"
 	EventTypeAddLiquidity            = "added_liquidity"
+	EventTypeAddLiquidityFailed      = "add_liquidity_failed"
+	EventTypeAddLiquiditySingleSided = "added_liquidity_single_sided"
 	EventTypeRemoveLiquidity         = "removed_liquidity"
+	EventTypeRemoveLiquidityFailed   = "remove_liquidity_failed"
 	EventTypeRequestUnlock           = "request_unlock_liquidity"
 	EventTypeCancelUnlock            = "cancel_unlock_liquidity"
 	EventTypeSwap                    = "swap_successful"
 	EventTypeSwapFailed              = "swap_failed"
+	EventTypeSwapRoute               = "swap_route_successful"
+	EventTypeSwapRouteHop            = "swap_route_hop"
+	EventTypeSwapRouteFailed         = "swap_route_failed"
+	EventTypePlaceLimitOrder         = "place_limit_order"
+	EventTypeCancelLimitOrder        = "cancel_limit_order"
+	EventTypeExecuteLimitOrder       = "execute_limit_order"
+	EventTypeExpireLimitOrder        = "expire_limit_order"
+	EventTypeTransferLiquidity       = "transfer_liquidity_position"
+	EventTypeUpdateSwapFeeRate       = "update_swap_fee_rate"
+	EventTypeUpdateProtocolFeeRate   = "update_protocol_fee_rate"
+	EventTypeUpdatePoolPauseState    = "update_pool_pause_state"
+	EventTypeUpdatePoolSwapMode      = "update_pool_swap_mode"
+	EventTypeApprovePendingPool      = "approve_pending_pool"
+	EventTypeQueueSwap               = "swap_queued"
+	EventTypeClearBatchSwaps         = "batch_swaps_cleared"
+	EventTypeUpdateCircuitBreaker    = "update_circuit_breaker_params"
+	EventTypeCircuitBreakerTripped   = "circuit_breaker_tripped"
+	EventTypeClaimRewards            = "claim_rewards"
+	EventTypeAppendRewardPeriod      = "append_reward_period"
+	EventTypeEditRewardPeriod        = "edit_reward_period"
+	EventTypeDeleteRewardPeriod      = "delete_reward_period"
+	EventTypeFundRewardEscrow        = "fund_reward_escrow"
+	EventTypeRefundRewardEscrow      = "refund_reward_escrow"
 	AttributeKeyThreshold            = "min_threshold"
 	AttributeKeySwapAmount           = "swap_amount"
 	AttributeKeyLiquidityFee         = "liquidity_fee"
 	AttributeKeyPriceImpact          = "price_impact"
 	AttributeKeyInPool               = "in_pool"
+	AttributeKeyRoute                = "route"
+	AttributeKeyHop                  = "hop"
+	AttributeKeySentAsset            = "sent_asset"
+	AttributeKeySentAmount           = "sent_amount"
+	AttributeKeyReceivedAsset        = "received_asset"
 	AttributeKeyOutPool              = "out_pool"
 	AttributePmtpBlockRate           = "pmtp_block_rate"
 	AttributePmtpCurrentRunningRate  = "pmtp_current_running_rate"
@@ -27,6 +64,33 @@ const (
 	AttributeKeyLiquidityProvider    = "liquidity_provider"
 	AttributeKeyUnits                = "liquidity_units"
 	AttributeKeyPmtpPolicyParams     = "pmtp_policy_params"
+	AttributeKeyPmtpPolicyID         = "pmtp_policy_id"
+	AttributeKeyLimitOrder           = "limit_order"
+	AttributeKeyRecipient            = "recipient"
+	AttributeKeySwapFeeRate          = "swap_fee_rate"
+	AttributeKeyProtocolFeeRate      = "protocol_fee_rate"
+	AttributeKeySwapsPaused          = "swaps_paused"
+	AttributeKeyAddsPaused           = "adds_paused"
+	AttributeKeyRemovesPaused        = "removes_paused"
+	AttributeKeyCircuitBreakerParams = "circuit_breaker_params"
+	AttributeKeyPriceChange          = "price_change"
+	AttributeKeyClaimedRewards       = "claimed_rewards"
+	AttributeKeyRewardPeriodID       = "reward_period_id"
+	AttributeKeyEscrowAmount         = "escrow_amount"
+	AttributeKeyEscrowBalance        = "escrow_balance"
 	AttributeKeyPmtpRateParams       = "pmtp_rate_params"
+	AttributeKeyDeadlineHeight       = "deadline_height"
+	AttributeKeyNativeAmount         = "native_amount"
+	AttributeKeyExternalAmount       = "external_amount"
+	AttributeKeyMinNativeOut         = "min_native_out"
+	AttributeKeyMinExternalOut       = "min_external_out"
+	AttributeKeySwapMode             = "swap_mode"
+	AttributeKeyQueuedSwap           = "queued_swap"
+	AttributeKeyClearingPrice        = "clearing_price"
+	AttributeKeyNetSwapAmount        = "net_swap_amount"
+	AttributeKeyWindDownEndHeight    = "wind_down_end_height"
+	AttributeKeyPayoutSymbol         = "payout_symbol"
+	AttributeKeySettleFailures       = "settle_failures"
+	AttributeKeyError                = "error"
 	AttributeValueCategory           = ModuleName
 )
@@ -27,10 +27,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // TODO: Add parameters to Genesis state ,such as minimum liquidity required to
 // create a pool
 type GenesisState struct {
-	Params             Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	AddressWhitelist   []string             `protobuf:"bytes,2,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
-	PoolList           []*Pool              `protobuf:"bytes,3,rep,name=pool_list,json=poolList,proto3" json:"pool_list,omitempty"`
-	LiquidityProviders []*LiquidityProvider `protobuf:"bytes,4,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	Params                   Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	AddressWhitelist         []string                   `protobuf:"bytes,2,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
+	PoolList                 []*Pool                    `protobuf:"bytes,3,rep,name=pool_list,json=poolList,proto3" json:"pool_list,omitempty"`
+	LiquidityProviders       []*LiquidityProvider       `protobuf:"bytes,4,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	LimitOrders              []*LimitOrder              `protobuf:"bytes,5,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders,omitempty"`
+	PmtpPolicies             []*PmtpPolicy              `protobuf:"bytes,6,rep,name=pmtp_policies,json=pmtpPolicies,proto3" json:"pmtp_policies,omitempty"`
+	PoolRewardAccumulators   []PoolRewardAccumulator    `protobuf:"bytes,7,rep,name=pool_reward_accumulators,json=poolRewardAccumulators,proto3" json:"pool_reward_accumulators"`
+	LiquidityProviderRewards []LiquidityProviderRewards `protobuf:"bytes,8,rep,name=liquidity_provider_rewards,json=liquidityProviderRewards,proto3" json:"liquidity_provider_rewards"`
+	RewardEscrows            []RewardEscrow             `protobuf:"bytes,9,rep,name=reward_escrows,json=rewardEscrows,proto3" json:"reward_escrows"`
+	TwapRecords              []GenesisTwapRecords       `protobuf:"bytes,10,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
+	// swap_fee_params defaults to no protocol fee when unset
+	SwapFeeParams   *SwapFeeParams          `protobuf:"bytes,11,opt,name=swap_fee_params,json=swapFeeParams,proto3" json:"swap_fee_params,omitempty"`
+	PoolFeeAccruals []GenesisPoolFeeAccrual `protobuf:"bytes,12,rep,name=pool_fee_accruals,json=poolFeeAccruals,proto3" json:"pool_fee_accruals"`
+	// circuit_breaker_params defaults to disabled limits when unset, pools
+	// carry their own pause state
+	CircuitBreakerParams *CircuitBreakerParams  `protobuf:"bytes,13,opt,name=circuit_breaker_params,json=circuitBreakerParams,proto3" json:"circuit_breaker_params,omitempty"`
+	PoolSnapshots        []GenesisPoolSnapshots `protobuf:"bytes,14,rep,name=pool_snapshots,json=poolSnapshots,proto3" json:"pool_snapshots"`
+	PoolStats            []GenesisPoolStats     `protobuf:"bytes,15,rep,name=pool_stats,json=poolStats,proto3" json:"pool_stats"`
+	QueuedSwaps          []*QueuedSwap          `protobuf:"bytes,16,rep,name=queued_swaps,json=queuedSwaps,proto3" json:"queued_swaps,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,34 +109,363 @@ func (m *GenesisState) GetLiquidityProviders() []*LiquidityProvider {
 	return nil
 }
 
+func (m *GenesisState) GetLimitOrders() []*LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPmtpPolicies() []*PmtpPolicy {
+	if m != nil {
+		return m.PmtpPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolRewardAccumulators() []PoolRewardAccumulator {
+	if m != nil {
+		return m.PoolRewardAccumulators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityProviderRewards() []LiquidityProviderRewards {
+	if m != nil {
+		return m.LiquidityProviderRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardEscrows() []RewardEscrow {
+	if m != nil {
+		return m.RewardEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []GenesisTwapRecords {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSwapFeeParams() *SwapFeeParams {
+	if m != nil {
+		return m.SwapFeeParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolFeeAccruals() []GenesisPoolFeeAccrual {
+	if m != nil {
+		return m.PoolFeeAccruals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerParams() *CircuitBreakerParams {
+	if m != nil {
+		return m.CircuitBreakerParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolSnapshots() []GenesisPoolSnapshots {
+	if m != nil {
+		return m.PoolSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolStats() []GenesisPoolStats {
+	if m != nil {
+		return m.PoolStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedSwaps() []*QueuedSwap {
+	if m != nil {
+		return m.QueuedSwaps
+	}
+	return nil
+}
+
+// GenesisTwapRecords - the cumulative price records of a pool in ascending
+// time
+type GenesisTwapRecords struct {
+	Symbol  string       `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Records []TwapRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *GenesisTwapRecords) Reset()         { *m = GenesisTwapRecords{} }
+func (m *GenesisTwapRecords) String() string { return proto.CompactTextString(m) }
+func (*GenesisTwapRecords) ProtoMessage()    {}
+func (*GenesisTwapRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd711ee3eda6f54c, []int{1}
+}
+func (m *GenesisTwapRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisTwapRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisTwapRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisTwapRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisTwapRecords.Merge(m, src)
+}
+func (m *GenesisTwapRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisTwapRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisTwapRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisTwapRecords proto.InternalMessageInfo
+
+func (m *GenesisTwapRecords) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GenesisTwapRecords) GetRecords() []TwapRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// GenesisPoolFeeAccrual - the swap fees a pool has charged
+type GenesisPoolFeeAccrual struct {
+	Symbol  string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Accrual PoolFeeAccrual `protobuf:"bytes,2,opt,name=accrual,proto3" json:"accrual"`
+}
+
+func (m *GenesisPoolFeeAccrual) Reset()         { *m = GenesisPoolFeeAccrual{} }
+func (m *GenesisPoolFeeAccrual) String() string { return proto.CompactTextString(m) }
+func (*GenesisPoolFeeAccrual) ProtoMessage()    {}
+func (*GenesisPoolFeeAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd711ee3eda6f54c, []int{2}
+}
+func (m *GenesisPoolFeeAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPoolFeeAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPoolFeeAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPoolFeeAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPoolFeeAccrual.Merge(m, src)
+}
+func (m *GenesisPoolFeeAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPoolFeeAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPoolFeeAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPoolFeeAccrual proto.InternalMessageInfo
+
+func (m *GenesisPoolFeeAccrual) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GenesisPoolFeeAccrual) GetAccrual() PoolFeeAccrual {
+	if m != nil {
+		return m.Accrual
+	}
+	return PoolFeeAccrual{}
+}
+
+// GenesisPoolSnapshots - the snapshots of a pool in ascending height
+type GenesisPoolSnapshots struct {
+	Symbol    string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Snapshots []PoolSnapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots"`
+}
+
+func (m *GenesisPoolSnapshots) Reset()         { *m = GenesisPoolSnapshots{} }
+func (m *GenesisPoolSnapshots) String() string { return proto.CompactTextString(m) }
+func (*GenesisPoolSnapshots) ProtoMessage()    {}
+func (*GenesisPoolSnapshots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd711ee3eda6f54c, []int{3}
+}
+func (m *GenesisPoolSnapshots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPoolSnapshots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPoolSnapshots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPoolSnapshots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPoolSnapshots.Merge(m, src)
+}
+func (m *GenesisPoolSnapshots) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPoolSnapshots) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPoolSnapshots.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPoolSnapshots proto.InternalMessageInfo
+
+func (m *GenesisPoolSnapshots) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GenesisPoolSnapshots) GetSnapshots() []PoolSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+// GenesisPoolStats - the hourly stats buckets of a pool in ascending time
+type GenesisPoolStats struct {
+	Symbol  string            `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Buckets []PoolStatsBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *GenesisPoolStats) Reset()         { *m = GenesisPoolStats{} }
+func (m *GenesisPoolStats) String() string { return proto.CompactTextString(m) }
+func (*GenesisPoolStats) ProtoMessage()    {}
+func (*GenesisPoolStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd711ee3eda6f54c, []int{4}
+}
+func (m *GenesisPoolStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisPoolStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisPoolStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisPoolStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisPoolStats.Merge(m, src)
+}
+func (m *GenesisPoolStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisPoolStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisPoolStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisPoolStats proto.InternalMessageInfo
+
+func (m *GenesisPoolStats) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *GenesisPoolStats) GetBuckets() []PoolStatsBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "sifnode.clp.v1.GenesisState")
+	proto.RegisterType((*GenesisTwapRecords)(nil), "sifnode.clp.v1.GenesisTwapRecords")
+	proto.RegisterType((*GenesisPoolFeeAccrual)(nil), "sifnode.clp.v1.GenesisPoolFeeAccrual")
+	proto.RegisterType((*GenesisPoolSnapshots)(nil), "sifnode.clp.v1.GenesisPoolSnapshots")
+	proto.RegisterType((*GenesisPoolStats)(nil), "sifnode.clp.v1.GenesisPoolStats")
 }
 
 func init() { proto.RegisterFile("sifnode/clp/v1/genesis.proto", fileDescriptor_cd711ee3eda6f54c) }
 
 var fileDescriptor_cd711ee3eda6f54c = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4e, 0xc2, 0x30,
-	0x18, 0xc7, 0x57, 0x21, 0x44, 0x8a, 0x31, 0x3a, 0x89, 0x21, 0xd3, 0x54, 0xf4, 0x22, 0x89, 0x49,
-	0x1b, 0xd0, 0x17, 0x90, 0x8b, 0x17, 0x0e, 0x64, 0x1c, 0x4c, 0xbc, 0x90, 0xb1, 0x95, 0xd1, 0xa4,
-	0xd0, 0xba, 0x16, 0x94, 0xb7, 0xf0, 0xb1, 0x38, 0x72, 0xf4, 0x64, 0xcc, 0xf6, 0x12, 0x1e, 0xcd,
-	0xba, 0xee, 0xe0, 0xbc, 0x35, 0xdf, 0xef, 0xf7, 0xff, 0xbe, 0xe6, 0x0f, 0x2f, 0x15, 0x9b, 0xaf,
-	0x44, 0x44, 0x49, 0xc8, 0x25, 0xd9, 0xf4, 0x49, 0x4c, 0x57, 0x54, 0x31, 0x85, 0x65, 0x22, 0xb4,
-	0x70, 0x8f, 0x2d, 0xc5, 0x21, 0x97, 0x78, 0xd3, 0xf7, 0xda, 0xb1, 0x88, 0x85, 0x41, 0x24, 0x7f,
-	0x15, 0x96, 0x77, 0x51, 0xd9, 0x21, 0x83, 0x24, 0x58, 0xda, 0x15, 0x9e, 0x57, 0x81, 0x7a, 0x2b,
-	0xa9, 0x65, 0x37, 0x3f, 0x00, 0x1e, 0x3d, 0x15, 0x07, 0x27, 0x3a, 0xd0, 0xd4, 0x7d, 0x80, 0x8d,
-	0x22, 0xdc, 0x01, 0x5d, 0xd0, 0x6b, 0x0d, 0xce, 0xf1, 0xdf, 0x0f, 0xe0, 0xb1, 0xa1, 0xc3, 0xfa,
-	0xee, 0xeb, 0xca, 0xf1, 0xad, 0xeb, 0xde, 0xc1, 0xd3, 0x20, 0x8a, 0x12, 0xaa, 0xd4, 0xf4, 0x6d,
-	0xc1, 0x34, 0xe5, 0x4c, 0xe9, 0xce, 0x41, 0xb7, 0xd6, 0x6b, 0xfa, 0x27, 0x16, 0x3c, 0x97, 0x73,
-	0xb7, 0x0f, 0x9b, 0x52, 0x08, 0x3e, 0x35, 0x52, 0xad, 0x5b, 0xeb, 0xb5, 0x06, 0xed, 0x7f, 0x57,
-	0x84, 0xe0, 0xfe, 0x61, 0xae, 0x8d, 0xf2, 0x88, 0x0f, 0xcf, 0x38, 0x7b, 0x5d, 0xb3, 0x88, 0xe9,
-	0xed, 0x54, 0x26, 0x62, 0xc3, 0x22, 0x9a, 0xa8, 0x4e, 0xdd, 0x84, 0xaf, 0xab, 0xe1, 0x51, 0xa9,
-	0x8e, 0xad, 0xe9, 0xbb, 0xbc, 0x3a, 0x52, 0xc3, 0xc7, 0x5d, 0x8a, 0xc0, 0x3e, 0x45, 0xe0, 0x3b,
-	0x45, 0xe0, 0x23, 0x43, 0xce, 0x3e, 0x43, 0xce, 0x67, 0x86, 0x9c, 0x97, 0xdb, 0x98, 0xe9, 0xc5,
-	0x7a, 0x86, 0x43, 0xb1, 0x24, 0x13, 0x36, 0x0f, 0x17, 0x01, 0x5b, 0x91, 0xb2, 0xc4, 0x77, 0x53,
-	0xa3, 0xe9, 0x70, 0xd6, 0x30, 0x25, 0xde, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd6, 0x99, 0xea,
-	0x9f, 0xc3, 0x01, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xdd, 0x6e, 0x1a, 0x39,
+	0x14, 0xe6, 0x27, 0x0b, 0xc1, 0xfc, 0x24, 0xf1, 0xb2, 0x68, 0xc4, 0x66, 0x09, 0x8b, 0x76, 0xb5,
+	0x48, 0x2b, 0x81, 0x92, 0xf6, 0xaa, 0x52, 0x9b, 0x26, 0x55, 0x52, 0x55, 0x8d, 0x54, 0x32, 0x54,
+	0x8a, 0x94, 0x9b, 0x91, 0x19, 0x1c, 0xb0, 0xe2, 0xc1, 0x8e, 0xed, 0x09, 0xe5, 0x2d, 0xfa, 0x3c,
+	0x7d, 0x82, 0x5c, 0xe6, 0xb2, 0x57, 0x55, 0x95, 0xbc, 0x48, 0x35, 0x1e, 0x0f, 0x90, 0x61, 0x68,
+	0xef, 0xcc, 0x39, 0xdf, 0xf9, 0xbe, 0x33, 0xe7, 0x3b, 0xc6, 0x60, 0x57, 0x92, 0xab, 0x09, 0x1b,
+	0xe2, 0xae, 0x4b, 0x79, 0xf7, 0x76, 0xbf, 0x3b, 0xc2, 0x13, 0x2c, 0x89, 0xec, 0x70, 0xc1, 0x14,
+	0x83, 0x15, 0x93, 0xed, 0xb8, 0x94, 0x77, 0x6e, 0xf7, 0xeb, 0xd5, 0x11, 0x1b, 0x31, 0x9d, 0xea,
+	0x06, 0xa7, 0x10, 0x55, 0xff, 0x33, 0xc6, 0xc1, 0x91, 0x40, 0x9e, 0xa1, 0xa8, 0xd7, 0x63, 0x49,
+	0x35, 0xe3, 0xd8, 0xe4, 0x5a, 0x5f, 0x0a, 0xa0, 0xf4, 0x36, 0x14, 0xec, 0x2b, 0xa4, 0x30, 0x7c,
+	0x0e, 0x72, 0x61, 0xb1, 0x95, 0x6e, 0xa6, 0xdb, 0xc5, 0x83, 0x5a, 0xe7, 0x69, 0x03, 0x9d, 0x9e,
+	0xce, 0x1e, 0x6f, 0xdc, 0x7d, 0xdb, 0x4b, 0xd9, 0x06, 0x0b, 0xff, 0x07, 0x3b, 0x68, 0x38, 0x14,
+	0x58, 0x4a, 0x67, 0x3a, 0x26, 0x0a, 0x53, 0x22, 0x95, 0x95, 0x69, 0x66, 0xdb, 0x05, 0x7b, 0xdb,
+	0x24, 0x2e, 0xa2, 0x38, 0xdc, 0x07, 0x05, 0xce, 0x18, 0x75, 0x34, 0x28, 0xdb, 0xcc, 0xb6, 0x8b,
+	0x07, 0xd5, 0x15, 0x15, 0xc6, 0xa8, 0xbd, 0x19, 0xc0, 0xce, 0x82, 0x12, 0x1b, 0xfc, 0x4e, 0xc9,
+	0x8d, 0x4f, 0x86, 0x44, 0xcd, 0x1c, 0x2e, 0xd8, 0x2d, 0x19, 0x62, 0x21, 0xad, 0x0d, 0x5d, 0xfc,
+	0x77, 0xbc, 0xf8, 0x2c, 0x82, 0xf6, 0x0c, 0xd2, 0x86, 0x34, 0x1e, 0x92, 0xf0, 0x25, 0x28, 0x51,
+	0xe2, 0x11, 0xe5, 0x30, 0xa1, 0xc9, 0x7e, 0xd3, 0x64, 0xf5, 0x55, 0x32, 0x8f, 0xa8, 0x0f, 0x01,
+	0xc4, 0x2e, 0xd2, 0xf9, 0x59, 0xc2, 0x43, 0x50, 0xe6, 0x9e, 0xe2, 0x0e, 0x67, 0x94, 0xb8, 0x04,
+	0x4b, 0x2b, 0x97, 0x5c, 0xdf, 0xf3, 0x14, 0xef, 0x05, 0x98, 0x99, 0x5d, 0xe2, 0xd1, 0x99, 0x60,
+	0x09, 0x31, 0xb0, 0xf4, 0x18, 0x04, 0x9e, 0x22, 0x31, 0x74, 0x90, 0xeb, 0xfa, 0x9e, 0x4f, 0x91,
+	0x62, 0x42, 0x5a, 0x79, 0xcd, 0xf5, 0x6f, 0xe2, 0x54, 0x34, 0xfc, 0x68, 0x81, 0x36, 0x56, 0xd4,
+	0x78, 0x52, 0x52, 0x42, 0x0a, 0xea, 0xab, 0xa3, 0x33, 0xa2, 0xd2, 0xda, 0xd4, 0x42, 0xed, 0x5f,
+	0x4f, 0x30, 0xc4, 0x1b, 0x2d, 0x8b, 0xae, 0xc9, 0xc3, 0x77, 0xa0, 0x62, 0xbe, 0x07, 0x4b, 0x57,
+	0xb0, 0xa9, 0xb4, 0x0a, 0x5a, 0x61, 0x37, 0xae, 0x10, 0x16, 0x9c, 0x68, 0x90, 0x61, 0x2d, 0x8b,
+	0xa5, 0x98, 0x84, 0xef, 0x41, 0x49, 0x4d, 0x11, 0x77, 0x04, 0x76, 0x59, 0xd0, 0x2a, 0xd0, 0x44,
+	0xad, 0x38, 0x91, 0xd9, 0xde, 0x8f, 0x53, 0xc4, 0xed, 0x10, 0x69, 0xe8, 0x8a, 0x6a, 0x11, 0x82,
+	0x27, 0x60, 0x4b, 0x06, 0x64, 0x57, 0x18, 0x3b, 0x66, 0xbf, 0x8b, 0x7a, 0xbf, 0xff, 0x8a, 0xf3,
+	0xf5, 0xa7, 0x88, 0x9f, 0x62, 0x1c, 0xae, 0xb9, 0x5d, 0x96, 0xcb, 0x3f, 0xe1, 0x05, 0xd8, 0xd1,
+	0x9e, 0x05, 0x34, 0xc8, 0x75, 0x85, 0x8f, 0xa8, 0xb4, 0x4a, 0xc9, 0x66, 0x99, 0xc6, 0x02, 0xcf,
+	0x4e, 0x31, 0x3e, 0x0a, 0xd1, 0xa6, 0xb7, 0x2d, 0xfe, 0x24, 0x2a, 0xe1, 0x25, 0xa8, 0xb9, 0x44,
+	0xb8, 0x3e, 0x51, 0xce, 0x40, 0x60, 0x74, 0x8d, 0x45, 0xd4, 0x66, 0x59, 0xb7, 0xf9, 0x4f, 0x9c,
+	0xfd, 0x4d, 0x88, 0x3e, 0x0e, 0xc1, 0xa6, 0xdb, 0xaa, 0x9b, 0x10, 0x85, 0xe7, 0xa0, 0xa2, 0x9b,
+	0x96, 0x13, 0xc4, 0xe5, 0x98, 0x29, 0x69, 0x55, 0x9a, 0xd9, 0x24, 0xce, 0xa5, 0x8e, 0xfb, 0x11,
+	0x36, 0xf2, 0x86, 0x2f, 0x07, 0xe1, 0x09, 0x00, 0x21, 0xa5, 0x42, 0x4a, 0x5a, 0x5b, 0x9a, 0xae,
+	0xf9, 0x33, 0x3a, 0x85, 0xe6, 0x54, 0x05, 0x1e, 0x05, 0x82, 0x2b, 0x78, 0xe3, 0x63, 0x1f, 0x0f,
+	0x9d, 0x60, 0xcc, 0xd2, 0xda, 0x4e, 0xbe, 0x42, 0xe7, 0x1a, 0x13, 0x18, 0x63, 0x17, 0x6f, 0xe6,
+	0x67, 0xd9, 0x1a, 0x03, 0xb8, 0xea, 0x3e, 0xac, 0x81, 0x9c, 0x9c, 0x79, 0x03, 0x46, 0xf5, 0x3f,
+	0x58, 0xc1, 0x36, 0xbf, 0xe0, 0x0b, 0x90, 0x8f, 0x56, 0x29, 0x93, 0xac, 0xb3, 0x60, 0x31, 0xad,
+	0x46, 0x05, 0x2d, 0x06, 0xfe, 0x48, 0xb4, 0x73, 0xad, 0xd8, 0x2b, 0x90, 0x37, 0xfb, 0x61, 0x65,
+	0xb4, 0x81, 0x8d, 0xa4, 0xbb, 0xbc, 0xb2, 0x17, 0x51, 0x51, 0x8b, 0x83, 0x6a, 0x92, 0x1b, 0x6b,
+	0xf5, 0x5e, 0x83, 0xc2, 0xc2, 0xde, 0x4c, 0xf2, 0x95, 0x5b, 0x66, 0x8a, 0xbc, 0x98, 0x17, 0xb5,
+	0xae, 0xc1, 0x76, 0xdc, 0xb0, 0xb5, 0x6a, 0x87, 0x20, 0x3f, 0xf0, 0xdd, 0x6b, 0x3c, 0xd7, 0xda,
+	0x4b, 0xd4, 0xd2, 0xa6, 0x6b, 0x5c, 0xf4, 0x79, 0xa6, 0xea, 0xf8, 0xe8, 0xee, 0xa1, 0x91, 0xbe,
+	0x7f, 0x68, 0xa4, 0xbf, 0x3f, 0x34, 0xd2, 0x9f, 0x1f, 0x1b, 0xa9, 0xfb, 0xc7, 0x46, 0xea, 0xeb,
+	0x63, 0x23, 0x75, 0xf9, 0xdf, 0x88, 0xa8, 0xb1, 0x3f, 0xe8, 0xb8, 0xcc, 0xeb, 0xf6, 0xc9, 0x95,
+	0x3b, 0x46, 0x64, 0xd2, 0x8d, 0x1e, 0xb0, 0x4f, 0xfa, 0x09, 0xd3, 0xef, 0xd7, 0x20, 0xa7, 0x1f,
+	0xb0, 0x67, 0x3f, 0x06, 0x00, 0x41, 0xc4, 0xd0, 0x53, 0x3f, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,10 +488,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityProviders) > 0 {
-		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.QueuedSwaps) > 0 {
+		for iNdEx := len(m.QueuedSwaps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.QueuedSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -155,92 +499,1281 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	if len(m.PoolList) > 0 {
-		for iNdEx := len(m.PoolList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PoolStats) > 0 {
+		for iNdEx := len(m.PoolStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PoolStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PoolSnapshots) > 0 {
+		for iNdEx := len(m.PoolSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.CircuitBreakerParams != nil {
+		{
+			size, err := m.CircuitBreakerParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PoolFeeAccruals) > 0 {
+		for iNdEx := len(m.PoolFeeAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFeeAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.SwapFeeParams != nil {
+		{
+			size, err := m.SwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RewardEscrows) > 0 {
+		for iNdEx := len(m.RewardEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LiquidityProviderRewards) > 0 {
+		for iNdEx := len(m.LiquidityProviderRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviderRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PoolRewardAccumulators) > 0 {
+		for iNdEx := len(m.PoolRewardAccumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRewardAccumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.AddressWhitelist) > 0 {
-		for iNdEx := len(m.AddressWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AddressWhitelist[iNdEx])
-			copy(dAtA[i:], m.AddressWhitelist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AddressWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PmtpPolicies) > 0 {
+		for iNdEx := len(m.PmtpPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PmtpPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolList) > 0 {
+		for iNdEx := len(m.PoolList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AddressWhitelist) > 0 {
+		for iNdEx := len(m.AddressWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddressWhitelist[iNdEx])
+			copy(dAtA[i:], m.AddressWhitelist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AddressWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisTwapRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisTwapRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisTwapRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPoolFeeAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPoolFeeAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPoolFeeAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accrual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPoolSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPoolSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPoolSnapshots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisPoolStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisPoolStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisPoolStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AddressWhitelist) > 0 {
+		for _, s := range m.AddressWhitelist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolList) > 0 {
+		for _, e := range m.PoolList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PmtpPolicies) > 0 {
+		for _, e := range m.PmtpPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolRewardAccumulators) > 0 {
+		for _, e := range m.PoolRewardAccumulators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityProviderRewards) > 0 {
+		for _, e := range m.LiquidityProviderRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardEscrows) > 0 {
+		for _, e := range m.RewardEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SwapFeeParams != nil {
+		l = m.SwapFeeParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolFeeAccruals) > 0 {
+		for _, e := range m.PoolFeeAccruals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CircuitBreakerParams != nil {
+		l = m.CircuitBreakerParams.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolSnapshots) > 0 {
+		for _, e := range m.PoolSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolStats) > 0 {
+		for _, e := range m.PoolStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedSwaps) > 0 {
+		for _, e := range m.QueuedSwaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisTwapRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisPoolFeeAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Accrual.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisPoolSnapshots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisPoolStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressWhitelist = append(m.AddressWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolList = append(m.PoolList, &Pool{})
+			if err := m.PoolList[len(m.PoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, &LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPolicies = append(m.PmtpPolicies, &PmtpPolicy{})
+			if err := m.PmtpPolicies[len(m.PmtpPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRewardAccumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRewardAccumulators = append(m.PoolRewardAccumulators, PoolRewardAccumulator{})
+			if err := m.PoolRewardAccumulators[len(m.PoolRewardAccumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviderRewards = append(m.LiquidityProviderRewards, LiquidityProviderRewards{})
+			if err := m.LiquidityProviderRewards[len(m.LiquidityProviderRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrows = append(m.RewardEscrows, RewardEscrow{})
+			if err := m.RewardEscrows[len(m.RewardEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, GenesisTwapRecords{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwapFeeParams == nil {
+				m.SwapFeeParams = &SwapFeeParams{}
+			}
+			if err := m.SwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFeeAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFeeAccruals = append(m.PoolFeeAccruals, GenesisPoolFeeAccrual{})
+			if err := m.PoolFeeAccruals[len(m.PoolFeeAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreakerParams == nil {
+				m.CircuitBreakerParams = &CircuitBreakerParams{}
+			}
+			if err := m.CircuitBreakerParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolSnapshots = append(m.PoolSnapshots, GenesisPoolSnapshots{})
+			if err := m.PoolSnapshots[len(m.PoolSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolStats = append(m.PoolStats, GenesisPoolStats{})
+			if err := m.PoolStats[len(m.PoolStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedSwaps = append(m.QueuedSwaps, &QueuedSwap{})
+			if err := m.QueuedSwaps[len(m.QueuedSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisTwapRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisTwapRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisTwapRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, TwapRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.AddressWhitelist) > 0 {
-		for _, s := range m.AddressWhitelist {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PoolList) > 0 {
-		for _, e := range m.PoolList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GenesisPoolFeeAccrual) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -263,15 +1796,47 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisPoolFeeAccrual: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisPoolFeeAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrual", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -298,13 +1863,63 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Accrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPoolSnapshots) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPoolSnapshots: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPoolSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -332,11 +1947,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressWhitelist = append(m.AddressWhitelist, string(dAtA[iNdEx:postIndex]))
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -363,14 +1978,96 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolList = append(m.PoolList, &Pool{})
-			if err := m.PoolList[len(m.PoolList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, PoolSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisPoolStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisPoolStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisPoolStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -397,8 +2094,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, PoolStatsBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClpHooks lets other modules react to changes of the clp pools without forking the clp module.
+// Hooks are called once the state change has been written, errors can not be returned to abort it.
+type ClpHooks interface {
+	// AfterSwap is called after every pool a swap goes through, with the swapped pool.
+	// The liquidity fee is taken in the received asset.
+	AfterSwap(ctx sdk.Context, swapper sdk.AccAddress, pool Pool, sentCoin sdk.Coin, receivedCoin sdk.Coin, liquidityFee sdk.Coin)
+	// AfterAddLiquidity is called after a provider added liquidity to a pool, lpUnits being the units minted for it.
+	AfterAddLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint)
+	// AfterRemoveLiquidity is called after a provider removed liquidity from a pool, lpUnits being the units burnt.
+	AfterRemoveLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint)
+	// AfterPoolCreated is called after a pool has been created and its creator became its first provider.
+	AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool Pool)
+	// BeforePoolDecommissioned is called before the remaining balances of a pool are paid out to its providers
+	// on decommissioning, the pool can still be swapped through bypassing its swap checks.
+	BeforePoolDecommissioned(ctx sdk.Context, pool Pool)
+	// AfterPoolDecommissioned is called after a pool has been destroyed and its providers refunded.
+	AfterPoolDecommissioned(ctx sdk.Context, pool Pool)
+}
+
+// MultiClpHooks combines multiple clp hooks, all hook functions are run in array sequence
+type MultiClpHooks []ClpHooks
+
+func NewMultiClpHooks(hooks ...ClpHooks) MultiClpHooks {
+	return hooks
+}
+
+func (h MultiClpHooks) AfterSwap(ctx sdk.Context, swapper sdk.AccAddress, pool Pool, sentCoin sdk.Coin, receivedCoin sdk.Coin, liquidityFee sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwap(ctx, swapper, pool, sentCoin, receivedCoin, liquidityFee)
+	}
+}
+
+func (h MultiClpHooks) AfterAddLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint) {
+	for i := range h {
+		h[i].AfterAddLiquidity(ctx, provider, pool, nativeAmount, externalAmount, lpUnits)
+	}
+}
+
+func (h MultiClpHooks) AfterRemoveLiquidity(ctx sdk.Context, provider sdk.AccAddress, pool Pool, nativeAmount sdk.Uint, externalAmount sdk.Uint, lpUnits sdk.Uint) {
+	for i := range h {
+		h[i].AfterRemoveLiquidity(ctx, provider, pool, nativeAmount, externalAmount, lpUnits)
+	}
+}
+
+func (h MultiClpHooks) AfterPoolCreated(ctx sdk.Context, creator sdk.AccAddress, pool Pool) {
+	for i := range h {
+		h[i].AfterPoolCreated(ctx, creator, pool)
+	}
+}
+
+func (h MultiClpHooks) BeforePoolDecommissioned(ctx sdk.Context, pool Pool) {
+	for i := range h {
+		h[i].BeforePoolDecommissioned(ctx, pool)
+	}
+}
+
+func (h MultiClpHooks) AfterPoolDecommissioned(ctx sdk.Context, pool Pool) {
+	for i := range h {
+		h[i].AfterPoolDecommissioned(ctx, pool)
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,10 +21,26 @@ const (
 	QuerierRoute = ModuleName
 
 	NativeSymbol = "rowan"
-	PoolThrehold = "1000000000000000000"
+	// LiquidityProviderTokenPrefix prefixes the bank denom representing the liquidity units of a pool
+	LiquidityProviderTokenPrefix = "clp/"
+	PoolThrehold                 = "1000000000000000000"
 
-	MaxSymbolLength = 71
-	MaxWbasis       = 10000
+	MaxSymbolLength    = 71
+	MaxWbasis          = 10000
+	MaxSwapRouteLength = 8
+
+	// TwapRecordRetentionPeriod is how long cumulative price records are kept, in seconds
+	TwapRecordRetentionPeriod = 48 * 60 * 60
+	// PoolSnapshotInterval is the number of blocks between two pool snapshots
+	PoolSnapshotInterval = 100
+	// PoolSnapshotRetention is how long pool snapshots are kept, in blocks
+	PoolSnapshotRetention = 30 * 24 * 60 * 60 / 6
+	// PoolStatsBucketDuration is the time span of a pool stats bucket, in seconds
+	PoolStatsBucketDuration = 60 * 60
+	// PoolStatsRetention is how long pool stats buckets are kept, in seconds
+	PoolStatsRetention = 7 * 24 * 60 * 60
+	// MaxSettlePoolFailures is how many blocks in a row the settlement of a pool winding down is attempted
+	MaxSettlePoolFailures = 10
 )
 
 var (
@@ -34,6 +51,27 @@ var (
 	PmtpEpochPrefix          = []byte{0x04} // Key to store the Epoch
 	PmtpParamsPrefix         = []byte{0x05} // Key to store the Pmtp params
 	RewardParamPrefix        = []byte{0x06}
+	LimitOrderPrefix         = []byte{0x07} // Key to store limit orders by id
+	LimitOrderPricePrefix    = []byte{0x08} // Key to index limit orders by pool, side and price
+	LimitOrderExpiryPrefix   = []byte{0x09} // Key to index limit orders by expiry height
+	LimitOrderNextIDPrefix   = []byte{0x0A} // Key to store the id of the next limit order
+	TwapRecordPrefix         = []byte{0x0B} // Key to store the cumulative price records of pools
+	TwapAccumulatorPrefix    = []byte{0x0C} // Key to store the range of price records of pools
+	SwapFeeParamsPrefix      = []byte{0x0D} // Key to store the swap fee params
+	PoolFeeAccrualPrefix     = []byte{0x0E} // Key to store the swap fees accrued by pools
+	CircuitBreakerPrefix     = []byte{0x0F} // Key to store the circuit breaker params
+	PmtpPolicyPrefix         = []byte{0x10} // Key to store pmtp policies by id
+	PmtpPolicyQueuePrefix    = []byte{0x11} // Key to index pending pmtp policies by start block
+	PmtpPolicyNextIDPrefix   = []byte{0x12} // Key to store the id of the next pmtp policy
+	PmtpActivePolicyPrefix   = []byte{0x13} // Key to store the id of the running pmtp policy
+	PoolRewardPrefix         = []byte{0x14} // Key to store the reward accumulators of pools by reward period
+	ProviderRewardPrefix     = []byte{0x15} // Key to store the rewards of liquidity providers
+	RewardEscrowPrefix       = []byte{0x16} // Key to store the reward escrows by reward period id
+	PoolSnapshotPrefix       = []byte{0x17} // Key to store the snapshots of pools by height
+	PoolHistoryIndexPrefix   = []byte{0x18} // Key to store the range of snapshots of pools
+	PoolStatsPrefix          = []byte{0x19} // Key to store the hourly swap and reward stats of pools
+	QueuedSwapPrefix         = []byte{0x1A} // Key to store the swaps queued against pools in batch swap mode
+	QueuedSwapNextIDPrefix   = []byte{0x1B} // Key to store the id of the next queued swap
 )
 
 // Generates a key for storing a specific pool
@@ -52,6 +90,132 @@ func GetLiquidityProviderKey(externalTicker string, lp string) []byte {
 	return append(LiquidityProviderPrefix, key...)
 }
 
+// Generate key to store a limit order
+// The key is the big endian encoded order id
+func GetLimitOrderKey(id uint64) []byte {
+	return append(LimitOrderPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate the prefix for all limit orders selling sentTicker into the pool of externalTicker
+// The prefix is of the format externalticker_sentticker_
+// Example : eth_rowan_ for orders selling rowan into the eth pool
+func GetLimitOrderPoolSidePrefix(externalTicker string, sentTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_%s_", externalTicker, sentTicker))
+	return append(LimitOrderPricePrefix, key...)
+}
+
+// Generate key to index a limit order by price, orders of a pool side iterate in ascending limit price
+func GetLimitOrderPriceKey(order LimitOrder) []byte {
+	key := GetLimitOrderPoolSidePrefix(order.PoolAsset.Symbol, order.SentAsset.Symbol)
+	key = append(key, sdk.SortableDecBytes(order.LimitPrice)...)
+	return append(key, sdk.Uint64ToBigEndian(order.Id)...)
+}
+
+// Generate key to index a limit order by expiry height
+func GetLimitOrderExpiryKey(expiryHeight int64, id uint64) []byte {
+	key := append(LimitOrderExpiryPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate the prefix for all cumulative price records of a pool
+// The prefix is of the format externalticker_
+func GetTwapRecordPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(TwapRecordPrefix, key...)
+}
+
+// Generate key to store a cumulative price record, records of a pool iterate in ascending block time
+func GetTwapRecordKey(externalTicker string, timestamp int64) []byte {
+	return append(GetTwapRecordPoolPrefix(externalTicker), sdk.FormatTimeBytes(time.Unix(timestamp, 0))...)
+}
+
+// Generate key to store the price record range of a pool
+func GetTwapAccumulatorKey(externalTicker string) []byte {
+	return append(TwapAccumulatorPrefix, []byte(externalTicker)...)
+}
+
+// Generate the prefix for all snapshots of a pool
+// The prefix is of the format externalticker_
+func GetPoolSnapshotPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(PoolSnapshotPrefix, key...)
+}
+
+// Generate key to store a pool snapshot, snapshots of a pool iterate in ascending height
+func GetPoolSnapshotKey(externalTicker string, height int64) []byte {
+	return append(GetPoolSnapshotPoolPrefix(externalTicker), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// Generate key to store the snapshot range of a pool
+func GetPoolHistoryIndexKey(externalTicker string) []byte {
+	return append(PoolHistoryIndexPrefix, []byte(externalTicker)...)
+}
+
+// Generate the prefix for all stats buckets of a pool
+// The prefix is of the format externalticker_
+func GetPoolStatsPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(PoolStatsPrefix, key...)
+}
+
+// Generate key to store a pool stats bucket, buckets of a pool iterate in ascending time
+func GetPoolStatsBucketKey(externalTicker string, hour int64) []byte {
+	return append(GetPoolStatsPoolPrefix(externalTicker), sdk.Uint64ToBigEndian(uint64(hour))...)
+}
+
+// Generate the prefix for all swaps queued against a pool
+// The prefix is of the format externalticker_
+func GetQueuedSwapPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(QueuedSwapPrefix, key...)
+}
+
+// Generate key to store a queued swap, swaps of a pool iterate in the order they were queued
+func GetQueuedSwapKey(externalTicker string, id uint64) []byte {
+	return append(GetQueuedSwapPoolPrefix(externalTicker), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate key to store the swap fees accrued by a pool
+func GetPoolFeeAccrualKey(externalTicker string) []byte {
+	return append(PoolFeeAccrualPrefix, []byte(externalTicker)...)
+}
+
+// Generate key to store a pmtp policy
+// The key is the big endian encoded policy id
+func GetPmtpPolicyKey(id uint64) []byte {
+	return append(PmtpPolicyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate key to index a pending pmtp policy, pending policies iterate in ascending start block
+func GetPmtpPolicyQueueKey(startBlock int64) []byte {
+	return append(PmtpPolicyQueuePrefix, sdk.Uint64ToBigEndian(uint64(startBlock))...)
+}
+
+// Generate the prefix for all reward accumulators of a pool
+// The prefix is of the format externalticker_
+func GetPoolRewardPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(PoolRewardPrefix, key...)
+}
+
+// Generate key to store the reward accumulator of a pool for a reward period
+// The key is of the format externalticker_rewardperiodid
+func GetPoolRewardKey(externalTicker string, rewardPeriodID string) []byte {
+	return append(GetPoolRewardPoolPrefix(externalTicker), []byte(rewardPeriodID)...)
+}
+
+// Generate key to store the rewards of a liquidity provider
+// The key is of the format ticker_lpaddress
+func GetLiquidityProviderRewardKey(externalTicker string, lp string) []byte {
+	key := []byte(fmt.Sprintf("%s_%s", externalTicker, lp))
+	return append(ProviderRewardPrefix, key...)
+}
+
+// Generate key to store the escrow of a reward period
+func GetRewardEscrowKey(rewardPeriodID string) []byte {
+	return append(RewardEscrowPrefix, []byte(rewardPeriodID)...)
+}
+
 func GetDefaultRewardParams() *RewardParams {
 	return &RewardParams{
 		LiquidityRemovalLockPeriod:   12 * 60 * 24 * 7,
@@ -69,3 +233,16 @@ func GetDefaultPmtpParams() *PmtpParams {
 		PmtpPeriodEndBlock:       0,
 	}
 }
+
+func GetDefaultSwapFeeParams() *SwapFeeParams {
+	return &SwapFeeParams{
+		ProtocolFeeRate: sdk.ZeroDec(),
+	}
+}
+
+func GetDefaultCircuitBreakerParams() *CircuitBreakerParams {
+	return &CircuitBreakerParams{
+		MaxPriceImpact: sdk.ZeroDec(),
+		MaxPriceChange: sdk.ZeroDec(),
+	}
+}
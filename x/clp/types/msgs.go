@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,7 @@ var (
 	_ sdk.Msg = &MsgCreatePool{}
 	_ sdk.Msg = &MsgAddLiquidity{}
 	_ sdk.Msg = &MsgSwap{}
+	_ sdk.Msg = &MsgSwapRoute{}
 	_ sdk.Msg = &MsgDecommissionPool{}
 	_ sdk.Msg = &MsgUnlockLiquidityRequest{}
 	_ sdk.Msg = &MsgUpdateRewardsParamsRequest{}
@@ -21,6 +23,24 @@ var (
 	_ sdk.Msg = &MsgModifyPmtpRates{}
 	_ sdk.Msg = &MsgUpdatePmtpParams{}
 	_ sdk.Msg = &MsgUpdateStakingRewardParams{}
+	_ sdk.Msg = &MsgPlaceLimitOrder{}
+	_ sdk.Msg = &MsgCancelLimitOrder{}
+	_ sdk.Msg = &MsgTransferLiquidityPosition{}
+	_ sdk.Msg = &MsgUpdateSwapFeeRate{}
+	_ sdk.Msg = &MsgUpdateProtocolFeeRate{}
+	_ sdk.Msg = &MsgUpdatePoolPauseState{}
+	_ sdk.Msg = &MsgUpdateCircuitBreakerParams{}
+	_ sdk.Msg = &MsgCancelPmtpPolicy{}
+	_ sdk.Msg = &MsgClaimRewards{}
+	_ sdk.Msg = &MsgAppendRewardPeriod{}
+	_ sdk.Msg = &MsgEditRewardPeriod{}
+	_ sdk.Msg = &MsgDeleteRewardPeriod{}
+	_ sdk.Msg = &MsgFundRewardEscrow{}
+	_ sdk.Msg = &MsgRefundRewardEscrow{}
+	_ sdk.Msg = &MsgAddLiquiditySingleSided{}
+	_ sdk.Msg = &MsgUpdatePoolSwapMode{}
+	_ sdk.Msg = &MsgApprovePendingPool{}
+	_ sdk.Msg = &MsgCancelPoolWindDown{}
 )
 
 func (m MsgUpdateStakingRewardParams) Route() string {
@@ -56,27 +76,71 @@ func (m MsgAddRewardPeriodRequest) Type() string {
 }
 
 func (m MsgAddRewardPeriodRequest) ValidateBasic() error {
+	ids := make(map[string]bool, len(m.RewardPeriods))
 	for _, period := range m.RewardPeriods {
-		if period.RewardPeriodId == "" {
-			return fmt.Errorf("reward period id must be non-empty: %d", period.RewardPeriodStartBlock)
+		if err := ValidateRewardPeriod(period); err != nil {
+			return err
 		}
-		if period.RewardPeriodEndBlock < period.RewardPeriodStartBlock {
-			return fmt.Errorf("reward period start block must be before end block: %d %d", period.RewardPeriodStartBlock, period.RewardPeriodEndBlock)
+		if ids[period.RewardPeriodId] {
+			return fmt.Errorf("reward period id must be unique: %s", period.RewardPeriodId)
 		}
-		for _, multiplier := range period.RewardPeriodPoolMultipliers {
-			if multiplier.Multiplier.LT(sdk.ZeroDec()) {
-				return fmt.Errorf("pool multiplier should be less than 0 | pool : %s , multiplier : %s", multiplier.PoolMultiplierAsset, multiplier.Multiplier.String())
-			}
-			if multiplier.Multiplier.GT(sdk.MustNewDecFromStr("10.00")) {
-				return fmt.Errorf("pool multiplier should be greater than 10 | pool : %s , multiplier : %s", multiplier.PoolMultiplierAsset, multiplier.Multiplier.String())
-			}
+		ids[period.RewardPeriodId] = true
+	}
+	return nil
+}
+
+// ValidateRewardPeriod checks the fields of a reward period, the keeper checks it against the state
+func ValidateRewardPeriod(period *RewardPeriod) error {
+	if period == nil {
+		return fmt.Errorf("reward period must be non-empty")
+	}
+	if period.RewardPeriodId == "" {
+		return fmt.Errorf("reward period id must be non-empty: %d", period.RewardPeriodStartBlock)
+	}
+	if period.RewardPeriodEndBlock < period.RewardPeriodStartBlock {
+		return fmt.Errorf("reward period start block must be before end block: %d %d", period.RewardPeriodStartBlock, period.RewardPeriodEndBlock)
+	}
+	if period.RewardPeriodAllocation == nil {
+		return fmt.Errorf("reward period allocation must be non-empty: %s", period.RewardPeriodId)
+	}
+	for _, multiplier := range period.RewardPeriodPoolMultipliers {
+		if multiplier == nil || multiplier.Multiplier == nil {
+			return fmt.Errorf("pool multiplier must be non-empty: %s", period.RewardPeriodId)
 		}
-		if period.RewardPeriodDefaultMultiplier.LT(sdk.ZeroDec()) {
-			return fmt.Errorf("default should be less than 0 |multiplier : %s", period.RewardPeriodDefaultMultiplier.String())
+		if multiplier.Multiplier.LT(sdk.ZeroDec()) {
+			return fmt.Errorf("pool multiplier should be less than 0 | pool : %s , multiplier : %s", multiplier.PoolMultiplierAsset, multiplier.Multiplier.String())
+		}
+		if multiplier.Multiplier.GT(sdk.MustNewDecFromStr("10.00")) {
+			return fmt.Errorf("pool multiplier should be greater than 10 | pool : %s , multiplier : %s", multiplier.PoolMultiplierAsset, multiplier.Multiplier.String())
+		}
+	}
+	if period.RewardPeriodDefaultMultiplier == nil {
+		return fmt.Errorf("default multiplier must be non-empty: %s", period.RewardPeriodId)
+	}
+	if period.RewardPeriodDefaultMultiplier.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("default should be less than 0 |multiplier : %s", period.RewardPeriodDefaultMultiplier.String())
+	}
+	if period.RewardPeriodDefaultMultiplier.GT(sdk.MustNewDecFromStr("10.00")) {
+		return fmt.Errorf("default multiplier should be greater than 10 | multiplier : %s", period.RewardPeriodDefaultMultiplier.String())
+	}
+	if err := sdk.ValidateDenom(period.RewardDenom()); err != nil {
+		return err
+	}
+	switch period.RewardPeriodFundingSource {
+	case RewardFundingSource_REWARD_FUNDING_SOURCE_MINT:
+		if period.RewardDenom() != GetSettlementAsset().Symbol {
+			return fmt.Errorf("only %s can be minted as rewards: %s", GetSettlementAsset().Symbol, period.RewardPeriodDenom)
 		}
-		if period.RewardPeriodDefaultMultiplier.GT(sdk.MustNewDecFromStr("10.00")) {
-			return fmt.Errorf("default multiplier should be greater than 10 | multiplier : %s", period.RewardPeriodDefaultMultiplier.String())
+	case RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT:
+		if period.RewardPeriodFundingModule == "" {
+			return fmt.Errorf("funding module must be non-empty: %s", period.RewardPeriodId)
 		}
+	case RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW:
+	default:
+		return fmt.Errorf("invalid funding source: %s", period.RewardPeriodFundingSource)
+	}
+	if period.RewardPeriodFundingSource != RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT && period.RewardPeriodFundingModule != "" {
+		return fmt.Errorf("funding module is only used by module account funded reward periods: %s", period.RewardPeriodId)
 	}
 	return nil
 }
@@ -122,25 +186,49 @@ func (m *MsgUpdatePmtpParams) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
-	if m.PmtpPeriodEpochLength <= 0 {
-		return fmt.Errorf("pmtp epoch length must be greated than zero: %d", m.PmtpPeriodEpochLength)
+	return ValidatePmtpPeriod(m.PmtpPeriodEpochLength, m.PmtpPeriodStartBlock, m.PmtpPeriodEndBlock)
+}
+
+// ValidateRewardPeriodFundingModules checks that the modules allowed to fund reward periods are distinct and exclude
+// the clp module, which holds the pools and the rewards that are still to be claimed
+func ValidateRewardPeriodFundingModules(modules []string) error {
+	seen := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		if module == "" {
+			return fmt.Errorf("funding module must be non-empty")
+		}
+		if module == ModuleName {
+			return sdkerrors.Wrap(ErrFundingModuleNotAllowed, module)
+		}
+		if seen[module] {
+			return fmt.Errorf("duplicate funding module: %s", module)
+		}
+		seen[module] = true
+	}
+	return nil
+}
+
+// ValidatePmtpPeriod checks that a policy period is made of whole epochs
+func ValidatePmtpPeriod(epochLength, startBlock, endBlock int64) error {
+	if epochLength <= 0 {
+		return fmt.Errorf("pmtp epoch length must be greated than zero: %d", epochLength)
 	}
-	if m.PmtpPeriodStartBlock < 0 {
-		return fmt.Errorf("pmtp start block cannot be negative: %d", m.PmtpPeriodStartBlock)
+	if startBlock < 0 {
+		return fmt.Errorf("pmtp start block cannot be negative: %d", startBlock)
 	}
 	// End block must be at-least 1
-	if m.PmtpPeriodEndBlock <= 0 {
-		return fmt.Errorf("pmtp end block cannot be negative: %d", m.PmtpPeriodStartBlock)
+	if endBlock <= 0 {
+		return fmt.Errorf("pmtp end block cannot be negative: %d", startBlock)
 	}
-	if m.PmtpPeriodEndBlock < m.PmtpPeriodStartBlock {
+	if endBlock < startBlock {
 		return fmt.Errorf(
 			"end block (%d) must be after begin block (%d)",
-			m.PmtpPeriodEndBlock, m.PmtpPeriodStartBlock,
+			endBlock, startBlock,
 		)
 	}
 
-	if (m.PmtpPeriodEndBlock-m.PmtpPeriodStartBlock+1)%m.PmtpPeriodEpochLength != 0 {
-		return fmt.Errorf("all epochs must have equal number of blocks : %d", m.PmtpPeriodEpochLength)
+	if (endBlock-startBlock+1)%epochLength != 0 {
+		return fmt.Errorf("all epochs must have equal number of blocks : %d", epochLength)
 	}
 
 	return nil
@@ -194,8 +282,8 @@ func (m MsgModifyPmtpRates) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
 
-func NewMsgDecommissionPool(signer sdk.AccAddress, symbol string) MsgDecommissionPool {
-	return MsgDecommissionPool{Signer: signer.String(), Symbol: symbol}
+func NewMsgDecommissionPool(signer sdk.AccAddress, symbol string, gracePeriod int64, payoutSymbol string) MsgDecommissionPool {
+	return MsgDecommissionPool{Signer: signer.String(), Symbol: symbol, GracePeriod: gracePeriod, PayoutSymbol: payoutSymbol}
 }
 
 func (m MsgDecommissionPool) Route() string {
@@ -213,6 +301,13 @@ func (m MsgDecommissionPool) ValidateBasic() error {
 	if !VerifyRange(len(strings.TrimSpace(m.Symbol)), 0, MaxSymbolLength) {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, m.Symbol)
 	}
+	if m.GracePeriod < 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("negative grace period %d", m.GracePeriod))
+	}
+	// Only pools winding down are settled, those below the threshold are paid in both assets right away
+	if m.PayoutSymbol != "" && (m.GracePeriod == 0 || (m.PayoutSymbol != m.Symbol && m.PayoutSymbol != GetSettlementAsset().Symbol)) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid payout symbol %s", m.PayoutSymbol))
+	}
 	return nil
 }
 
@@ -271,6 +366,55 @@ func (m MsgSwap) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func NewMsgSwapRoute(signer sdk.AccAddress, assets []*Asset, sentAmount sdk.Uint, minReceivingAmount sdk.Uint) MsgSwapRoute {
+	return MsgSwapRoute{Signer: signer.String(), Assets: assets, SentAmount: sentAmount, MinReceivingAmount: minReceivingAmount}
+}
+
+func (m MsgSwapRoute) Route() string {
+	return RouterKey
+}
+
+func (m MsgSwapRoute) Type() string {
+	return "swap_route"
+}
+
+func (m MsgSwapRoute) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if len(m.Assets) < 2 || len(m.Assets) > MaxSwapRouteLength {
+		return sdkerrors.Wrap(ErrInvalidSwapRoute, fmt.Sprintf("route must contain between 2 and %d assets : %d", MaxSwapRouteLength, len(m.Assets)))
+	}
+	for i, asset := range m.Assets {
+		if asset == nil || !asset.Validate() {
+			return sdkerrors.Wrap(ErrInValidAsset, fmt.Sprintf("route position %d", i))
+		}
+		if i == 0 {
+			continue
+		}
+		// Every hop is a single pool swap, so exactly one side of it has to be rowan
+		if asset.Equals(GetSettlementAsset()) == m.Assets[i-1].Equals(GetSettlementAsset()) {
+			return sdkerrors.Wrap(ErrInvalidSwapRoute, fmt.Sprintf("hop %s -> %s does not go through a single pool", m.Assets[i-1].Symbol, asset.Symbol))
+		}
+	}
+	if m.SentAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, m.SentAmount.String())
+	}
+	return nil
+}
+
+func (m MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 func NewMsgRemoveLiquidity(signer sdk.AccAddress, externalAsset Asset, wBasisPoints sdk.Int, asymmetry sdk.Int) MsgRemoveLiquidity {
 	return MsgRemoveLiquidity{Signer: signer.String(), ExternalAsset: &externalAsset, WBasisPoints: wBasisPoints, Asymmetry: asymmetry}
 }
@@ -348,6 +492,180 @@ func (m MsgRemoveLiquidityUnits) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func NewMsgTransferLiquidityPosition(signer sdk.AccAddress, recipient sdk.AccAddress, externalAsset Asset, units sdk.Uint) MsgTransferLiquidityPosition {
+	return MsgTransferLiquidityPosition{Signer: signer.String(), Recipient: recipient.String(), ExternalAsset: &externalAsset, Units: units}
+}
+
+func (m MsgTransferLiquidityPosition) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferLiquidityPosition) Type() string {
+	return "transfer_liquidity_position"
+}
+
+func (m MsgTransferLiquidityPosition) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	_, err := sdk.AccAddressFromBech32(m.Recipient)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.Signer == m.Recipient {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient must differ from the signer")
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	if !m.Units.GT(sdk.ZeroUint()) {
+		return sdkerrors.Wrap(ErrInValidAmount, fmt.Sprintf("Units must be greater than 0 : %s", m.Units.String()))
+	}
+	return nil
+}
+
+func (m MsgTransferLiquidityPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLiquidityPosition) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgAppendRewardPeriod(signer sdk.AccAddress, period RewardPeriod) MsgAppendRewardPeriod {
+	return MsgAppendRewardPeriod{Signer: signer.String(), RewardPeriod: &period}
+}
+
+func (m MsgAppendRewardPeriod) Route() string {
+	return RouterKey
+}
+
+func (m MsgAppendRewardPeriod) Type() string {
+	return "append_reward_period"
+}
+
+func (m MsgAppendRewardPeriod) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	return ValidateRewardPeriod(m.RewardPeriod)
+}
+
+func (m MsgAppendRewardPeriod) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAppendRewardPeriod) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgEditRewardPeriod(signer sdk.AccAddress, period RewardPeriod) MsgEditRewardPeriod {
+	return MsgEditRewardPeriod{Signer: signer.String(), RewardPeriod: &period}
+}
+
+func (m MsgEditRewardPeriod) Route() string {
+	return RouterKey
+}
+
+func (m MsgEditRewardPeriod) Type() string {
+	return "edit_reward_period"
+}
+
+func (m MsgEditRewardPeriod) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	return ValidateRewardPeriod(m.RewardPeriod)
+}
+
+func (m MsgEditRewardPeriod) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgEditRewardPeriod) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgDeleteRewardPeriod(signer sdk.AccAddress, rewardPeriodID string) MsgDeleteRewardPeriod {
+	return MsgDeleteRewardPeriod{Signer: signer.String(), RewardPeriodId: rewardPeriodID}
+}
+
+func (m MsgDeleteRewardPeriod) Route() string {
+	return RouterKey
+}
+
+func (m MsgDeleteRewardPeriod) Type() string {
+	return "delete_reward_period"
+}
+
+func (m MsgDeleteRewardPeriod) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.RewardPeriodId == "" {
+		return fmt.Errorf("reward period id must be non-empty")
+	}
+	return nil
+}
+
+func (m MsgDeleteRewardPeriod) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDeleteRewardPeriod) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgClaimRewards(signer sdk.AccAddress, externalAsset Asset) MsgClaimRewards {
+	return MsgClaimRewards{Signer: signer.String(), ExternalAsset: &externalAsset}
+}
+
+func (m MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (m MsgClaimRewards) Type() string {
+	return "claim_rewards"
+}
+
+func (m MsgClaimRewards) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 func NewMsgAddLiquidity(signer sdk.AccAddress, externalAsset Asset, nativeAssetAmount sdk.Uint, externalAssetAmount sdk.Uint) MsgAddLiquidity {
 	return MsgAddLiquidity{Signer: signer.String(), ExternalAsset: &externalAsset, NativeAssetAmount: nativeAssetAmount, ExternalAssetAmount: externalAssetAmount}
 }
@@ -449,3 +767,479 @@ func (m MsgUnlockLiquidityRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+func NewMsgPlaceLimitOrder(signer sdk.AccAddress, sentAsset Asset, receivedAsset Asset, sentAmount sdk.Uint, limitPrice sdk.Dec, expiryHeight int64) MsgPlaceLimitOrder {
+	return MsgPlaceLimitOrder{Signer: signer.String(), SentAsset: &sentAsset, ReceivedAsset: &receivedAsset, SentAmount: sentAmount, LimitPrice: limitPrice, ExpiryHeight: expiryHeight}
+}
+
+func (m MsgPlaceLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgPlaceLimitOrder) Type() string {
+	return "place_limit_order"
+}
+
+func (m MsgPlaceLimitOrder) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.SentAsset == nil || !m.SentAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid sent asset")
+	}
+	if m.ReceivedAsset == nil || !m.ReceivedAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid received asset")
+	}
+	// Limit orders execute against a single pool, so exactly one side has to be rowan
+	if m.SentAsset.Equals(GetSettlementAsset()) == m.ReceivedAsset.Equals(GetSettlementAsset()) {
+		return sdkerrors.Wrap(ErrInValidAsset, "limit orders must swap between rowan and an external asset")
+	}
+	if m.SentAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, m.SentAmount.String())
+	}
+	if m.LimitPrice.IsNil() || !m.LimitPrice.IsPositive() {
+		return sdkerrors.Wrap(ErrInValidAmount, fmt.Sprintf("limit price must be positive : %s", m.LimitPrice))
+	}
+	if m.ExpiryHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiryHeight, strconv.FormatInt(m.ExpiryHeight, 10))
+	}
+	return nil
+}
+
+func (m MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCancelLimitOrder(signer sdk.AccAddress, id uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{Signer: signer.String(), Id: id}
+}
+
+func (m MsgCancelLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelLimitOrder) Type() string {
+	return "cancel_limit_order"
+}
+
+func (m MsgCancelLimitOrder) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	return nil
+}
+
+func (m MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdateSwapFeeRate(signer sdk.AccAddress, externalAsset Asset, swapFeeRate sdk.Dec) MsgUpdateSwapFeeRate {
+	return MsgUpdateSwapFeeRate{Signer: signer.String(), ExternalAsset: &externalAsset, SwapFeeRate: swapFeeRate}
+}
+
+func (m MsgUpdateSwapFeeRate) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateSwapFeeRate) Type() string {
+	return "update_swap_fee_rate"
+}
+
+func (m MsgUpdateSwapFeeRate) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	if !ValidateFeeRate(m.SwapFeeRate) {
+		return sdkerrors.Wrap(ErrInvalidFeeRate, m.SwapFeeRate.String())
+	}
+	return nil
+}
+
+func (m MsgUpdateSwapFeeRate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateSwapFeeRate) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdateProtocolFeeRate(signer sdk.AccAddress, protocolFeeRate sdk.Dec) MsgUpdateProtocolFeeRate {
+	return MsgUpdateProtocolFeeRate{Signer: signer.String(), ProtocolFeeRate: protocolFeeRate}
+}
+
+func (m MsgUpdateProtocolFeeRate) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateProtocolFeeRate) Type() string {
+	return "update_protocol_fee_rate"
+}
+
+func (m MsgUpdateProtocolFeeRate) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if !ValidateFeeRate(m.ProtocolFeeRate) {
+		return sdkerrors.Wrap(ErrInvalidFeeRate, m.ProtocolFeeRate.String())
+	}
+	return nil
+}
+
+func (m MsgUpdateProtocolFeeRate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateProtocolFeeRate) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdatePoolPauseState(signer sdk.AccAddress, externalAsset Asset, swapsPaused, addsPaused, removesPaused bool) MsgUpdatePoolPauseState {
+	return MsgUpdatePoolPauseState{Signer: signer.String(), ExternalAsset: &externalAsset, SwapsPaused: swapsPaused, AddsPaused: addsPaused, RemovesPaused: removesPaused}
+}
+
+func (m MsgUpdatePoolPauseState) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdatePoolPauseState) Type() string {
+	return "update_pool_pause_state"
+}
+
+func (m MsgUpdatePoolPauseState) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	return nil
+}
+
+func (m MsgUpdatePoolPauseState) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdatePoolPauseState) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdateCircuitBreakerParams(signer sdk.AccAddress, maxPriceImpact, maxPriceChange sdk.Dec) MsgUpdateCircuitBreakerParams {
+	return MsgUpdateCircuitBreakerParams{Signer: signer.String(), MaxPriceImpact: maxPriceImpact, MaxPriceChange: maxPriceChange}
+}
+
+func (m MsgUpdateCircuitBreakerParams) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateCircuitBreakerParams) Type() string {
+	return "update_circuit_breaker_params"
+}
+
+func (m MsgUpdateCircuitBreakerParams) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.MaxPriceImpact.IsNil() || m.MaxPriceImpact.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidCircuitBreakerLimit, "max price impact")
+	}
+	if m.MaxPriceChange.IsNil() || m.MaxPriceChange.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidCircuitBreakerLimit, "max price change")
+	}
+	return nil
+}
+
+func (m MsgUpdateCircuitBreakerParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateCircuitBreakerParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCancelPmtpPolicy(signer sdk.AccAddress, policyID uint64) MsgCancelPmtpPolicy {
+	return MsgCancelPmtpPolicy{Signer: signer.String(), PolicyId: policyID}
+}
+
+func (m MsgCancelPmtpPolicy) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelPmtpPolicy) Type() string {
+	return "cancel_pmtp_policy"
+}
+
+func (m MsgCancelPmtpPolicy) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.PolicyId == 0 {
+		return sdkerrors.Wrap(ErrPmtpPolicyDoesNotExist, "policy id cannot be zero")
+	}
+	return nil
+}
+
+func (m MsgCancelPmtpPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelPmtpPolicy) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgFundRewardEscrow(signer sdk.AccAddress, rewardPeriodID string, amount sdk.Uint) MsgFundRewardEscrow {
+	return MsgFundRewardEscrow{Signer: signer.String(), RewardPeriodId: rewardPeriodID, Amount: amount}
+}
+
+func (m MsgFundRewardEscrow) Route() string {
+	return RouterKey
+}
+
+func (m MsgFundRewardEscrow) Type() string {
+	return "fund_reward_escrow"
+}
+
+func (m MsgFundRewardEscrow) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.RewardPeriodId == "" {
+		return fmt.Errorf("reward period id must be non-empty")
+	}
+	if m.Amount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, m.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgFundRewardEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFundRewardEscrow) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgRefundRewardEscrow(signer sdk.AccAddress, rewardPeriodID string) MsgRefundRewardEscrow {
+	return MsgRefundRewardEscrow{Signer: signer.String(), RewardPeriodId: rewardPeriodID}
+}
+
+func (m MsgRefundRewardEscrow) Route() string {
+	return RouterKey
+}
+
+func (m MsgRefundRewardEscrow) Type() string {
+	return "refund_reward_escrow"
+}
+
+func (m MsgRefundRewardEscrow) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.RewardPeriodId == "" {
+		return fmt.Errorf("reward period id must be non-empty")
+	}
+	return nil
+}
+
+func (m MsgRefundRewardEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRefundRewardEscrow) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgAddLiquiditySingleSided(signer sdk.AccAddress, externalAsset Asset, sentAsset Asset, amount sdk.Uint) MsgAddLiquiditySingleSided {
+	return MsgAddLiquiditySingleSided{Signer: signer.String(), ExternalAsset: &externalAsset, SentAsset: &sentAsset, Amount: amount}
+}
+
+func (m MsgAddLiquiditySingleSided) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddLiquiditySingleSided) Type() string {
+	return "add_liquidity_single_sided"
+}
+
+func (m MsgAddLiquiditySingleSided) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	if m.ExternalAsset.Equals(GetSettlementAsset()) {
+		return sdkerrors.Wrap(ErrInValidAsset, "External asset cannot be rowan")
+	}
+	if m.SentAsset == nil || (!m.SentAsset.Equals(GetSettlementAsset()) && !m.SentAsset.Equals(*m.ExternalAsset)) {
+		return sdkerrors.Wrap(ErrInValidAsset, "Sent asset must be rowan or the external asset")
+	}
+	if !m.Amount.GT(sdk.ZeroUint()) {
+		return sdkerrors.Wrap(ErrInValidAmount, m.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgAddLiquiditySingleSided) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddLiquiditySingleSided) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgUpdatePoolSwapMode(signer sdk.AccAddress, externalAsset Asset, swapMode SwapMode) MsgUpdatePoolSwapMode {
+	return MsgUpdatePoolSwapMode{Signer: signer.String(), ExternalAsset: &externalAsset, SwapMode: swapMode}
+}
+
+func (m MsgUpdatePoolSwapMode) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdatePoolSwapMode) Type() string {
+	return "update_pool_swap_mode"
+}
+
+func (m MsgUpdatePoolSwapMode) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	if _, ok := SwapMode_name[int32(m.SwapMode)]; !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid swap mode %d", m.SwapMode))
+	}
+	return nil
+}
+
+func (m MsgUpdatePoolSwapMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdatePoolSwapMode) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgApprovePendingPool(signer sdk.AccAddress, externalAsset Asset) MsgApprovePendingPool {
+	return MsgApprovePendingPool{Signer: signer.String(), ExternalAsset: &externalAsset}
+}
+
+func (m MsgApprovePendingPool) Route() string {
+	return RouterKey
+}
+
+func (m MsgApprovePendingPool) Type() string {
+	return "approve_pending_pool"
+}
+
+func (m MsgApprovePendingPool) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	return nil
+}
+
+func (m MsgApprovePendingPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgApprovePendingPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCancelPoolWindDown(signer sdk.AccAddress, externalAsset Asset) MsgCancelPoolWindDown {
+	return MsgCancelPoolWindDown{Signer: signer.String(), ExternalAsset: &externalAsset}
+}
+
+func (m MsgCancelPoolWindDown) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelPoolWindDown) Type() string {
+	return "cancel_pool_wind_down"
+}
+
+func (m MsgCancelPoolWindDown) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	return nil
+}
+
+func (m MsgCancelPoolWindDown) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelPoolWindDown) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -79,12 +80,12 @@ func TestNewMsgCreatePool(t *testing.T) {
 func TestNewMsgDecommissionPool(t *testing.T) {
 	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
 	asset := GetETHAsset()
-	tx := NewMsgDecommissionPool(signer, asset.Symbol)
+	tx := NewMsgDecommissionPool(signer, asset.Symbol, 0, "")
 	err := tx.ValidateBasic()
 	assert.NoError(t, err)
 	assert.Equal(t, tx.GetSigners()[0], signer)
 	wrongAsset := GetWrongAsset()
-	tx = NewMsgDecommissionPool(signer, wrongAsset.Symbol)
+	tx = NewMsgDecommissionPool(signer, wrongAsset.Symbol, 0, "")
 	err = tx.ValidateBasic()
 	assert.Error(t, err)
 
@@ -92,9 +93,20 @@ func TestNewMsgDecommissionPool(t *testing.T) {
 	assert.Equal(t, str, "clp")
 	str = tx.Type()
 	assert.Equal(t, str, "decommission_pool")
-	tx = NewMsgDecommissionPool(nil, asset.Symbol)
+	tx = NewMsgDecommissionPool(signer, asset.Symbol, -1, "")
+	err = tx.ValidateBasic()
+	assert.Error(t, err)
+	tx = NewMsgDecommissionPool(nil, asset.Symbol, 0, "")
 	err = tx.ValidateBasic()
 	assert.Error(t, err, "invalid address")
+	tx = NewMsgDecommissionPool(signer, asset.Symbol, 10, GetSettlementAsset().Symbol)
+	assert.NoError(t, tx.ValidateBasic())
+	tx = NewMsgDecommissionPool(signer, asset.Symbol, 10, asset.Symbol)
+	assert.NoError(t, tx.ValidateBasic())
+	tx = NewMsgDecommissionPool(signer, asset.Symbol, 10, "cusdc")
+	assert.Error(t, tx.ValidateBasic())
+	tx = NewMsgDecommissionPool(signer, asset.Symbol, 0, asset.Symbol)
+	assert.Error(t, tx.ValidateBasic())
 }
 
 func TestNewMsgSwap(t *testing.T) {
@@ -130,6 +142,262 @@ func TestNewMsgSwap(t *testing.T) {
 	assert.Error(t, err, "amount is invalid")
 }
 
+func TestNewMsgSwapRoute(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	eth := GetETHAsset()
+	rowan := GetSettlementAsset()
+	usdc := NewAsset("cusdc")
+	tx := NewMsgSwapRoute(signer, []*Asset{&eth, &rowan, &usdc}, sdk.NewUint(100), sdk.NewUint(90))
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Route(), "clp")
+	assert.Equal(t, tx.Type(), "swap_route")
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth, &rowan, &usdc, &rowan, &eth}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.NoError(t, err)
+	tx = NewMsgSwapRoute(nil, []*Asset{&eth, &rowan}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.Error(t, err, "invalid address")
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidSwapRoute)
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth, &usdc}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidSwapRoute)
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth, &rowan, &rowan, &usdc}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidSwapRoute)
+	wrongAsset := GetWrongAsset()
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth, &rowan, &wrongAsset}, sdk.NewUint(100), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgSwapRoute(signer, []*Asset{&eth, &rowan, &usdc}, sdk.NewUint(0), sdk.NewUint(90))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAmount)
+}
+
+func TestNewMsgPlaceLimitOrder(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	eth := GetETHAsset()
+	rowan := GetSettlementAsset()
+	usdc := NewAsset("cusdc")
+	tx := NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(100), sdk.NewDec(2), 0)
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Route(), "clp")
+	assert.Equal(t, tx.Type(), "place_limit_order")
+	tx = NewMsgPlaceLimitOrder(nil, eth, rowan, sdk.NewUint(100), sdk.NewDec(2), 0)
+	err = tx.ValidateBasic()
+	assert.Error(t, err, "invalid address")
+	tx = NewMsgPlaceLimitOrder(signer, eth, usdc, sdk.NewUint(100), sdk.NewDec(2), 0)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgPlaceLimitOrder(signer, rowan, rowan, sdk.NewUint(100), sdk.NewDec(2), 0)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(0), sdk.NewDec(2), 0)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAmount)
+	tx = NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(100), sdk.ZeroDec(), 0)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAmount)
+	tx = NewMsgPlaceLimitOrder(signer, eth, rowan, sdk.NewUint(100), sdk.NewDec(2), -1)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidExpiryHeight)
+}
+
+func TestNewMsgCancelLimitOrder(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgCancelLimitOrder(signer, 1)
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "cancel_limit_order")
+	tx = NewMsgCancelLimitOrder(nil, 1)
+	err = tx.ValidateBasic()
+	assert.Error(t, err, "invalid address")
+}
+
+func TestNewMsgTransferLiquidityPosition(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	recipient := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA7")
+	asset := GetETHAsset()
+	tx := NewMsgTransferLiquidityPosition(signer, recipient, asset, sdk.NewUint(100))
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "transfer_liquidity_position")
+	tx = NewMsgTransferLiquidityPosition(signer, signer, asset, sdk.NewUint(100))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	tx = NewMsgTransferLiquidityPosition(signer, nil, asset, sdk.NewUint(100))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	tx = NewMsgTransferLiquidityPosition(signer, recipient, GetWrongAsset(), sdk.NewUint(100))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgTransferLiquidityPosition(signer, recipient, asset, sdk.ZeroUint())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAmount)
+}
+
+func TestNewMsgUpdateSwapFeeRate(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgUpdateSwapFeeRate(signer, GetETHAsset(), sdk.MustNewDecFromStr("0.003"))
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "update_swap_fee_rate")
+	tx = NewMsgUpdateSwapFeeRate(signer, GetETHAsset(), sdk.ZeroDec())
+	err = tx.ValidateBasic()
+	assert.NoError(t, err)
+	tx = NewMsgUpdateSwapFeeRate(signer, GetWrongAsset(), sdk.MustNewDecFromStr("0.003"))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgUpdateSwapFeeRate(signer, GetETHAsset(), sdk.OneDec())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+	tx = NewMsgUpdateSwapFeeRate(signer, GetETHAsset(), sdk.MustNewDecFromStr("-0.1"))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+}
+
+func TestNewMsgUpdateProtocolFeeRate(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgUpdateProtocolFeeRate(signer, sdk.MustNewDecFromStr("0.1"))
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "update_protocol_fee_rate")
+	tx = NewMsgUpdateProtocolFeeRate(signer, sdk.NewDec(2))
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+	tx = NewMsgUpdateProtocolFeeRate(signer, sdk.Dec{})
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidFeeRate)
+}
+
+func TestNewMsgUpdatePoolPauseState(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgUpdatePoolPauseState(signer, GetETHAsset(), true, false, false)
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "update_pool_pause_state")
+	tx = NewMsgUpdatePoolPauseState(signer, GetWrongAsset(), true, false, false)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+}
+
+func TestNewMsgUpdatePoolSwapMode(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgUpdatePoolSwapMode(signer, GetETHAsset(), SwapMode_SWAP_MODE_BATCH)
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "update_pool_swap_mode")
+	tx = NewMsgUpdatePoolSwapMode(signer, GetWrongAsset(), SwapMode_SWAP_MODE_BATCH)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgUpdatePoolSwapMode(signer, GetETHAsset(), SwapMode(5))
+	err = tx.ValidateBasic()
+	assert.Error(t, err)
+}
+
+func TestNewMsgUpdateCircuitBreakerParams(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgUpdateCircuitBreakerParams(signer, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "update_circuit_breaker_params")
+	tx = NewMsgUpdateCircuitBreakerParams(signer, sdk.MustNewDecFromStr("-0.05"), sdk.ZeroDec())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidCircuitBreakerLimit)
+	tx = NewMsgUpdateCircuitBreakerParams(signer, sdk.ZeroDec(), sdk.Dec{})
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInvalidCircuitBreakerLimit)
+}
+
+func TestNewMsgCancelPmtpPolicy(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgCancelPmtpPolicy(signer, 1)
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "cancel_pmtp_policy")
+	tx = NewMsgCancelPmtpPolicy(signer, 0)
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrPmtpPolicyDoesNotExist)
+}
+
+func TestNewMsgClaimRewards(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgClaimRewards(signer, GetETHAsset())
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "claim_rewards")
+	tx = NewMsgClaimRewards(signer, GetWrongAsset())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	tx = NewMsgClaimRewards(nil, GetETHAsset())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestNewMsgRewardPeriods(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	allocation := sdk.NewUint(1000)
+	oneDec := sdk.OneDec()
+	period := RewardPeriod{RewardPeriodId: "RP1", RewardPeriodStartBlock: 10, RewardPeriodEndBlock: 20, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec}
+	appendMsg := NewMsgAppendRewardPeriod(signer, period)
+	assert.NoError(t, appendMsg.ValidateBasic())
+	assert.Equal(t, appendMsg.GetSigners()[0], signer)
+	editMsg := NewMsgEditRewardPeriod(signer, period)
+	assert.NoError(t, editMsg.ValidateBasic())
+	deleteMsg := NewMsgDeleteRewardPeriod(signer, "RP1")
+	assert.NoError(t, deleteMsg.ValidateBasic())
+	deleteMsg = NewMsgDeleteRewardPeriod(signer, "")
+	assert.Error(t, deleteMsg.ValidateBasic())
+	period.RewardPeriodDefaultMultiplier = nil
+	appendMsg = NewMsgAppendRewardPeriod(signer, period)
+	assert.Error(t, appendMsg.ValidateBasic())
+	period.RewardPeriodDefaultMultiplier = &oneDec
+	period.RewardPeriodEndBlock = 5
+	editMsg = NewMsgEditRewardPeriod(signer, period)
+	assert.Error(t, editMsg.ValidateBasic())
+	period.RewardPeriodEndBlock = 20
+	replaceMsg := MsgAddRewardPeriodRequest{Signer: signer.String(), RewardPeriods: []*RewardPeriod{&period, &period}}
+	assert.Error(t, replaceMsg.ValidateBasic())
+}
+
+func TestNewMsgRewardEscrow(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	allocation := sdk.NewUint(1000)
+	oneDec := sdk.OneDec()
+	period := RewardPeriod{RewardPeriodId: "RP1", RewardPeriodStartBlock: 10, RewardPeriodEndBlock: 20, RewardPeriodAllocation: &allocation, RewardPeriodDefaultMultiplier: &oneDec, RewardPeriodDenom: "ceth"}
+	// Only rowan can be minted
+	assert.Error(t, ValidateRewardPeriod(&period))
+	period.RewardPeriodFundingSource = RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW
+	assert.NoError(t, ValidateRewardPeriod(&period))
+	period.RewardPeriodFundingModule = "reserve"
+	assert.Error(t, ValidateRewardPeriod(&period))
+	period.RewardPeriodFundingSource = RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT
+	assert.NoError(t, ValidateRewardPeriod(&period))
+	fundMsg := NewMsgFundRewardEscrow(signer, "RP1", sdk.NewUint(100))
+	assert.NoError(t, fundMsg.ValidateBasic())
+	assert.Equal(t, fundMsg.GetSigners()[0], signer)
+	fundMsg = NewMsgFundRewardEscrow(signer, "RP1", sdk.ZeroUint())
+	assert.Error(t, fundMsg.ValidateBasic())
+	refundMsg := NewMsgRefundRewardEscrow(signer, "RP1")
+	assert.NoError(t, refundMsg.ValidateBasic())
+	refundMsg = NewMsgRefundRewardEscrow(signer, "")
+	assert.Error(t, refundMsg.ValidateBasic())
+}
+
 func TestNewMsgAddLiquidity(t *testing.T) {
 	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
 	asset := GetETHAsset()
@@ -183,3 +451,27 @@ func TestNewMsgRemoveLiquidity(t *testing.T) {
 	err = tx.ValidateBasic()
 	assert.Error(t, err, "invalid address")
 }
+
+func TestNewMsgApprovePendingPool(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgApprovePendingPool(signer, GetETHAsset())
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "approve_pending_pool")
+	tx = NewMsgApprovePendingPool(signer, GetWrongAsset())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+}
+
+func TestNewMsgCancelPoolWindDown(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := NewMsgCancelPoolWindDown(signer, GetETHAsset())
+	err := tx.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, tx.GetSigners()[0], signer)
+	assert.Equal(t, tx.Type(), "cancel_pool_wind_down")
+	tx = NewMsgCancelPoolWindDown(signer, GetWrongAsset())
+	err = tx.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+}
@@ -24,6 +24,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PmtpPolicyStatus - the stage of a scheduled pmtp policy
+type PmtpPolicyStatus int32
+
+const (
+	PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED PmtpPolicyStatus = 0
+	PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING     PmtpPolicyStatus = 1
+	PmtpPolicyStatus_PMTP_POLICY_STATUS_ACTIVE      PmtpPolicyStatus = 2
+	PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED   PmtpPolicyStatus = 3
+	PmtpPolicyStatus_PMTP_POLICY_STATUS_CANCELLED   PmtpPolicyStatus = 4
+)
+
+var PmtpPolicyStatus_name = map[int32]string{
+	0: "PMTP_POLICY_STATUS_UNSPECIFIED",
+	1: "PMTP_POLICY_STATUS_PENDING",
+	2: "PMTP_POLICY_STATUS_ACTIVE",
+	3: "PMTP_POLICY_STATUS_COMPLETED",
+	4: "PMTP_POLICY_STATUS_CANCELLED",
+}
+
+var PmtpPolicyStatus_value = map[string]int32{
+	"PMTP_POLICY_STATUS_UNSPECIFIED": 0,
+	"PMTP_POLICY_STATUS_PENDING":     1,
+	"PMTP_POLICY_STATUS_ACTIVE":      2,
+	"PMTP_POLICY_STATUS_COMPLETED":   3,
+	"PMTP_POLICY_STATUS_CANCELLED":   4,
+}
+
+func (x PmtpPolicyStatus) String() string {
+	return proto.EnumName(PmtpPolicyStatus_name, int32(x))
+}
+
+func (PmtpPolicyStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_61de66e331088d04, []int{0}
+}
+
+// RewardFundingSource is where the rewards of a reward period come from
+type RewardFundingSource int32
+
+const (
+	// Rowan is minted
+	RewardFundingSource_REWARD_FUNDING_SOURCE_MINT RewardFundingSource = 0
+	// Rewards are sent by a module account
+	RewardFundingSource_REWARD_FUNDING_SOURCE_MODULE_ACCOUNT RewardFundingSource = 1
+	// Rewards are paid from the escrow funded for the reward period
+	RewardFundingSource_REWARD_FUNDING_SOURCE_ESCROW RewardFundingSource = 2
+)
+
+var RewardFundingSource_name = map[int32]string{
+	0: "REWARD_FUNDING_SOURCE_MINT",
+	1: "REWARD_FUNDING_SOURCE_MODULE_ACCOUNT",
+	2: "REWARD_FUNDING_SOURCE_ESCROW",
+}
+
+var RewardFundingSource_value = map[string]int32{
+	"REWARD_FUNDING_SOURCE_MINT":           0,
+	"REWARD_FUNDING_SOURCE_MODULE_ACCOUNT": 1,
+	"REWARD_FUNDING_SOURCE_ESCROW":         2,
+}
+
+func (x RewardFundingSource) String() string {
+	return proto.EnumName(RewardFundingSource_name, int32(x))
+}
+
+func (RewardFundingSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_61de66e331088d04, []int{1}
+}
+
 // Params - used for initializing default parameter for clp at genesis
 type Params struct {
 	MinCreatePoolThreshold uint64 `protobuf:"varint,1,opt,name=min_create_pool_threshold,json=minCreatePoolThreshold,proto3" json:"min_create_pool_threshold,omitempty"`
@@ -74,6 +141,9 @@ type RewardParams struct {
 	LiquidityRemovalCancelPeriod uint64          `protobuf:"varint,2,opt,name=liquidity_removal_cancel_period,json=liquidityRemovalCancelPeriod,proto3" json:"liquidity_removal_cancel_period,omitempty"`
 	RewardPeriods                []*RewardPeriod `protobuf:"bytes,4,rep,name=reward_periods,json=rewardPeriods,proto3" json:"reward_periods,omitempty"`
 	RewardPeriodStartTime        string          `protobuf:"bytes,5,opt,name=reward_period_start_time,json=rewardPeriodStartTime,proto3" json:"reward_period_start_time,omitempty"`
+	// reward_period_funding_modules are the module accounts allowed to fund
+	// module account funded reward periods
+	RewardPeriodFundingModules []string `protobuf:"bytes,6,rep,name=reward_period_funding_modules,json=rewardPeriodFundingModules,proto3" json:"reward_period_funding_modules,omitempty"`
 }
 
 func (m *RewardParams) Reset()         { *m = RewardParams{} }
@@ -137,6 +207,13 @@ func (m *RewardParams) GetRewardPeriodStartTime() string {
 	return ""
 }
 
+func (m *RewardParams) GetRewardPeriodFundingModules() []string {
+	if m != nil {
+		return m.RewardPeriodFundingModules
+	}
+	return nil
+}
+
 // These params are non-governable and are calculated on chain
 type PmtpRateParams struct {
 	PmtpPeriodBlockRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=pmtp_period_block_rate,json=pmtpPeriodBlockRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pmtp_period_block_rate"`
@@ -238,6 +315,153 @@ func (m *PmtpParams) GetPmtpPeriodEndBlock() int64 {
 	return 0
 }
 
+// PmtpPolicy - a pmtp policy queued by MsgUpdatePmtpParams, the params are
+// copied to PmtpParams when the policy starts
+type PmtpPolicy struct {
+	Id     uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Params PmtpParams       `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Status PmtpPolicyStatus `protobuf:"varint,3,opt,name=status,proto3,enum=sifnode.clp.v1.PmtpPolicyStatus" json:"status,omitempty"`
+	// final_running_rate is the running rate when the policy ended, zero until
+	// the policy is completed
+	FinalRunningRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=final_running_rate,json=finalRunningRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"final_running_rate"`
+}
+
+func (m *PmtpPolicy) Reset()         { *m = PmtpPolicy{} }
+func (m *PmtpPolicy) String() string { return proto.CompactTextString(m) }
+func (*PmtpPolicy) ProtoMessage()    {}
+func (*PmtpPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61de66e331088d04, []int{4}
+}
+func (m *PmtpPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PmtpPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PmtpPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PmtpPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PmtpPolicy.Merge(m, src)
+}
+func (m *PmtpPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PmtpPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PmtpPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PmtpPolicy proto.InternalMessageInfo
+
+func (m *PmtpPolicy) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PmtpPolicy) GetParams() PmtpParams {
+	if m != nil {
+		return m.Params
+	}
+	return PmtpParams{}
+}
+
+func (m *PmtpPolicy) GetStatus() PmtpPolicyStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED
+}
+
+// SwapFeeParams - the share of swap fees routed to the fee collector
+type SwapFeeParams struct {
+	ProtocolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=protocol_fee_rate,json=protocolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_rate"`
+}
+
+func (m *SwapFeeParams) Reset()         { *m = SwapFeeParams{} }
+func (m *SwapFeeParams) String() string { return proto.CompactTextString(m) }
+func (*SwapFeeParams) ProtoMessage()    {}
+func (*SwapFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61de66e331088d04, []int{5}
+}
+func (m *SwapFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapFeeParams.Merge(m, src)
+}
+func (m *SwapFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapFeeParams proto.InternalMessageInfo
+
+// CircuitBreakerParams - limits protecting pools from extreme price moves, a
+// zero limit is disabled
+type CircuitBreakerParams struct {
+	// max_price_impact is the largest share of a pool a single swap may trade
+	// against
+	MaxPriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_impact"`
+	// max_price_change is the largest relative change of swap_price_native
+	// between two blocks before swaps of the pool are paused
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change"`
+}
+
+func (m *CircuitBreakerParams) Reset()         { *m = CircuitBreakerParams{} }
+func (m *CircuitBreakerParams) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerParams) ProtoMessage()    {}
+func (*CircuitBreakerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61de66e331088d04, []int{6}
+}
+func (m *CircuitBreakerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerParams.Merge(m, src)
+}
+func (m *CircuitBreakerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerParams proto.InternalMessageInfo
+
 type RewardPeriod struct {
 	RewardPeriodId                string                                   `protobuf:"bytes,1,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
 	RewardPeriodStartBlock        uint64                                   `protobuf:"varint,2,opt,name=reward_period_start_block,json=rewardPeriodStartBlock,proto3" json:"reward_period_start_block,omitempty"`
@@ -245,13 +469,20 @@ type RewardPeriod struct {
 	RewardPeriodAllocation        *github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=reward_period_allocation,json=rewardPeriodAllocation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"reward_period_allocation,omitempty"`
 	RewardPeriodPoolMultipliers   []*PoolMultiplier                        `protobuf:"bytes,5,rep,name=reward_period_pool_multipliers,json=rewardPeriodPoolMultipliers,proto3" json:"reward_period_pool_multipliers,omitempty"`
 	RewardPeriodDefaultMultiplier *github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=reward_period_default_multiplier,json=rewardPeriodDefaultMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_period_default_multiplier,omitempty"`
+	// reward_period_denom is the token registry denom the rewards are paid in,
+	// rowan when empty
+	RewardPeriodDenom         string              `protobuf:"bytes,7,opt,name=reward_period_denom,json=rewardPeriodDenom,proto3" json:"reward_period_denom,omitempty"`
+	RewardPeriodFundingSource RewardFundingSource `protobuf:"varint,8,opt,name=reward_period_funding_source,json=rewardPeriodFundingSource,proto3,enum=sifnode.clp.v1.RewardFundingSource" json:"reward_period_funding_source,omitempty"`
+	// reward_period_funding_module is the module account paying the rewards
+	// when the funding source is a module account
+	RewardPeriodFundingModule string `protobuf:"bytes,9,opt,name=reward_period_funding_module,json=rewardPeriodFundingModule,proto3" json:"reward_period_funding_module,omitempty"`
 }
 
 func (m *RewardPeriod) Reset()         { *m = RewardPeriod{} }
 func (m *RewardPeriod) String() string { return proto.CompactTextString(m) }
 func (*RewardPeriod) ProtoMessage()    {}
 func (*RewardPeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_61de66e331088d04, []int{4}
+	return fileDescriptor_61de66e331088d04, []int{7}
 }
 func (m *RewardPeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,6 +539,27 @@ func (m *RewardPeriod) GetRewardPeriodPoolMultipliers() []*PoolMultiplier {
 	return nil
 }
 
+func (m *RewardPeriod) GetRewardPeriodDenom() string {
+	if m != nil {
+		return m.RewardPeriodDenom
+	}
+	return ""
+}
+
+func (m *RewardPeriod) GetRewardPeriodFundingSource() RewardFundingSource {
+	if m != nil {
+		return m.RewardPeriodFundingSource
+	}
+	return RewardFundingSource_REWARD_FUNDING_SOURCE_MINT
+}
+
+func (m *RewardPeriod) GetRewardPeriodFundingModule() string {
+	if m != nil {
+		return m.RewardPeriodFundingModule
+	}
+	return ""
+}
+
 type PoolMultiplier struct {
 	PoolMultiplierAsset string                                  `protobuf:"bytes,1,opt,name=pool_multiplier_asset,json=poolMultiplierAsset,proto3" json:"pool_multiplier_asset,omitempty"`
 	Multiplier          *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier,omitempty"`
@@ -317,7 +569,7 @@ func (m *PoolMultiplier) Reset()         { *m = PoolMultiplier{} }
 func (m *PoolMultiplier) String() string { return proto.CompactTextString(m) }
 func (*PoolMultiplier) ProtoMessage()    {}
 func (*PoolMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_61de66e331088d04, []int{5}
+	return fileDescriptor_61de66e331088d04, []int{8}
 }
 func (m *PoolMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,10 +606,15 @@ func (m *PoolMultiplier) GetPoolMultiplierAsset() string {
 }
 
 func init() {
+	proto.RegisterEnum("sifnode.clp.v1.PmtpPolicyStatus", PmtpPolicyStatus_name, PmtpPolicyStatus_value)
+	proto.RegisterEnum("sifnode.clp.v1.RewardFundingSource", RewardFundingSource_name, RewardFundingSource_value)
 	proto.RegisterType((*Params)(nil), "sifnode.clp.v1.Params")
 	proto.RegisterType((*RewardParams)(nil), "sifnode.clp.v1.RewardParams")
 	proto.RegisterType((*PmtpRateParams)(nil), "sifnode.clp.v1.PmtpRateParams")
 	proto.RegisterType((*PmtpParams)(nil), "sifnode.clp.v1.PmtpParams")
+	proto.RegisterType((*PmtpPolicy)(nil), "sifnode.clp.v1.PmtpPolicy")
+	proto.RegisterType((*SwapFeeParams)(nil), "sifnode.clp.v1.SwapFeeParams")
+	proto.RegisterType((*CircuitBreakerParams)(nil), "sifnode.clp.v1.CircuitBreakerParams")
 	proto.RegisterType((*RewardPeriod)(nil), "sifnode.clp.v1.RewardPeriod")
 	proto.RegisterType((*PoolMultiplier)(nil), "sifnode.clp.v1.PoolMultiplier")
 }
@@ -365,54 +622,80 @@ func init() {
 func init() { proto.RegisterFile("sifnode/clp/v1/params.proto", fileDescriptor_61de66e331088d04) }
 
 var fileDescriptor_61de66e331088d04 = []byte{
-	// 741 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcf, 0x4f, 0xdb, 0x30,
-	0x14, 0xc7, 0x9b, 0x16, 0x90, 0xf0, 0xb6, 0x6a, 0x0a, 0x14, 0xc2, 0xaf, 0xb4, 0xea, 0x61, 0xab,
-	0x36, 0x2d, 0x15, 0x4c, 0x08, 0xed, 0x58, 0x0a, 0x9a, 0x98, 0x98, 0x54, 0x05, 0x76, 0xd9, 0x25,
-	0x0a, 0xae, 0x69, 0x2d, 0x1c, 0x3b, 0xb3, 0xdd, 0x6e, 0xfc, 0x17, 0x9c, 0x76, 0xdc, 0x7f, 0x33,
-	0x89, 0x23, 0xc7, 0x89, 0x03, 0x9a, 0xe0, 0xb8, 0x7f, 0x62, 0xca, 0x73, 0xda, 0x26, 0x65, 0x87,
-	0xc1, 0xa9, 0x8d, 0xde, 0x7b, 0x9f, 0xef, 0xfb, 0x65, 0x1b, 0xad, 0x29, 0x7a, 0xca, 0x45, 0x97,
-	0x34, 0x31, 0x8b, 0x9b, 0xc3, 0xcd, 0x66, 0x1c, 0xca, 0x30, 0x52, 0x5e, 0x2c, 0x85, 0x16, 0x76,
-	0x39, 0x35, 0x7a, 0x98, 0xc5, 0xde, 0x70, 0x73, 0x75, 0xb1, 0x27, 0x7a, 0x02, 0x4c, 0xcd, 0xe4,
-	0x9f, 0xf1, 0xaa, 0xb7, 0xd1, 0x5c, 0x07, 0xa2, 0xec, 0x77, 0x68, 0x25, 0xa2, 0x3c, 0xc0, 0x92,
-	0x84, 0x9a, 0x04, 0xb1, 0x10, 0x2c, 0xd0, 0x7d, 0x49, 0x54, 0x5f, 0xb0, 0xae, 0x63, 0xd5, 0xac,
-	0xc6, 0x8c, 0xbf, 0x14, 0x51, 0xde, 0x06, 0x7b, 0x47, 0x08, 0x76, 0x3c, 0xb2, 0xd6, 0xbf, 0x17,
-	0xd1, 0x53, 0x9f, 0x7c, 0x0d, 0x65, 0x37, 0x65, 0xb5, 0xd0, 0x06, 0xa3, 0x5f, 0x06, 0xb4, 0x4b,
-	0xf5, 0x79, 0x20, 0x49, 0x24, 0x86, 0x21, 0x0b, 0x98, 0xc0, 0x67, 0x41, 0x4c, 0x24, 0x15, 0x23,
-	0xde, 0xea, 0xd8, 0xc9, 0x37, 0x3e, 0x87, 0x02, 0x9f, 0x75, 0xc0, 0xc3, 0xde, 0x47, 0xd5, 0xfb,
-	0x08, 0x1c, 0x72, 0x4c, 0xd8, 0x08, 0x52, 0x04, 0xc8, 0xfa, 0x34, 0xa4, 0x0d, 0x4e, 0x29, 0xa6,
-	0x8d, 0xca, 0x12, 0x32, 0x4b, 0x83, 0x94, 0x33, 0x53, 0x2b, 0x35, 0x9e, 0x6c, 0xad, 0x7b, 0xf9,
-	0xf6, 0x78, 0x69, 0xfe, 0xe0, 0xe4, 0x3f, 0x93, 0x99, 0x2f, 0x65, 0xef, 0x20, 0x27, 0x07, 0x09,
-	0x94, 0x0e, 0xa5, 0x0e, 0x34, 0x8d, 0x88, 0x33, 0x5b, 0xb3, 0x1a, 0xf3, 0x7e, 0x25, 0x1b, 0x70,
-	0x94, 0x58, 0x8f, 0x69, 0x44, 0xea, 0x3f, 0x8b, 0xa8, 0xdc, 0x89, 0x74, 0xec, 0x27, 0x2d, 0x33,
-	0xad, 0xc1, 0x68, 0x29, 0x8e, 0x74, 0x3c, 0x22, 0x9d, 0x40, 0x57, 0x64, 0xa8, 0x09, 0x94, 0x33,
-	0xbf, 0xeb, 0x5d, 0xde, 0x54, 0x0b, 0xd7, 0x37, 0xd5, 0x17, 0x3d, 0xaa, 0xfb, 0x83, 0x13, 0x0f,
-	0x8b, 0xa8, 0x89, 0x85, 0x8a, 0x84, 0x4a, 0x7f, 0xde, 0xa8, 0xee, 0x59, 0x53, 0x9f, 0xc7, 0x44,
-	0x79, 0x7b, 0x04, 0xfb, 0x0b, 0x09, 0xcd, 0xe8, 0xee, 0x26, 0xac, 0x44, 0xca, 0xa6, 0x68, 0x05,
-	0x44, 0xf0, 0x40, 0x4a, 0xc2, 0x75, 0x20, 0x07, 0x9c, 0x53, 0xde, 0x33, 0x3a, 0xa5, 0x47, 0xe9,
-	0x40, 0xd6, 0x6d, 0xc3, 0xf3, 0x0d, 0x0e, 0xa4, 0x46, 0xf5, 0x50, 0xae, 0x89, 0x0c, 0x62, 0xc1,
-	0x28, 0x3e, 0x37, 0x3a, 0x33, 0x8f, 0xaf, 0xe7, 0x20, 0x81, 0x75, 0x80, 0x95, 0x88, 0xd4, 0x7f,
-	0x14, 0x11, 0x4a, 0xfa, 0x98, 0xf6, 0x30, 0x42, 0x6b, 0xd9, 0x1e, 0xf6, 0xc4, 0x90, 0x48, 0x9e,
-	0x4c, 0xdd, 0x08, 0x5b, 0x8f, 0x12, 0x76, 0x26, 0x8d, 0x7c, 0x3f, 0x06, 0x42, 0x89, 0x3b, 0xc8,
-	0xc9, 0xca, 0x91, 0x58, 0xe0, 0x7e, 0xc0, 0x08, 0xef, 0xe9, 0x3e, 0x0c, 0xad, 0xe4, 0x57, 0x26,
-	0xb1, 0xfb, 0x89, 0xf5, 0x10, 0x8c, 0xf6, 0x36, 0x5a, 0xce, 0x06, 0x9a, 0xad, 0x81, 0x89, 0xc3,
-	0x10, 0x4a, 0xfe, 0xe2, 0x24, 0x0e, 0x96, 0x06, 0x26, 0x68, 0x6f, 0xa2, 0x4a, 0x4e, 0x8f, 0xa7,
-	0x6b, 0x02, 0x1d, 0x2d, 0xf9, 0x76, 0x46, 0x8c, 0x9b, 0xa1, 0xd7, 0xff, 0x94, 0xc6, 0x27, 0xd0,
-	0xec, 0x7d, 0x03, 0x3d, 0xcf, 0xaf, 0x2c, 0x35, 0x87, 0x6e, 0xde, 0x2f, 0x67, 0x57, 0xf5, 0xa0,
-	0x9b, 0x9c, 0xfb, 0x7f, 0x2d, 0xb7, 0x51, 0x34, 0x47, 0x6c, 0xe9, 0xde, 0x76, 0x9b, 0x44, 0xb7,
-	0xd1, 0x72, 0x3e, 0x74, 0x92, 0x6a, 0x09, 0x02, 0x17, 0xb3, 0x81, 0xa3, 0x64, 0x6d, 0x32, 0x7d,
-	0x9c, 0x42, 0xc6, 0x04, 0x0e, 0x35, 0x15, 0x3c, 0x5d, 0x9a, 0xd7, 0xd7, 0x37, 0xd5, 0x97, 0xff,
-	0x31, 0xb7, 0x4f, 0x94, 0xeb, 0x7c, 0x76, 0xad, 0x31, 0xca, 0xc6, 0xc8, 0xcd, 0xcb, 0xc0, 0x9d,
-	0x16, 0x0d, 0x98, 0xa6, 0x31, 0xa3, 0x44, 0x2a, 0x67, 0x16, 0xae, 0x02, 0x77, 0xfa, 0x2a, 0x48,
-	0x2e, 0xb7, 0x8f, 0x63, 0x37, 0x7f, 0x2d, 0xcb, 0xcf, 0xdb, 0x94, 0xad, 0x50, 0x2d, 0x2f, 0xd2,
-	0x25, 0xa7, 0xe1, 0x80, 0xe9, 0x8c, 0x8e, 0x33, 0x07, 0x35, 0xbd, 0x7a, 0xc0, 0x2e, 0x6e, 0x64,
-	0x25, 0xf7, 0x0c, 0x71, 0xa2, 0x5a, 0xbf, 0xb0, 0x50, 0x39, 0x9f, 0x88, 0xbd, 0x85, 0x2a, 0x53,
-	0xe5, 0x05, 0xa1, 0x52, 0x44, 0xa7, 0x43, 0x5f, 0x88, 0x73, 0xee, 0xad, 0xc4, 0x64, 0x7f, 0x40,
-	0x28, 0x93, 0x65, 0xf1, 0xc1, 0x59, 0x66, 0xa2, 0x77, 0x5b, 0x97, 0xb7, 0xae, 0x75, 0x75, 0xeb,
-	0x5a, 0xbf, 0x6f, 0x5d, 0xeb, 0xe2, 0xce, 0x2d, 0x5c, 0xdd, 0xb9, 0x85, 0x5f, 0x77, 0x6e, 0xe1,
-	0x73, 0x76, 0x8e, 0x47, 0xf4, 0x14, 0xf7, 0x43, 0xca, 0x9b, 0xa3, 0x87, 0xeb, 0x1b, 0x3c, 0x5d,
-	0x80, 0x3c, 0x99, 0x83, 0x17, 0xe9, 0xed, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0xa5, 0x67,
-	0xa2, 0xd6, 0x06, 0x00, 0x00,
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xb6, 0xd0, 0x59, 0x36, 0x78, 0xa7, 0x3f, 0xeb, 0xfe, 0xa5, 0x51, 0x40, 0x10,
+	0x15, 0x91, 0xa8, 0x45, 0xab, 0x85, 0x2b, 0x94, 0x3a, 0xee, 0x2a, 0x28, 0x4d, 0x2c, 0x27, 0x61,
+	0x61, 0x85, 0x34, 0x72, 0x27, 0x93, 0x64, 0x54, 0xdb, 0x63, 0xec, 0x71, 0xb7, 0xbd, 0xe4, 0x0d,
+	0xf6, 0x09, 0x78, 0x0f, 0x6e, 0x91, 0x90, 0x56, 0x5c, 0xed, 0x25, 0xec, 0x45, 0x85, 0xda, 0x7b,
+	0x9e, 0x01, 0x79, 0xec, 0x24, 0x76, 0x9a, 0x22, 0x36, 0x5c, 0xed, 0x56, 0xe7, 0x3b, 0xdf, 0x77,
+	0xfc, 0x9d, 0x33, 0xe7, 0x04, 0xec, 0xf8, 0x74, 0xe0, 0xb0, 0x3e, 0xa9, 0x62, 0xcb, 0xad, 0x5e,
+	0x1c, 0x56, 0x5d, 0xd3, 0x33, 0x6d, 0xbf, 0xe2, 0x7a, 0x8c, 0x33, 0x98, 0x8f, 0x83, 0x15, 0x6c,
+	0xb9, 0x95, 0x8b, 0xc3, 0xed, 0xf5, 0x21, 0x1b, 0x32, 0x11, 0xaa, 0x86, 0xff, 0x8b, 0x50, 0x25,
+	0x15, 0xac, 0xe8, 0x22, 0x0b, 0x7e, 0x05, 0xb6, 0x6c, 0xea, 0x20, 0xec, 0x11, 0x93, 0x13, 0xe4,
+	0x32, 0x66, 0x21, 0x3e, 0xf2, 0x88, 0x3f, 0x62, 0x56, 0x5f, 0x91, 0x8a, 0x52, 0x79, 0xc9, 0xd8,
+	0xb4, 0xa9, 0xa3, 0x8a, 0xb8, 0xce, 0x98, 0xd5, 0x1d, 0x47, 0x4b, 0x7f, 0x66, 0xc1, 0x07, 0x06,
+	0x79, 0x69, 0x7a, 0xfd, 0x98, 0xab, 0x06, 0xf6, 0x2c, 0xfa, 0x63, 0x40, 0xfb, 0x94, 0x5f, 0x21,
+	0x8f, 0xd8, 0xec, 0xc2, 0xb4, 0x90, 0xc5, 0xf0, 0x39, 0x72, 0x89, 0x47, 0xd9, 0x98, 0x6f, 0x7b,
+	0x02, 0x32, 0x22, 0x4c, 0x93, 0xe1, 0x73, 0x5d, 0x20, 0xa0, 0x06, 0xf6, 0xef, 0x52, 0x60, 0xd3,
+	0xc1, 0xc4, 0x1a, 0x93, 0x64, 0x05, 0xc9, 0xee, 0x2c, 0x89, 0x2a, 0x40, 0x31, 0x8d, 0x0a, 0xf2,
+	0x9e, 0xa8, 0x2c, 0x4e, 0xf2, 0x95, 0xa5, 0x62, 0xae, 0xfc, 0xe0, 0x68, 0xb7, 0x92, 0xb6, 0xa7,
+	0x12, 0xd7, 0x2f, 0x40, 0xc6, 0x43, 0x2f, 0xf1, 0x97, 0x0f, 0x9f, 0x02, 0x25, 0x45, 0x82, 0x7c,
+	0x6e, 0x7a, 0x1c, 0x71, 0x6a, 0x13, 0x65, 0xb9, 0x28, 0x95, 0x57, 0x8d, 0x8d, 0x64, 0x42, 0x27,
+	0x8c, 0x76, 0xa9, 0x4d, 0x42, 0x1f, 0xd2, 0x89, 0x83, 0xc0, 0xe9, 0x53, 0x67, 0x88, 0x6c, 0xd6,
+	0x0f, 0x2c, 0xe2, 0x2b, 0x2b, 0xc5, 0x5c, 0x79, 0xd5, 0xd8, 0x4e, 0x66, 0x9f, 0x44, 0x90, 0xd3,
+	0x08, 0x51, 0xfa, 0x2d, 0x0b, 0xf2, 0xba, 0xcd, 0x5d, 0x23, 0x74, 0x3d, 0x72, 0x17, 0x83, 0x4d,
+	0xd7, 0xe6, 0xee, 0x98, 0xf3, 0x4c, 0x18, 0xeb, 0x99, 0x9c, 0x08, 0x47, 0x56, 0x8f, 0x2b, 0xaf,
+	0xaf, 0xf7, 0x33, 0x6f, 0xaf, 0xf7, 0x3f, 0x19, 0x52, 0x3e, 0x0a, 0xce, 0x2a, 0x98, 0xd9, 0x55,
+	0xcc, 0x7c, 0x9b, 0xf9, 0xf1, 0x3f, 0x9f, 0xfb, 0xfd, 0xf3, 0x2a, 0xbf, 0x72, 0x89, 0x5f, 0xa9,
+	0x13, 0x6c, 0xac, 0x85, 0x6c, 0x91, 0xf8, 0x71, 0xc8, 0x15, 0x4a, 0x41, 0x0a, 0xb6, 0x84, 0x08,
+	0x0e, 0x3c, 0x8f, 0x38, 0x1c, 0x79, 0x81, 0xe3, 0x84, 0x95, 0x0b, 0x9d, 0xdc, 0x42, 0x3a, 0xa2,
+	0x6a, 0x35, 0xe2, 0x33, 0x22, 0x3a, 0x21, 0x35, 0xfe, 0x1e, 0xea, 0x70, 0xe2, 0x21, 0x97, 0x59,
+	0x14, 0x5f, 0x45, 0x3a, 0x4b, 0x8b, 0x7f, 0x4f, 0x23, 0x24, 0xd3, 0x05, 0x57, 0x28, 0x52, 0xfa,
+	0x39, 0x0b, 0x40, 0xe8, 0x63, 0xec, 0xa1, 0x0d, 0x76, 0x92, 0x1e, 0x0e, 0xd9, 0x05, 0xf1, 0x9c,
+	0x70, 0x70, 0x22, 0x61, 0x69, 0x21, 0x61, 0x65, 0x6a, 0xe4, 0xb3, 0x09, 0xa1, 0xf8, 0xc4, 0xa7,
+	0x40, 0x49, 0xca, 0x11, 0x97, 0xe1, 0x11, 0xb2, 0x88, 0x33, 0xe4, 0x23, 0xd1, 0xb4, 0x9c, 0xb1,
+	0x31, 0xcd, 0xd5, 0xc2, 0x68, 0x53, 0x04, 0xe1, 0x13, 0xf0, 0x38, 0x99, 0x18, 0x0d, 0x9e, 0xe8,
+	0xb8, 0x68, 0x42, 0xce, 0x58, 0x9f, 0xe6, 0x89, 0xb9, 0x13, 0x1d, 0x84, 0x87, 0x60, 0x23, 0xa5,
+	0xe7, 0xc4, 0x63, 0x22, 0x1c, 0xcd, 0x19, 0x30, 0x21, 0xe6, 0x44, 0x4d, 0x2f, 0xfd, 0x2d, 0xc5,
+	0x06, 0x09, 0xcf, 0x60, 0x1e, 0x64, 0xe9, 0xf8, 0x9d, 0x66, 0x69, 0x1f, 0x7e, 0x09, 0x56, 0xa2,
+	0xf5, 0x22, 0xea, 0x7d, 0x70, 0xb4, 0x3d, 0xfb, 0x80, 0xa6, 0xe6, 0x1e, 0x2f, 0x85, 0xbe, 0x19,
+	0x31, 0x3e, 0xcc, 0xf4, 0xb9, 0xc9, 0x03, 0x5f, 0x54, 0x9c, 0x3f, 0x2a, 0xce, 0xcd, 0x14, 0xaa,
+	0x1d, 0x81, 0x33, 0x62, 0x3c, 0xfc, 0x01, 0xc0, 0x01, 0x75, 0x4c, 0x2b, 0x3d, 0x7c, 0x8b, 0x0d,
+	0x85, 0x2c, 0x98, 0x12, 0x63, 0x57, 0x3a, 0x07, 0x0f, 0x3b, 0x2f, 0x4d, 0xf7, 0x84, 0x8c, 0xdf,
+	0xd5, 0x0b, 0xf0, 0x48, 0x2c, 0x45, 0xcc, 0x2c, 0x34, 0x20, 0xff, 0x6b, 0x12, 0x3e, 0x1c, 0x13,
+	0x9d, 0x10, 0x31, 0x00, 0xa5, 0xdf, 0x25, 0xb0, 0xae, 0x52, 0x0f, 0x07, 0x94, 0x1f, 0x7b, 0xc4,
+	0x3c, 0x27, 0x5e, 0x2c, 0xfa, 0x1d, 0x90, 0x6d, 0xf3, 0x12, 0xb9, 0x1e, 0xc5, 0x04, 0x51, 0xdb,
+	0x35, 0x31, 0x5f, 0x50, 0x33, 0x6f, 0x9b, 0x97, 0x7a, 0x48, 0xd3, 0x10, 0x2c, 0x69, 0x66, 0x3c,
+	0x32, 0x9d, 0xe1, 0xa2, 0x0b, 0x62, 0xc2, 0xac, 0x0a, 0x96, 0xd2, 0xaf, 0xcb, 0x93, 0x7d, 0x1f,
+	0x6d, 0xd9, 0x32, 0x90, 0xd3, 0x7b, 0x2e, 0x1e, 0x9d, 0x55, 0x23, 0x9f, 0x5c, 0x6d, 0x8d, 0x7e,
+	0x78, 0x65, 0xe6, 0xad, 0xd2, 0x68, 0x38, 0xa3, 0x85, 0xbe, 0x79, 0x67, 0x97, 0x46, 0x33, 0xfd,
+	0x04, 0x3c, 0x4e, 0xa7, 0x4e, 0xa7, 0x3a, 0x27, 0x12, 0xd7, 0x93, 0x89, 0xe3, 0xb9, 0x86, 0x64,
+	0x76, 0x79, 0x9b, 0x96, 0xc5, 0xb0, 0xc9, 0x29, 0x73, 0xe2, 0x51, 0xfa, 0xec, 0xed, 0xf5, 0xfe,
+	0xa7, 0xff, 0xc1, 0x8a, 0x1e, 0x75, 0x78, 0xba, 0xba, 0xda, 0x84, 0x0a, 0x62, 0x50, 0x48, 0xcb,
+	0x88, 0x0b, 0x6a, 0x07, 0x16, 0xa7, 0xae, 0x45, 0x89, 0xe7, 0x2b, 0xcb, 0xe2, 0xf0, 0x14, 0xee,
+	0x4c, 0x3f, 0x63, 0xd6, 0xe9, 0x04, 0x66, 0xec, 0x24, 0xf9, 0xd3, 0x31, 0x1f, 0xfa, 0xa0, 0x98,
+	0x16, 0xe9, 0x93, 0x81, 0x19, 0x58, 0x3c, 0xa1, 0xa3, 0xac, 0x88, 0x6f, 0x3a, 0x78, 0x87, 0xf6,
+	0xee, 0x25, 0x25, 0xeb, 0x11, 0xe3, 0x54, 0x15, 0x56, 0xc0, 0xda, 0xac, 0xa8, 0xc3, 0x6c, 0xe5,
+	0x3d, 0xd1, 0xdf, 0x47, 0xe9, 0x5c, 0x87, 0xd9, 0xb0, 0x0f, 0x76, 0xe7, 0x1f, 0x3d, 0x9f, 0x05,
+	0x1e, 0x26, 0xca, 0xfb, 0x62, 0x0b, 0x7c, 0x34, 0xff, 0x00, 0xc7, 0xd7, 0xaf, 0x23, 0xa0, 0xc6,
+	0xd6, 0x9c, 0xc3, 0x18, 0x85, 0xe0, 0xd7, 0x60, 0xf7, 0xdf, 0x4e, 0xab, 0xb2, 0x2a, 0xca, 0xdb,
+	0xba, 0xf7, 0xb2, 0x96, 0x5e, 0x49, 0x20, 0x9f, 0xf6, 0x17, 0x1e, 0x81, 0x8d, 0x99, 0xae, 0x21,
+	0xd3, 0xf7, 0x49, 0xfc, 0x20, 0x8d, 0x35, 0x37, 0x05, 0xaf, 0x85, 0x21, 0xf8, 0x0d, 0x00, 0x09,
+	0xf3, 0xb3, 0xef, 0x6c, 0x7e, 0x22, 0xfb, 0xe0, 0x17, 0x09, 0xc8, 0xb3, 0xcb, 0x10, 0x96, 0x40,
+	0x41, 0x3f, 0xed, 0xea, 0x48, 0x6f, 0x37, 0x1b, 0xea, 0xf7, 0xa8, 0xd3, 0xad, 0x75, 0x7b, 0x1d,
+	0xd4, 0x6b, 0x75, 0x74, 0x4d, 0x6d, 0x9c, 0x34, 0xb4, 0xba, 0x9c, 0x81, 0x05, 0xb0, 0x3d, 0x07,
+	0xa3, 0x6b, 0xad, 0x7a, 0xa3, 0xf5, 0x4c, 0x96, 0xe0, 0x1e, 0xd8, 0x9a, 0x13, 0xaf, 0xa9, 0xdd,
+	0xc6, 0xb7, 0x9a, 0x9c, 0x85, 0x45, 0xb0, 0x3b, 0x27, 0xac, 0xb6, 0x4f, 0xf5, 0xa6, 0xd6, 0xd5,
+	0xea, 0x72, 0xee, 0x3e, 0x44, 0xad, 0xa5, 0x6a, 0xcd, 0xa6, 0x56, 0x97, 0x97, 0x0e, 0x7e, 0x92,
+	0xc0, 0xda, 0x9c, 0x16, 0x86, 0xa5, 0x19, 0xda, 0xf3, 0x9a, 0x51, 0x47, 0x27, 0x3d, 0x51, 0x0e,
+	0xea, 0xb4, 0x7b, 0x86, 0xaa, 0xa1, 0xd3, 0x46, 0xab, 0x2b, 0x67, 0x60, 0x19, 0x7c, 0x7c, 0x4f,
+	0xbc, 0x5d, 0xef, 0x35, 0x35, 0x54, 0x53, 0xd5, 0x76, 0xaf, 0xd5, 0x95, 0xa5, 0xb0, 0x86, 0xf9,
+	0x48, 0xad, 0xa3, 0x1a, 0xed, 0xe7, 0x72, 0xf6, 0xb8, 0xf6, 0xfa, 0xa6, 0x20, 0xbd, 0xb9, 0x29,
+	0x48, 0x7f, 0xdd, 0x14, 0xa4, 0x57, 0xb7, 0x85, 0xcc, 0x9b, 0xdb, 0x42, 0xe6, 0x8f, 0xdb, 0x42,
+	0xe6, 0x45, 0xf2, 0x79, 0x77, 0xe8, 0x00, 0x8f, 0x4c, 0xea, 0x54, 0xc7, 0xbf, 0x9e, 0x2f, 0xc5,
+	0xef, 0x67, 0xd1, 0x92, 0xb3, 0x15, 0xb1, 0xb8, 0xbf, 0xf8, 0x67, 0x00, 0x4e, 0x5d, 0xc1, 0xf9,
+	0x5b, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -463,6 +746,15 @@ func (m *RewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardPeriodFundingModules) > 0 {
+		for iNdEx := len(m.RewardPeriodFundingModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardPeriodFundingModules[iNdEx])
+			copy(dAtA[i:], m.RewardPeriodFundingModules[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RewardPeriodFundingModules[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.RewardPeriodStartTime) > 0 {
 		i -= len(m.RewardPeriodStartTime)
 		copy(dAtA[i:], m.RewardPeriodStartTime)
@@ -598,6 +890,135 @@ func (m *PmtpParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PmtpPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PmtpPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PmtpPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FinalRunningRate.Size()
+		i -= size
+		if _, err := m.FinalRunningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProtocolFeeRate.Size()
+		i -= size
+		if _, err := m.ProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -618,6 +1039,25 @@ func (m *RewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardPeriodFundingModule) > 0 {
+		i -= len(m.RewardPeriodFundingModule)
+		copy(dAtA[i:], m.RewardPeriodFundingModule)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardPeriodFundingModule)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RewardPeriodFundingSource != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardPeriodFundingSource))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RewardPeriodDenom) > 0 {
+		i -= len(m.RewardPeriodDenom)
+		copy(dAtA[i:], m.RewardPeriodDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardPeriodDenom)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.RewardPeriodDefaultMultiplier != nil {
 		{
 			size := m.RewardPeriodDefaultMultiplier.Size()
@@ -763,6 +1203,12 @@ func (m *RewardParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.RewardPeriodFundingModules) > 0 {
+		for _, s := range m.RewardPeriodFundingModules {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -801,21 +1247,64 @@ func (m *PmtpParams) Size() (n int) {
 	return n
 }
 
-func (m *RewardPeriod) Size() (n int) {
+func (m *PmtpPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RewardPeriodId)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovParams(uint64(m.Id))
 	}
-	if m.RewardPeriodStartBlock != 0 {
-		n += 1 + sovParams(uint64(m.RewardPeriodStartBlock))
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovParams(uint64(m.Status))
 	}
-	if m.RewardPeriodEndBlock != 0 {
-		n += 1 + sovParams(uint64(m.RewardPeriodEndBlock))
+	l = m.FinalRunningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *SwapFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtocolFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *RewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RewardPeriodStartBlock != 0 {
+		n += 1 + sovParams(uint64(m.RewardPeriodStartBlock))
+	}
+	if m.RewardPeriodEndBlock != 0 {
+		n += 1 + sovParams(uint64(m.RewardPeriodEndBlock))
 	}
 	if m.RewardPeriodAllocation != nil {
 		l = m.RewardPeriodAllocation.Size()
@@ -831,6 +1320,17 @@ func (m *RewardPeriod) Size() (n int) {
 		l = m.RewardPeriodDefaultMultiplier.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	l = len(m.RewardPeriodDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.RewardPeriodFundingSource != 0 {
+		n += 1 + sovParams(uint64(m.RewardPeriodFundingSource))
+	}
+	l = len(m.RewardPeriodFundingModule)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -1059,6 +1559,38 @@ func (m *RewardParams) Unmarshal(dAtA []byte) error {
 			}
 			m.RewardPeriodStartTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodFundingModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodFundingModules = append(m.RewardPeriodFundingModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1373,6 +1905,363 @@ func (m *PmtpParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PmtpPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PmtpPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PmtpPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PmtpPolicyStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalRunningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalRunningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1578,6 +2467,89 @@ func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodFundingSource", wireType)
+			}
+			m.RewardPeriodFundingSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardPeriodFundingSource |= RewardFundingSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodFundingModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodFundingModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const (
+	ProposalTypeUpdatePmtpParams          = "UpdatePmtpParams"
+	ProposalTypeModifyPmtpRates           = "ModifyPmtpRates"
+	ProposalTypeAddRewardPeriod           = "AddRewardPeriod"
+	ProposalTypeUpdateRewardsParams       = "UpdateRewardsParams"
+	ProposalTypeUpdateStakingRewardParams = "UpdateStakingRewardParams"
+)
+
+var (
+	_ govtypes.Content = &UpdatePmtpParamsProposal{}
+	_ govtypes.Content = &ModifyPmtpRatesProposal{}
+	_ govtypes.Content = &AddRewardPeriodProposal{}
+	_ govtypes.Content = &UpdateRewardsParamsProposal{}
+	_ govtypes.Content = &UpdateStakingRewardParamsProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdatePmtpParams)
+	govtypes.RegisterProposalTypeCodec(&UpdatePmtpParamsProposal{}, "clp/UpdatePmtpParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeModifyPmtpRates)
+	govtypes.RegisterProposalTypeCodec(&ModifyPmtpRatesProposal{}, "clp/ModifyPmtpRatesProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddRewardPeriod)
+	govtypes.RegisterProposalTypeCodec(&AddRewardPeriodProposal{}, "clp/AddRewardPeriodProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateRewardsParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateRewardsParamsProposal{}, "clp/UpdateRewardsParamsProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateStakingRewardParams)
+	govtypes.RegisterProposalTypeCodec(&UpdateStakingRewardParamsProposal{}, "clp/UpdateStakingRewardParamsProposal")
+}
+
+func NewUpdatePmtpParamsProposal(title, description, governanceRate string, epochLength, startBlock, endBlock int64) *UpdatePmtpParamsProposal {
+	return &UpdatePmtpParamsProposal{
+		Title:                    title,
+		Description:              description,
+		PmtpPeriodGovernanceRate: governanceRate,
+		PmtpPeriodEpochLength:    epochLength,
+		PmtpPeriodStartBlock:     startBlock,
+		PmtpPeriodEndBlock:       endBlock,
+	}
+}
+
+func (p *UpdatePmtpParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdatePmtpParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdatePmtpParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdatePmtpParamsProposal) ProposalType() string { return ProposalTypeUpdatePmtpParams }
+
+func (p *UpdatePmtpParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.PmtpPeriodGovernanceRate != "" {
+		if _, err := sdk.NewDecFromStr(p.PmtpPeriodGovernanceRate); err != nil {
+			return fmt.Errorf("invalid pmtp governance rate %s: %s", p.PmtpPeriodGovernanceRate, err.Error())
+		}
+	}
+	return ValidatePmtpPeriod(p.PmtpPeriodEpochLength, p.PmtpPeriodStartBlock, p.PmtpPeriodEndBlock)
+}
+
+func NewModifyPmtpRatesProposal(title, description, blockRate, runningRate string, endPolicy bool) *ModifyPmtpRatesProposal {
+	return &ModifyPmtpRatesProposal{
+		Title:       title,
+		Description: description,
+		BlockRate:   blockRate,
+		RunningRate: runningRate,
+		EndPolicy:   endPolicy,
+	}
+}
+
+func (p *ModifyPmtpRatesProposal) GetTitle() string { return p.Title }
+
+func (p *ModifyPmtpRatesProposal) GetDescription() string { return p.Description }
+
+func (p *ModifyPmtpRatesProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ModifyPmtpRatesProposal) ProposalType() string { return ProposalTypeModifyPmtpRates }
+
+func (p *ModifyPmtpRatesProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	for _, rate := range []string{p.BlockRate, p.RunningRate} {
+		if rate == "" {
+			continue
+		}
+		if _, err := sdk.NewDecFromStr(rate); err != nil {
+			return fmt.Errorf("invalid pmtp rate %s: %s", rate, err.Error())
+		}
+	}
+	return nil
+}
+
+func NewAddRewardPeriodProposal(title, description string, rewardPeriods []*RewardPeriod) *AddRewardPeriodProposal {
+	return &AddRewardPeriodProposal{
+		Title:         title,
+		Description:   description,
+		RewardPeriods: rewardPeriods,
+	}
+}
+
+func (p *AddRewardPeriodProposal) GetTitle() string { return p.Title }
+
+func (p *AddRewardPeriodProposal) GetDescription() string { return p.Description }
+
+func (p *AddRewardPeriodProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddRewardPeriodProposal) ProposalType() string { return ProposalTypeAddRewardPeriod }
+
+func (p *AddRewardPeriodProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return MsgAddRewardPeriodRequest{RewardPeriods: p.RewardPeriods}.ValidateBasic()
+}
+
+func NewUpdateRewardsParamsProposal(title, description string, lockPeriod, cancelPeriod uint64, fundingModules []string) *UpdateRewardsParamsProposal {
+	return &UpdateRewardsParamsProposal{
+		Title:                        title,
+		Description:                  description,
+		LiquidityRemovalLockPeriod:   lockPeriod,
+		LiquidityRemovalCancelPeriod: cancelPeriod,
+		RewardPeriodFundingModules:   fundingModules,
+	}
+}
+
+func (p *UpdateRewardsParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateRewardsParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateRewardsParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateRewardsParamsProposal) ProposalType() string { return ProposalTypeUpdateRewardsParams }
+
+func (p *UpdateRewardsParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return ValidateRewardPeriodFundingModules(p.RewardPeriodFundingModules)
+}
+
+func NewUpdateStakingRewardParamsProposal(title, description string, minter minttypes.Minter, params minttypes.Params) *UpdateStakingRewardParamsProposal {
+	return &UpdateStakingRewardParamsProposal{
+		Title:       title,
+		Description: description,
+		Minter:      minter,
+		Params:      params,
+	}
+}
+
+func (p *UpdateStakingRewardParamsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateStakingRewardParamsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateStakingRewardParamsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateStakingRewardParamsProposal) ProposalType() string {
+	return ProposalTypeUpdateStakingRewardParams
+}
+
+func (p *UpdateStakingRewardParamsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return p.Params.Validate()
+}
@@ -0,0 +1,1724 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/clp/v1/proposals.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_x_mint_types "github.com/cosmos/cosmos-sdk/x/mint/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UpdatePmtpParamsProposal queues a pmtp policy like MsgUpdatePmtpParams
+type UpdatePmtpParamsProposal struct {
+	Title                    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description              string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PmtpPeriodGovernanceRate string `protobuf:"bytes,3,opt,name=pmtp_period_governance_rate,json=pmtpPeriodGovernanceRate,proto3" json:"pmtp_period_governance_rate,omitempty"`
+	PmtpPeriodEpochLength    int64  `protobuf:"varint,4,opt,name=pmtp_period_epoch_length,json=pmtpPeriodEpochLength,proto3" json:"pmtp_period_epoch_length,omitempty"`
+	PmtpPeriodStartBlock     int64  `protobuf:"varint,5,opt,name=pmtp_period_start_block,json=pmtpPeriodStartBlock,proto3" json:"pmtp_period_start_block,omitempty"`
+	PmtpPeriodEndBlock       int64  `protobuf:"varint,6,opt,name=pmtp_period_end_block,json=pmtpPeriodEndBlock,proto3" json:"pmtp_period_end_block,omitempty"`
+}
+
+func (m *UpdatePmtpParamsProposal) Reset()         { *m = UpdatePmtpParamsProposal{} }
+func (m *UpdatePmtpParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdatePmtpParamsProposal) ProtoMessage()    {}
+func (*UpdatePmtpParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{0}
+}
+func (m *UpdatePmtpParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePmtpParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePmtpParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdatePmtpParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePmtpParamsProposal.Merge(m, src)
+}
+func (m *UpdatePmtpParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePmtpParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePmtpParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePmtpParamsProposal proto.InternalMessageInfo
+
+// ModifyPmtpRatesProposal sets the pmtp rates or ends the running policy like
+// MsgModifyPmtpRates
+type ModifyPmtpRatesProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	BlockRate   string `protobuf:"bytes,3,opt,name=block_rate,json=blockRate,proto3" json:"block_rate,omitempty"`
+	RunningRate string `protobuf:"bytes,4,opt,name=running_rate,json=runningRate,proto3" json:"running_rate,omitempty"`
+	EndPolicy   bool   `protobuf:"varint,5,opt,name=end_policy,json=endPolicy,proto3" json:"end_policy,omitempty"`
+}
+
+func (m *ModifyPmtpRatesProposal) Reset()         { *m = ModifyPmtpRatesProposal{} }
+func (m *ModifyPmtpRatesProposal) String() string { return proto.CompactTextString(m) }
+func (*ModifyPmtpRatesProposal) ProtoMessage()    {}
+func (*ModifyPmtpRatesProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{1}
+}
+func (m *ModifyPmtpRatesProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModifyPmtpRatesProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModifyPmtpRatesProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModifyPmtpRatesProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyPmtpRatesProposal.Merge(m, src)
+}
+func (m *ModifyPmtpRatesProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModifyPmtpRatesProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyPmtpRatesProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyPmtpRatesProposal proto.InternalMessageInfo
+
+// AddRewardPeriodProposal replaces the reward periods like
+// MsgAddRewardPeriodRequest
+type AddRewardPeriodProposal struct {
+	Title         string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RewardPeriods []*RewardPeriod `protobuf:"bytes,3,rep,name=reward_periods,json=rewardPeriods,proto3" json:"reward_periods,omitempty"`
+}
+
+func (m *AddRewardPeriodProposal) Reset()         { *m = AddRewardPeriodProposal{} }
+func (m *AddRewardPeriodProposal) String() string { return proto.CompactTextString(m) }
+func (*AddRewardPeriodProposal) ProtoMessage()    {}
+func (*AddRewardPeriodProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{2}
+}
+func (m *AddRewardPeriodProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRewardPeriodProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRewardPeriodProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRewardPeriodProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRewardPeriodProposal.Merge(m, src)
+}
+func (m *AddRewardPeriodProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRewardPeriodProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRewardPeriodProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRewardPeriodProposal proto.InternalMessageInfo
+
+// UpdateRewardsParamsProposal sets the liquidity removal periods like
+// MsgUpdateRewardsParamsRequest and the module accounts allowed to fund reward
+// periods
+type UpdateRewardsParamsProposal struct {
+	Title                        string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description                  string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LiquidityRemovalLockPeriod   uint64   `protobuf:"varint,3,opt,name=liquidity_removal_lock_period,json=liquidityRemovalLockPeriod,proto3" json:"liquidity_removal_lock_period,omitempty"`
+	LiquidityRemovalCancelPeriod uint64   `protobuf:"varint,4,opt,name=liquidity_removal_cancel_period,json=liquidityRemovalCancelPeriod,proto3" json:"liquidity_removal_cancel_period,omitempty"`
+	RewardPeriodFundingModules   []string `protobuf:"bytes,5,rep,name=reward_period_funding_modules,json=rewardPeriodFundingModules,proto3" json:"reward_period_funding_modules,omitempty"`
+}
+
+func (m *UpdateRewardsParamsProposal) Reset()         { *m = UpdateRewardsParamsProposal{} }
+func (m *UpdateRewardsParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateRewardsParamsProposal) ProtoMessage()    {}
+func (*UpdateRewardsParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{3}
+}
+func (m *UpdateRewardsParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRewardsParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRewardsParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateRewardsParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRewardsParamsProposal.Merge(m, src)
+}
+func (m *UpdateRewardsParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRewardsParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRewardsParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRewardsParamsProposal proto.InternalMessageInfo
+
+// UpdateStakingRewardParamsProposal sets the mint params like
+// MsgUpdateStakingRewardParams
+type UpdateStakingRewardParamsProposal struct {
+	Title       string                                           `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                           `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Minter      github_com_cosmos_cosmos_sdk_x_mint_types.Minter `protobuf:"bytes,3,opt,name=minter,proto3,customtype=github.com/cosmos/cosmos-sdk/x/mint/types.Minter" json:"minter"`
+	Params      github_com_cosmos_cosmos_sdk_x_mint_types.Params `protobuf:"bytes,4,opt,name=params,proto3,customtype=github.com/cosmos/cosmos-sdk/x/mint/types.Params" json:"params"`
+}
+
+func (m *UpdateStakingRewardParamsProposal) Reset()         { *m = UpdateStakingRewardParamsProposal{} }
+func (m *UpdateStakingRewardParamsProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateStakingRewardParamsProposal) ProtoMessage()    {}
+func (*UpdateStakingRewardParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91ec28629c263e02, []int{4}
+}
+func (m *UpdateStakingRewardParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateStakingRewardParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateStakingRewardParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateStakingRewardParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStakingRewardParamsProposal.Merge(m, src)
+}
+func (m *UpdateStakingRewardParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateStakingRewardParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStakingRewardParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStakingRewardParamsProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*UpdatePmtpParamsProposal)(nil), "sifnode.clp.v1.UpdatePmtpParamsProposal")
+	proto.RegisterType((*ModifyPmtpRatesProposal)(nil), "sifnode.clp.v1.ModifyPmtpRatesProposal")
+	proto.RegisterType((*AddRewardPeriodProposal)(nil), "sifnode.clp.v1.AddRewardPeriodProposal")
+	proto.RegisterType((*UpdateRewardsParamsProposal)(nil), "sifnode.clp.v1.UpdateRewardsParamsProposal")
+	proto.RegisterType((*UpdateStakingRewardParamsProposal)(nil), "sifnode.clp.v1.UpdateStakingRewardParamsProposal")
+}
+
+func init() { proto.RegisterFile("sifnode/clp/v1/proposals.proto", fileDescriptor_91ec28629c263e02) }
+
+var fileDescriptor_91ec28629c263e02 = []byte{
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0xb6, 0x85, 0xfc, 0x3b, 0xfc, 0xe5, 0xb0, 0x81, 0xb0, 0x29, 0x74, 0x5b, 0xb8, 0xd8,
+	0x8b, 0xbb, 0xa2, 0x31, 0x1a, 0x13, 0x0f, 0x85, 0xa0, 0x17, 0x48, 0x9a, 0x25, 0x5e, 0xbc, 0x6c,
+	0x96, 0x99, 0xe9, 0x76, 0xd2, 0xdd, 0x99, 0x71, 0x66, 0x5a, 0xe9, 0x07, 0x30, 0xf1, 0xe8, 0x07,
+	0xf0, 0xe0, 0xd1, 0x8f, 0x60, 0xfc, 0x04, 0x1c, 0x39, 0x1a, 0x0f, 0xc4, 0xc0, 0x17, 0x31, 0xf3,
+	0x02, 0x2c, 0x78, 0x13, 0x4e, 0xed, 0xcc, 0xef, 0xe5, 0x79, 0xe6, 0xb7, 0x4f, 0x1e, 0x10, 0x4a,
+	0x32, 0xa2, 0x0c, 0xe1, 0x18, 0x16, 0x3c, 0x9e, 0x6d, 0xc7, 0x5c, 0x30, 0xce, 0x64, 0x56, 0xc8,
+	0x88, 0x0b, 0xa6, 0x98, 0xbf, 0xec, 0xf0, 0x08, 0x16, 0x3c, 0x9a, 0x6d, 0xb7, 0x57, 0x72, 0x96,
+	0x33, 0x03, 0xc5, 0xfa, 0x9f, 0x65, 0xb5, 0xd7, 0x6f, 0xbb, 0x64, 0x22, 0x2b, 0x9d, 0xc5, 0xd6,
+	0x8f, 0x3a, 0x08, 0xde, 0x72, 0x94, 0x29, 0x3c, 0x2c, 0x15, 0x1f, 0x1a, 0x68, 0xe8, 0xca, 0xf8,
+	0x2b, 0x60, 0x41, 0x11, 0x55, 0xe0, 0xc0, 0xeb, 0x79, 0xfd, 0x56, 0x62, 0x0f, 0x7e, 0x0f, 0x2c,
+	0x21, 0x2c, 0xa1, 0x20, 0x5c, 0x11, 0x46, 0x83, 0xba, 0xc1, 0xaa, 0x57, 0xfe, 0x2b, 0xb0, 0xce,
+	0x4b, 0xc5, 0x53, 0x8e, 0x05, 0x61, 0x28, 0xcd, 0xd9, 0x0c, 0x0b, 0x9a, 0x51, 0x88, 0x53, 0x91,
+	0x29, 0x1c, 0x34, 0x8c, 0x22, 0xd0, 0x94, 0xa1, 0x61, 0xbc, 0xb9, 0x22, 0x24, 0x99, 0xc2, 0xfe,
+	0x73, 0x10, 0x54, 0xe5, 0x98, 0x33, 0x38, 0x4e, 0x0b, 0x4c, 0x73, 0x35, 0x0e, 0x9a, 0x3d, 0xaf,
+	0xdf, 0x48, 0x56, 0xaf, 0xb5, 0x7b, 0x1a, 0xdd, 0x37, 0xa0, 0xff, 0x0c, 0xac, 0x55, 0x85, 0x52,
+	0x65, 0x42, 0xa5, 0x47, 0x05, 0x83, 0x93, 0x60, 0xc1, 0xe8, 0x56, 0xae, 0x75, 0x87, 0x1a, 0xdc,
+	0xd1, 0x98, 0xbf, 0x0d, 0x56, 0x6f, 0xd4, 0xa3, 0xc8, 0x89, 0x16, 0x8d, 0xc8, 0xaf, 0x14, 0xa3,
+	0xc8, 0x48, 0x5e, 0x36, 0x3f, 0x7d, 0xed, 0xd6, 0xb6, 0xbe, 0x7b, 0x60, 0xed, 0x80, 0x21, 0x32,
+	0x9a, 0xeb, 0xf0, 0x74, 0xef, 0x77, 0xcf, 0xae, 0x03, 0x80, 0x29, 0x5e, 0x8d, 0xaa, 0x65, 0x6e,
+	0x4c, 0x36, 0x9b, 0xe0, 0x7f, 0x31, 0xa5, 0x94, 0xd0, 0xdc, 0x12, 0x9a, 0xd6, 0xc1, 0xdd, 0x19,
+	0x4a, 0x07, 0x00, 0xfd, 0x04, 0xce, 0x0a, 0x02, 0xe7, 0xe6, 0xe1, 0xff, 0x25, 0x2d, 0x4c, 0xd1,
+	0xd0, 0x5c, 0xb8, 0xd6, 0xbf, 0x78, 0x60, 0x6d, 0x80, 0x50, 0x82, 0x3f, 0x64, 0x02, 0xd9, 0xc7,
+	0xdd, 0xb9, 0xf5, 0x5d, 0xb0, 0x2c, 0x8c, 0x9f, 0x4b, 0x52, 0x06, 0x8d, 0x5e, 0xa3, 0xbf, 0xf4,
+	0x64, 0x23, 0xba, 0x39, 0xa7, 0x51, 0xb5, 0x6a, 0xf2, 0x40, 0x54, 0x4e, 0xd2, 0xb5, 0xf7, 0xad,
+	0x0e, 0xd6, 0xed, 0x58, 0x5a, 0xae, 0xbc, 0xa7, 0xc9, 0x1c, 0x80, 0x4e, 0x41, 0xde, 0x4f, 0x09,
+	0x22, 0x6a, 0x9e, 0x0a, 0x5c, 0xb2, 0x59, 0x56, 0xa4, 0x26, 0x6e, 0xdb, 0xb2, 0x09, 0xbc, 0x99,
+	0xb4, 0xaf, 0x48, 0x89, 0xe5, 0xec, 0x33, 0x38, 0xb1, 0x1d, 0xfa, 0x7b, 0xa0, 0xfb, 0xb7, 0x05,
+	0xd4, 0xc3, 0x5b, 0x5c, 0x9a, 0x34, 0x8d, 0xc9, 0xc6, 0x6d, 0x93, 0x5d, 0x43, 0x72, 0x36, 0x03,
+	0xd0, 0xb9, 0x11, 0x56, 0x3a, 0x9a, 0x52, 0xa4, 0x3f, 0x6b, 0xc9, 0xd0, 0xb4, 0xc0, 0x32, 0x58,
+	0xe8, 0x35, 0xfa, 0xad, 0xa4, 0x5d, 0x4d, 0xe7, 0xb5, 0xa5, 0x1c, 0x58, 0x86, 0x8b, 0xea, 0x63,
+	0x1d, 0x6c, 0xda, 0xa8, 0x0e, 0x55, 0x36, 0xd1, 0x43, 0x60, 0x15, 0xf7, 0x13, 0xd8, 0x10, 0x2c,
+	0x96, 0x84, 0x2a, 0x2c, 0xec, 0x28, 0xee, 0xbc, 0x38, 0x39, 0xeb, 0xd6, 0x7e, 0x9d, 0x75, 0x1f,
+	0xe7, 0x44, 0x8d, 0xa7, 0x47, 0x11, 0x64, 0x65, 0x0c, 0x99, 0x2c, 0x99, 0x74, 0x3f, 0x8f, 0x24,
+	0x9a, 0xc4, 0xc7, 0xb1, 0x16, 0xc5, 0x6a, 0xce, 0xb1, 0x8c, 0x0e, 0x8c, 0x3e, 0x71, 0x3e, 0xda,
+	0xd1, 0x6e, 0xa0, 0xa0, 0xf9, 0xaf, 0x8e, 0xf6, 0x6d, 0x89, 0xf3, 0xb1, 0x39, 0xec, 0x0c, 0x4e,
+	0xce, 0x43, 0xef, 0xf4, 0x3c, 0xf4, 0x7e, 0x9f, 0x87, 0xde, 0xe7, 0x8b, 0xb0, 0x76, 0x7a, 0x11,
+	0xd6, 0x7e, 0x5e, 0x84, 0xb5, 0x77, 0x0f, 0x2b, 0xce, 0x87, 0x64, 0x04, 0xc7, 0x19, 0xa1, 0xf1,
+	0xe5, 0x52, 0x3c, 0x36, 0x6b, 0xd1, 0xf8, 0x1e, 0x2d, 0x9a, 0x9d, 0xf8, 0xf4, 0xcf, 0x00, 0x9b,
+	0xac, 0xf5, 0x20, 0x78, 0x05, 0x00, 0x00,
+}
+
+func (m *UpdatePmtpParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePmtpParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdatePmtpParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PmtpPeriodEndBlock != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.PmtpPeriodEndBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PmtpPeriodStartBlock != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.PmtpPeriodStartBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PmtpPeriodEpochLength != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.PmtpPeriodEpochLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PmtpPeriodGovernanceRate) > 0 {
+		i -= len(m.PmtpPeriodGovernanceRate)
+		copy(dAtA[i:], m.PmtpPeriodGovernanceRate)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.PmtpPeriodGovernanceRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModifyPmtpRatesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyPmtpRatesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyPmtpRatesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndPolicy {
+		i--
+		if m.EndPolicy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RunningRate) > 0 {
+		i -= len(m.RunningRate)
+		copy(dAtA[i:], m.RunningRate)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.RunningRate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlockRate) > 0 {
+		i -= len(m.BlockRate)
+		copy(dAtA[i:], m.BlockRate)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.BlockRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddRewardPeriodProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddRewardPeriodProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddRewardPeriodProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposals(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRewardsParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRewardsParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRewardsParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriodFundingModules) > 0 {
+		for iNdEx := len(m.RewardPeriodFundingModules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardPeriodFundingModules[iNdEx])
+			copy(dAtA[i:], m.RewardPeriodFundingModules[iNdEx])
+			i = encodeVarintProposals(dAtA, i, uint64(len(m.RewardPeriodFundingModules[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LiquidityRemovalCancelPeriod != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.LiquidityRemovalCancelPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LiquidityRemovalLockPeriod != 0 {
+		i = encodeVarintProposals(dAtA, i, uint64(m.LiquidityRemovalLockPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateStakingRewardParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateStakingRewardParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateStakingRewardParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Params.Size()
+		i -= size
+		if _, err := m.Params.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Minter.Size()
+		i -= size
+		if _, err := m.Minter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProposals(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposals(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdatePmtpParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.PmtpPeriodGovernanceRate)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.PmtpPeriodEpochLength != 0 {
+		n += 1 + sovProposals(uint64(m.PmtpPeriodEpochLength))
+	}
+	if m.PmtpPeriodStartBlock != 0 {
+		n += 1 + sovProposals(uint64(m.PmtpPeriodStartBlock))
+	}
+	if m.PmtpPeriodEndBlock != 0 {
+		n += 1 + sovProposals(uint64(m.PmtpPeriodEndBlock))
+	}
+	return n
+}
+
+func (m *ModifyPmtpRatesProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.BlockRate)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.RunningRate)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.EndPolicy {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddRewardPeriodProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if len(m.RewardPeriods) > 0 {
+		for _, e := range m.RewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateRewardsParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	if m.LiquidityRemovalLockPeriod != 0 {
+		n += 1 + sovProposals(uint64(m.LiquidityRemovalLockPeriod))
+	}
+	if m.LiquidityRemovalCancelPeriod != 0 {
+		n += 1 + sovProposals(uint64(m.LiquidityRemovalCancelPeriod))
+	}
+	if len(m.RewardPeriodFundingModules) > 0 {
+		for _, s := range m.RewardPeriodFundingModules {
+			l = len(s)
+			n += 1 + l + sovProposals(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateStakingRewardParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = m.Minter.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovProposals(uint64(l))
+	return n
+}
+
+func sovProposals(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposals(x uint64) (n int) {
+	return sovProposals(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdatePmtpParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdatePmtpParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdatePmtpParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodGovernanceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPeriodGovernanceRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEpochLength", wireType)
+			}
+			m.PmtpPeriodEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+			}
+			m.PmtpPeriodStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodStartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			}
+			m.PmtpPeriodEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModifyPmtpRatesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModifyPmtpRatesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModifyPmtpRatesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndPolicy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRewardPeriodProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRewardPeriodProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRewardPeriodProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriods = append(m.RewardPeriods, &RewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRewardsParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRewardsParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRewardsParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalLockPeriod", wireType)
+			}
+			m.LiquidityRemovalLockPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityRemovalLockPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalCancelPeriod", wireType)
+			}
+			m.LiquidityRemovalCancelPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityRemovalCancelPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodFundingModules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodFundingModules = append(m.RewardPeriodFundingModules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateStakingRewardParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateStakingRewardParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateStakingRewardParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposals(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposals
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposals
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposals
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposals        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposals          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposals = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePmtpParamsProposal_ValidateBasic(t *testing.T) {
+	proposal := NewUpdatePmtpParamsProposal("title", "description", "0.1", 10, 11, 30)
+	assert.NoError(t, proposal.ValidateBasic())
+	assert.Equal(t, RouterKey, proposal.ProposalRoute())
+	assert.Equal(t, ProposalTypeUpdatePmtpParams, proposal.ProposalType())
+	proposal = NewUpdatePmtpParamsProposal("", "description", "0.1", 10, 11, 30)
+	assert.Error(t, proposal.ValidateBasic())
+	proposal = NewUpdatePmtpParamsProposal("title", "description", "abc", 10, 11, 30)
+	assert.Error(t, proposal.ValidateBasic())
+	proposal = NewUpdatePmtpParamsProposal("title", "description", "", 10, 11, 25)
+	assert.Error(t, proposal.ValidateBasic())
+}
+
+func TestModifyPmtpRatesProposal_ValidateBasic(t *testing.T) {
+	proposal := NewModifyPmtpRatesProposal("title", "description", "", "0.5", true)
+	assert.NoError(t, proposal.ValidateBasic())
+	proposal = NewModifyPmtpRatesProposal("title", "description", "abc", "", false)
+	assert.Error(t, proposal.ValidateBasic())
+}
+
+func TestAddRewardPeriodProposal_ValidateBasic(t *testing.T) {
+	proposal := NewAddRewardPeriodProposal("title", "description", []*RewardPeriod{{RewardPeriodId: "RP1", RewardPeriodStartBlock: 10, RewardPeriodEndBlock: 5}})
+	assert.Error(t, proposal.ValidateBasic())
+	proposal = NewAddRewardPeriodProposal("title", "description", nil)
+	assert.NoError(t, proposal.ValidateBasic())
+}
+
+func TestUpdateRewardsParamsProposal_ValidateBasic(t *testing.T) {
+	proposal := NewUpdateRewardsParamsProposal("title", "description", 5, 7, []string{"reserve"})
+	assert.NoError(t, proposal.ValidateBasic())
+	proposal = NewUpdateRewardsParamsProposal("title", "description", 5, 7, []string{ModuleName})
+	assert.ErrorIs(t, proposal.ValidateBasic(), ErrFundingModuleNotAllowed)
+	proposal = NewUpdateRewardsParamsProposal("title", "description", 5, 7, []string{"reserve", "reserve"})
+	assert.Error(t, proposal.ValidateBasic())
+}
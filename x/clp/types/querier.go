@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	QueryPool                  = "pool"
 	QueryPools                 = "allpools"
@@ -11,8 +13,47 @@ const (
 	QueryParams                = "params"
 	QueryRewardParams          = "rewardParams"
 	QueryPmtpParams            = "pmtpParams"
+	QuerySwapQuote             = "swapQuote"
+	QueryLimitOrdersByOwner    = "limitOrdersByOwner"
+	QueryLimitOrdersByPool     = "limitOrdersByPool"
+	QueryTwap                  = "twap"
+	QueryPoolFees              = "poolFees"
+	QueryCircuitBreakerParams  = "circuitBreakerParams"
+	QueryPmtpPolicies          = "pmtpPolicies"
+	QuerySimulatePmtpPolicy    = "simulatePmtpPolicy"
+	QueryLPRewards             = "lpRewards"
+	QueryRewardDistributions   = "rewardPeriodDistributions"
+	QueryRewardAllocations     = "rewardPeriodAllocations"
+	QueryPoolHistory           = "poolHistory"
+	QueryPoolStats             = "poolStats"
+	QuerySimulateSingleSided   = "simulateAddLiquiditySingleSided"
+	QueryPendingPools          = "pendingPools"
 )
 
 func NewQueryReqGetPool(symbol string) PoolReq {
 	return PoolReq{Symbol: symbol}
 }
+
+func NewQueryReqSwapQuote(sentAsset, receivedAsset, sentAmount string) SwapQuoteReq {
+	return SwapQuoteReq{SentAsset: sentAsset, ReceivedAsset: receivedAsset, SentAmount: sentAmount}
+}
+
+func NewQueryReqTwap(symbol string, startHeight, startTime int64) TwapReq {
+	return TwapReq{Symbol: symbol, StartHeight: startHeight, StartTime: startTime}
+}
+
+func NewQueryReqPoolFees(symbol string) PoolFeesReq {
+	return PoolFeesReq{Symbol: symbol}
+}
+
+func NewQueryReqSimulatePmtpPolicy(governanceRate sdk.Dec, epochLength, startBlock, endBlock int64, heights []int64) SimulatePmtpPolicyReq {
+	return SimulatePmtpPolicyReq{
+		Params: PmtpParams{
+			PmtpPeriodGovernanceRate: governanceRate,
+			PmtpPeriodEpochLength:    epochLength,
+			PmtpPeriodStartBlock:     startBlock,
+			PmtpPeriodEndBlock:       endBlock,
+		},
+		Heights: heights,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_x_mint_types "github.com/cosmos/cosmos-sdk/x/mint/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -117,6 +118,13 @@ type MsgRemoveLiquidity struct {
 	ExternalAsset *Asset                                 `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 	WBasisPoints  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=w_basis_points,json=wBasisPoints,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"w_basis_points" yaml:"w_basis_points"`
 	Asymmetry     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=asymmetry,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"asymmetry" yaml:"asymmetry"`
+	// min_native_out and min_external_out are the least amounts the signer
+	// accepts to receive, unset or zero for no minimum
+	MinNativeOut   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_native_out,json=minNativeOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_native_out" yaml:"min_native_out"`
+	MinExternalOut github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=min_external_out,json=minExternalOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_external_out" yaml:"min_external_out"`
+	// deadline_height is the last height the message can be executed at, 0
+	// for no deadline
+	DeadlineHeight int64 `protobuf:"varint,7,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
 }
 
 func (m *MsgRemoveLiquidity) Reset()         { *m = MsgRemoveLiquidity{} }
@@ -166,6 +174,13 @@ func (m *MsgRemoveLiquidity) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *MsgRemoveLiquidity) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 type MsgRemoveLiquidityResponse struct {
 }
 
@@ -206,6 +221,13 @@ type MsgRemoveLiquidityUnits struct {
 	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	ExternalAsset *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 	WithdrawUnits github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=withdraw_units,json=withdrawUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"withdraw_units" yaml:"withdraw_units"`
+	// min_native_out and min_external_out are the least amounts the signer
+	// accepts to receive, unset or zero for no minimum
+	MinNativeOut   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=min_native_out,json=minNativeOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_native_out" yaml:"min_native_out"`
+	MinExternalOut github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_external_out,json=minExternalOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_external_out" yaml:"min_external_out"`
+	// deadline_height is the last height the message can be executed at, 0
+	// for no deadline
+	DeadlineHeight int64 `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
 }
 
 func (m *MsgRemoveLiquidityUnits) Reset()         { *m = MsgRemoveLiquidityUnits{} }
@@ -255,6 +277,13 @@ func (m *MsgRemoveLiquidityUnits) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *MsgRemoveLiquidityUnits) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 type MsgRemoveLiquidityUnitsResponse struct {
 }
 
@@ -291,6 +320,103 @@ func (m *MsgRemoveLiquidityUnitsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveLiquidityUnitsResponse proto.InternalMessageInfo
 
+type MsgTransferLiquidityPosition struct {
+	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Recipient     string                                  `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	ExternalAsset *Asset                                  `protobuf:"bytes,3,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	Units         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units" yaml:"units"`
+}
+
+func (m *MsgTransferLiquidityPosition) Reset()         { *m = MsgTransferLiquidityPosition{} }
+func (m *MsgTransferLiquidityPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLiquidityPosition) ProtoMessage()    {}
+func (*MsgTransferLiquidityPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{6}
+}
+func (m *MsgTransferLiquidityPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLiquidityPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLiquidityPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLiquidityPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLiquidityPosition.Merge(m, src)
+}
+func (m *MsgTransferLiquidityPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLiquidityPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLiquidityPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLiquidityPosition proto.InternalMessageInfo
+
+func (m *MsgTransferLiquidityPosition) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgTransferLiquidityPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgTransferLiquidityPosition) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
+}
+
+type MsgTransferLiquidityPositionResponse struct {
+}
+
+func (m *MsgTransferLiquidityPositionResponse) Reset()         { *m = MsgTransferLiquidityPositionResponse{} }
+func (m *MsgTransferLiquidityPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLiquidityPositionResponse) ProtoMessage()    {}
+func (*MsgTransferLiquidityPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{7}
+}
+func (m *MsgTransferLiquidityPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLiquidityPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLiquidityPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLiquidityPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLiquidityPositionResponse.Merge(m, src)
+}
+func (m *MsgTransferLiquidityPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLiquidityPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLiquidityPositionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLiquidityPositionResponse proto.InternalMessageInfo
+
 type MsgCreatePool struct {
 	Signer              string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	ExternalAsset       *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
@@ -302,7 +428,7 @@ func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
 func (m *MsgCreatePool) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePool) ProtoMessage()    {}
 func (*MsgCreatePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{6}
+	return fileDescriptor_a3bff5b30808c4f3, []int{8}
 }
 func (m *MsgCreatePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +478,7 @@ func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
 func (m *MsgCreatePoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePoolResponse) ProtoMessage()    {}
 func (*MsgCreatePoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{7}
+	return fileDescriptor_a3bff5b30808c4f3, []int{9}
 }
 func (m *MsgCreatePoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,13 +512,19 @@ type MsgAddLiquidity struct {
 	ExternalAsset       *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
 	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+	// min_pool_units is the least liquidity units the signer accepts to
+	// receive, unset or zero for no minimum
+	MinPoolUnits github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_pool_units,json=minPoolUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_pool_units" yaml:"min_pool_units"`
+	// deadline_height is the last height the message can be executed at, 0
+	// for no deadline
+	DeadlineHeight int64 `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
 }
 
 func (m *MsgAddLiquidity) Reset()         { *m = MsgAddLiquidity{} }
 func (m *MsgAddLiquidity) String() string { return proto.CompactTextString(m) }
 func (*MsgAddLiquidity) ProtoMessage()    {}
 func (*MsgAddLiquidity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{8}
+	return fileDescriptor_a3bff5b30808c4f3, []int{10}
 }
 func (m *MsgAddLiquidity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -435,6 +567,13 @@ func (m *MsgAddLiquidity) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *MsgAddLiquidity) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 type MsgAddLiquidityResponse struct {
 }
 
@@ -442,7 +581,7 @@ func (m *MsgAddLiquidityResponse) Reset()         { *m = MsgAddLiquidityResponse
 func (m *MsgAddLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddLiquidityResponse) ProtoMessage()    {}
 func (*MsgAddLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{9}
+	return fileDescriptor_a3bff5b30808c4f3, []int{11}
 }
 func (m *MsgAddLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +621,7 @@ func (m *MsgModifyPmtpRates) Reset()         { *m = MsgModifyPmtpRates{} }
 func (m *MsgModifyPmtpRates) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyPmtpRates) ProtoMessage()    {}
 func (*MsgModifyPmtpRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{10}
+	return fileDescriptor_a3bff5b30808c4f3, []int{12}
 }
 func (m *MsgModifyPmtpRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +685,7 @@ func (m *MsgModifyPmtpRatesResponse) Reset()         { *m = MsgModifyPmtpRatesRe
 func (m *MsgModifyPmtpRatesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyPmtpRatesResponse) ProtoMessage()    {}
 func (*MsgModifyPmtpRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{11}
+	return fileDescriptor_a3bff5b30808c4f3, []int{13}
 }
 func (m *MsgModifyPmtpRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,7 +726,7 @@ func (m *MsgUpdatePmtpParams) Reset()         { *m = MsgUpdatePmtpParams{} }
 func (m *MsgUpdatePmtpParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePmtpParams) ProtoMessage()    {}
 func (*MsgUpdatePmtpParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{12}
+	return fileDescriptor_a3bff5b30808c4f3, []int{14}
 }
 func (m *MsgUpdatePmtpParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,13 +791,14 @@ func (m *MsgUpdatePmtpParams) GetPmtpPeriodEndBlock() int64 {
 }
 
 type MsgUpdatePmtpParamsResponse struct {
+	PolicyId uint64 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 }
 
 func (m *MsgUpdatePmtpParamsResponse) Reset()         { *m = MsgUpdatePmtpParamsResponse{} }
 func (m *MsgUpdatePmtpParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePmtpParamsResponse) ProtoMessage()    {}
 func (*MsgUpdatePmtpParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{13}
+	return fileDescriptor_a3bff5b30808c4f3, []int{15}
 }
 func (m *MsgUpdatePmtpParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,19 +827,29 @@ func (m *MsgUpdatePmtpParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdatePmtpParamsResponse proto.InternalMessageInfo
 
+func (m *MsgUpdatePmtpParamsResponse) GetPolicyId() uint64 {
+	if m != nil {
+		return m.PolicyId
+	}
+	return 0
+}
+
 type MsgSwap struct {
 	Signer             string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	SentAsset          *Asset                                  `protobuf:"bytes,2,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty" yaml:"sent_asset"`
 	ReceivedAsset      *Asset                                  `protobuf:"bytes,3,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty" yaml:"received_asset"`
 	SentAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
 	MinReceivingAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_receiving_amount,json=minReceivingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_receiving_amount" yaml:"min_receiving_amount"`
+	// deadline_height is the last height the message can be executed at, 0
+	// for no deadline
+	DeadlineHeight int64 `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
 func (m *MsgSwap) String() string { return proto.CompactTextString(m) }
 func (*MsgSwap) ProtoMessage()    {}
 func (*MsgSwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{14}
+	return fileDescriptor_a3bff5b30808c4f3, []int{16}
 }
 func (m *MsgSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -749,14 +899,24 @@ func (m *MsgSwap) GetReceivedAsset() *Asset {
 	return nil
 }
 
+func (m *MsgSwap) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 type MsgSwapResponse struct {
+	// queued_swap_id is the id of the queued swap when the pool is in batch
+	// swap mode, the swap is then cleared at the end of the block
+	QueuedSwapId uint64 `protobuf:"varint,1,opt,name=queued_swap_id,json=queuedSwapId,proto3" json:"queued_swap_id,omitempty"`
 }
 
 func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
 func (m *MsgSwapResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapResponse) ProtoMessage()    {}
 func (*MsgSwapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{15}
+	return fileDescriptor_a3bff5b30808c4f3, []int{17}
 }
 func (m *MsgSwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,16 +945,125 @@ func (m *MsgSwapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapResponse proto.InternalMessageInfo
 
+func (m *MsgSwapResponse) GetQueuedSwapId() uint64 {
+	if m != nil {
+		return m.QueuedSwapId
+	}
+	return 0
+}
+
+// MsgSwapRoute swaps sent_amount of the first asset in assets into the last
+// one, hopping through every pool in between. Every consecutive pair of
+// assets must have rowan on exactly one side.
+type MsgSwapRoute struct {
+	Signer             string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Assets             []*Asset                                `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty" yaml:"assets"`
+	SentAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	MinReceivingAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=min_receiving_amount,json=minReceivingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_receiving_amount" yaml:"min_receiving_amount"`
+}
+
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{18}
+}
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+func (m *MsgSwapRoute) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSwapRoute) GetAssets() []*Asset {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+type MsgSwapRouteResponse struct {
+}
+
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{19}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
 type MsgDecommissionPool struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	// grace_period is the number of blocks liquidity providers can still
+	// withdraw before the pool is settled, a clp admin can wind down pools of
+	// any size or reschedule the settlement of a winding down pool while zero
+	// decommissions pools below the threshold right away
+	GracePeriod int64 `protobuf:"varint,3,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty" yaml:"grace_period"`
+	// payout_symbol optionally is the single asset liquidity providers of a pool
+	// winding down are paid in, rowan or the external asset of the pool, both
+	// assets are paid when empty
+	PayoutSymbol string `protobuf:"bytes,4,opt,name=payout_symbol,json=payoutSymbol,proto3" json:"payout_symbol,omitempty" yaml:"payout_symbol"`
 }
 
 func (m *MsgDecommissionPool) Reset()         { *m = MsgDecommissionPool{} }
 func (m *MsgDecommissionPool) String() string { return proto.CompactTextString(m) }
 func (*MsgDecommissionPool) ProtoMessage()    {}
 func (*MsgDecommissionPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{16}
+	return fileDescriptor_a3bff5b30808c4f3, []int{20}
 }
 func (m *MsgDecommissionPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,6 +1106,20 @@ func (m *MsgDecommissionPool) GetSymbol() string {
 	return ""
 }
 
+func (m *MsgDecommissionPool) GetGracePeriod() int64 {
+	if m != nil {
+		return m.GracePeriod
+	}
+	return 0
+}
+
+func (m *MsgDecommissionPool) GetPayoutSymbol() string {
+	if m != nil {
+		return m.PayoutSymbol
+	}
+	return ""
+}
+
 type MsgDecommissionPoolResponse struct {
 }
 
@@ -844,7 +1127,7 @@ func (m *MsgDecommissionPoolResponse) Reset()         { *m = MsgDecommissionPool
 func (m *MsgDecommissionPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDecommissionPoolResponse) ProtoMessage()    {}
 func (*MsgDecommissionPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{17}
+	return fileDescriptor_a3bff5b30808c4f3, []int{21}
 }
 func (m *MsgDecommissionPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1166,7 @@ func (m *MsgUnlockLiquidityRequest) Reset()         { *m = MsgUnlockLiquidityReq
 func (m *MsgUnlockLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockLiquidityRequest) ProtoMessage()    {}
 func (*MsgUnlockLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{18}
+	return fileDescriptor_a3bff5b30808c4f3, []int{22}
 }
 func (m *MsgUnlockLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1216,7 @@ func (m *MsgUnlockLiquidityResponse) Reset()         { *m = MsgUnlockLiquidityRe
 func (m *MsgUnlockLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockLiquidityResponse) ProtoMessage()    {}
 func (*MsgUnlockLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{19}
+	return fileDescriptor_a3bff5b30808c4f3, []int{23}
 }
 func (m *MsgUnlockLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1255,7 @@ func (m *MsgUpdateRewardsParamsRequest) Reset()         { *m = MsgUpdateRewardsP
 func (m *MsgUpdateRewardsParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardsParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateRewardsParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{20}
+	return fileDescriptor_a3bff5b30808c4f3, []int{24}
 }
 func (m *MsgUpdateRewardsParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1312,7 @@ func (m *MsgUpdateRewardsParamsResponse) Reset()         { *m = MsgUpdateRewards
 func (m *MsgUpdateRewardsParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardsParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateRewardsParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{21}
+	return fileDescriptor_a3bff5b30808c4f3, []int{25}
 }
 func (m *MsgUpdateRewardsParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1350,7 @@ func (m *MsgAddRewardPeriodRequest) Reset()         { *m = MsgAddRewardPeriodReq
 func (m *MsgAddRewardPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRewardPeriodRequest) ProtoMessage()    {}
 func (*MsgAddRewardPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{22}
+	return fileDescriptor_a3bff5b30808c4f3, []int{26}
 }
 func (m *MsgAddRewardPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1400,7 @@ func (m *MsgAddRewardPeriodResponse) Reset()         { *m = MsgAddRewardPeriodRe
 func (m *MsgAddRewardPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRewardPeriodResponse) ProtoMessage()    {}
 func (*MsgAddRewardPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{23}
+	return fileDescriptor_a3bff5b30808c4f3, []int{27}
 }
 func (m *MsgAddRewardPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,1890 +1429,10627 @@ func (m *MsgAddRewardPeriodResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddRewardPeriodResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateStakingRewardParams)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParams")
-	proto.RegisterType((*MsgUpdateStakingRewardParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParamsResponse")
-	proto.RegisterType((*MsgRemoveLiquidity)(nil), "sifnode.clp.v1.MsgRemoveLiquidity")
-	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "sifnode.clp.v1.MsgRemoveLiquidityResponse")
-	proto.RegisterType((*MsgRemoveLiquidityUnits)(nil), "sifnode.clp.v1.MsgRemoveLiquidityUnits")
-	proto.RegisterType((*MsgRemoveLiquidityUnitsResponse)(nil), "sifnode.clp.v1.MsgRemoveLiquidityUnitsResponse")
-	proto.RegisterType((*MsgCreatePool)(nil), "sifnode.clp.v1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "sifnode.clp.v1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgAddLiquidity)(nil), "sifnode.clp.v1.MsgAddLiquidity")
-	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "sifnode.clp.v1.MsgAddLiquidityResponse")
-	proto.RegisterType((*MsgModifyPmtpRates)(nil), "sifnode.clp.v1.MsgModifyPmtpRates")
-	proto.RegisterType((*MsgModifyPmtpRatesResponse)(nil), "sifnode.clp.v1.MsgModifyPmtpRatesResponse")
-	proto.RegisterType((*MsgUpdatePmtpParams)(nil), "sifnode.clp.v1.MsgUpdatePmtpParams")
-	proto.RegisterType((*MsgUpdatePmtpParamsResponse)(nil), "sifnode.clp.v1.MsgUpdatePmtpParamsResponse")
-	proto.RegisterType((*MsgSwap)(nil), "sifnode.clp.v1.MsgSwap")
-	proto.RegisterType((*MsgSwapResponse)(nil), "sifnode.clp.v1.MsgSwapResponse")
-	proto.RegisterType((*MsgDecommissionPool)(nil), "sifnode.clp.v1.MsgDecommissionPool")
-	proto.RegisterType((*MsgDecommissionPoolResponse)(nil), "sifnode.clp.v1.MsgDecommissionPoolResponse")
-	proto.RegisterType((*MsgUnlockLiquidityRequest)(nil), "sifnode.clp.v1.MsgUnlockLiquidityRequest")
-	proto.RegisterType((*MsgUnlockLiquidityResponse)(nil), "sifnode.clp.v1.MsgUnlockLiquidityResponse")
-	proto.RegisterType((*MsgUpdateRewardsParamsRequest)(nil), "sifnode.clp.v1.MsgUpdateRewardsParamsRequest")
-	proto.RegisterType((*MsgUpdateRewardsParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateRewardsParamsResponse")
-	proto.RegisterType((*MsgAddRewardPeriodRequest)(nil), "sifnode.clp.v1.MsgAddRewardPeriodRequest")
-	proto.RegisterType((*MsgAddRewardPeriodResponse)(nil), "sifnode.clp.v1.MsgAddRewardPeriodResponse")
+type MsgAppendRewardPeriod struct {
+	Signer       string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RewardPeriod *RewardPeriod `protobuf:"bytes,2,opt,name=reward_period,json=rewardPeriod,proto3" json:"reward_period,omitempty"`
 }
 
-func init() { proto.RegisterFile("sifnode/clp/v1/tx.proto", fileDescriptor_a3bff5b30808c4f3) }
+func (m *MsgAppendRewardPeriod) Reset()         { *m = MsgAppendRewardPeriod{} }
+func (m *MsgAppendRewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendRewardPeriod) ProtoMessage()    {}
+func (*MsgAppendRewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{28}
+}
+func (m *MsgAppendRewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendRewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendRewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAppendRewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendRewardPeriod.Merge(m, src)
+}
+func (m *MsgAppendRewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendRewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendRewardPeriod.DiscardUnknown(m)
+}
 
-var fileDescriptor_a3bff5b30808c4f3 = []byte{
-	// 1337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0xcf, 0x66, 0xdb, 0x7e, 0xbf, 0x79, 0x69, 0xd2, 0xc6, 0xc9, 0x2a, 0x1b, 0x27, 0xd9, 0x6d,
-	0x5d, 0xe8, 0x0f, 0xa0, 0xbb, 0xb4, 0x80, 0x40, 0x48, 0x48, 0x24, 0x25, 0x2a, 0x88, 0x2e, 0xac,
-	0x1c, 0x55, 0x45, 0x5c, 0x8c, 0xb3, 0x9e, 0x38, 0xa3, 0xd8, 0x33, 0xae, 0x67, 0xf2, 0x63, 0x0f,
-	0x08, 0x24, 0x8e, 0x48, 0x88, 0x23, 0xe2, 0x84, 0xf8, 0x5b, 0x40, 0xea, 0xb1, 0x07, 0x0e, 0x88,
-	0x43, 0x84, 0x5a, 0x09, 0x89, 0x03, 0x97, 0xfe, 0x05, 0x68, 0x7e, 0xd8, 0xeb, 0xf5, 0x7a, 0x9b,
-	0xb8, 0xa7, 0x1c, 0x38, 0xed, 0x7a, 0xde, 0xe7, 0x7d, 0xde, 0xaf, 0x79, 0x6f, 0xc6, 0x86, 0x45,
-	0x86, 0xb7, 0x09, 0xf5, 0x50, 0xbb, 0x17, 0x44, 0xed, 0xfd, 0x5b, 0x6d, 0x7e, 0xd8, 0x8a, 0x62,
-	0xca, 0xa9, 0x31, 0xab, 0x05, 0xad, 0x5e, 0x10, 0xb5, 0xf6, 0x6f, 0x99, 0x0b, 0x3e, 0xf5, 0xa9,
-	0x14, 0xb5, 0xc5, 0x3f, 0x85, 0x32, 0xcd, 0xbc, 0x7a, 0x3f, 0x42, 0x4c, 0xcb, 0x96, 0x73, 0xb2,
-	0xc8, 0x8d, 0xdd, 0x50, 0x0b, 0xad, 0x7f, 0x2a, 0xb0, 0xd2, 0x61, 0xfe, 0xfd, 0xc8, 0x73, 0x39,
-	0xda, 0xe4, 0xee, 0x2e, 0x26, 0xbe, 0x8d, 0x0e, 0xdc, 0xd8, 0xeb, 0x4a, 0x98, 0x71, 0x03, 0xce,
-	0x31, 0xec, 0x13, 0x14, 0xd7, 0x2b, 0x97, 0x2a, 0xd7, 0xa7, 0xd6, 0xe7, 0x9e, 0x1d, 0x35, 0x67,
-	0xfa, 0x6e, 0x18, 0xbc, 0x6b, 0xa9, 0x75, 0xcb, 0xd6, 0x00, 0xa3, 0x0b, 0xe7, 0x42, 0x4c, 0x38,
-	0x8a, 0xeb, 0x93, 0x12, 0xfa, 0xce, 0xa3, 0xa3, 0xe6, 0xc4, 0x1f, 0x47, 0xcd, 0xd7, 0x7d, 0xcc,
-	0x77, 0xf6, 0xb6, 0x5a, 0x3d, 0x1a, 0xb6, 0x7b, 0x94, 0x85, 0x94, 0xe9, 0x9f, 0x9b, 0xcc, 0xdb,
-	0x6d, 0x1f, 0xb6, 0x85, 0x92, 0xf6, 0xb8, 0x23, 0xf5, 0x6d, 0xcd, 0x23, 0x18, 0x95, 0xb7, 0xf5,
-	0xea, 0x8b, 0x32, 0xaa, 0x30, 0x6c, 0xcd, 0x63, 0x5d, 0x85, 0x97, 0x9e, 0x17, 0xae, 0x8d, 0x58,
-	0x44, 0x09, 0x43, 0xd6, 0xdf, 0x93, 0x60, 0x74, 0x98, 0x6f, 0xa3, 0x90, 0xee, 0xa3, 0x7b, 0xf8,
-	0xe1, 0x1e, 0xf6, 0x30, 0xef, 0x97, 0xc9, 0xc6, 0x03, 0x98, 0x45, 0x87, 0x1c, 0xc5, 0xc4, 0x0d,
-	0x1c, 0x97, 0x31, 0xc4, 0x65, 0x56, 0xa6, 0x6f, 0xd7, 0x5a, 0xc3, 0x15, 0x6d, 0xad, 0x09, 0xe1,
-	0xfa, 0xd2, 0xb3, 0xa3, 0x66, 0x4d, 0x31, 0x0d, 0xab, 0x59, 0xf6, 0x4c, 0xb2, 0x20, 0x91, 0x46,
-	0x08, 0xb3, 0x07, 0xce, 0x96, 0xcb, 0x30, 0x73, 0x22, 0x8a, 0x09, 0x4f, 0x92, 0x73, 0x57, 0x27,
-	0xe7, 0xea, 0x73, 0x93, 0xa3, 0xb2, 0xf2, 0x11, 0xe1, 0x03, 0x7b, 0xc3, 0x6c, 0x96, 0x7d, 0xfe,
-	0x60, 0x5d, 0x3c, 0x77, 0xe5, 0xa3, 0xf1, 0x05, 0x4c, 0xb9, 0xac, 0x1f, 0x86, 0x88, 0xc7, 0xfd,
-	0xfa, 0x19, 0x69, 0x69, 0xbd, 0xb4, 0xa5, 0x8b, 0xca, 0x52, 0x4a, 0x64, 0xd9, 0x03, 0x52, 0x6b,
-	0x05, 0xcc, 0xd1, 0x54, 0xa7, 0x95, 0xf8, 0x6e, 0x12, 0x16, 0x47, 0xc5, 0xf7, 0x09, 0xe6, 0xec,
-	0x54, 0x94, 0x83, 0xc2, 0xec, 0x01, 0xe6, 0x3b, 0x5e, 0xec, 0x1e, 0x38, 0x7b, 0xc2, 0x2b, 0x5d,
-	0x8e, 0x0f, 0x75, 0x92, 0xae, 0x9d, 0x20, 0x49, 0xf7, 0xf1, 0x50, 0x3d, 0x86, 0xe8, 0x2c, 0x7b,
-	0x26, 0x59, 0x90, 0x41, 0x5b, 0x97, 0xa1, 0x39, 0x26, 0x1f, 0x69, 0xce, 0x7e, 0xa8, 0xc2, 0x4c,
-	0x87, 0xf9, 0x77, 0x62, 0xe4, 0x72, 0xd4, 0xa5, 0x34, 0x38, 0x15, 0x99, 0xfa, 0x12, 0xe6, 0x89,
-	0xcb, 0xf1, 0x3e, 0x52, 0x72, 0xc7, 0x0d, 0xe9, 0x1e, 0xe1, 0x3a, 0x5d, 0x9d, 0xf2, 0xe9, 0x32,
-	0x95, 0xd5, 0x02, 0x4e, 0xcb, 0x9e, 0x53, 0xab, 0xd2, 0xf0, 0x9a, 0x5c, 0x33, 0xbe, 0xa9, 0x40,
-	0x6d, 0xd8, 0xc3, 0xc4, 0x03, 0xb5, 0xab, 0x3f, 0x2d, 0xef, 0xc1, 0x4a, 0x51, 0xdc, 0xa9, 0x0f,
-	0xf3, 0x43, 0xe1, 0x2b, 0x2f, 0xac, 0x45, 0xa8, 0x0d, 0x55, 0x26, 0xad, 0xd9, 0x8f, 0x55, 0xb8,
-	0xd0, 0x61, 0xfe, 0x9a, 0xe7, 0x9d, 0xae, 0x71, 0xf3, 0x5f, 0xd5, 0x08, 0xb7, 0x96, 0xe4, 0x0c,
-	0xca, 0xd6, 0x26, 0xad, 0xdb, 0x4f, 0x15, 0x79, 0x52, 0x74, 0xa8, 0x87, 0xb7, 0xfb, 0xdd, 0x90,
-	0x47, 0xb6, 0xcb, 0x51, 0xa9, 0xd1, 0xb4, 0x0a, 0xb0, 0x15, 0xd0, 0xde, 0xae, 0x13, 0xbb, 0x1c,
-	0xa9, 0xb3, 0xd3, 0x9e, 0x92, 0x2b, 0x82, 0xca, 0xb8, 0x0c, 0xe7, 0xe3, 0x3d, 0x42, 0x30, 0xf1,
-	0x15, 0x40, 0x66, 0xde, 0x9e, 0xd6, 0x6b, 0x12, 0xb2, 0x0a, 0x80, 0x88, 0xe7, 0x44, 0x34, 0xc0,
-	0x3d, 0x35, 0xa4, 0xff, 0x6f, 0x4f, 0x21, 0xe2, 0x75, 0xe5, 0x82, 0x1e, 0xb0, 0x39, 0x0f, 0xd3,
-	0x00, 0x7e, 0x9e, 0x84, 0xf9, 0xf4, 0x4c, 0x14, 0xe2, 0xf2, 0x27, 0xff, 0x7b, 0xb0, 0x1c, 0x85,
-	0x3c, 0x72, 0x22, 0x14, 0x63, 0xea, 0x39, 0x3e, 0xdd, 0x17, 0x19, 0x24, 0x3d, 0x94, 0x0d, 0xa9,
-	0x2e, 0x20, 0x5d, 0x89, 0xb8, 0x9b, 0x02, 0xa4, 0xfb, 0x6f, 0x43, 0x3d, 0xab, 0x8e, 0x22, 0xda,
-	0xdb, 0x71, 0x02, 0x44, 0x7c, 0xbe, 0x23, 0xa3, 0xad, 0xda, 0xb5, 0x81, 0xee, 0x86, 0x90, 0xde,
-	0x93, 0x42, 0xe3, 0x2d, 0x58, 0xcc, 0x2a, 0x32, 0xee, 0xc6, 0xdc, 0x91, 0x99, 0x93, 0x49, 0xa8,
-	0xda, 0x0b, 0x03, 0xbd, 0x4d, 0x21, 0x5c, 0x17, 0x32, 0xe3, 0x16, 0xd4, 0x86, 0xec, 0x11, 0x4f,
-	0x2b, 0x9d, 0x95, 0x4a, 0x46, 0xc6, 0x18, 0xf1, 0xa4, 0x8a, 0xb5, 0x0a, 0xcb, 0x05, 0x39, 0x4a,
-	0x73, 0xf8, 0x6b, 0x15, 0xfe, 0xd7, 0x61, 0xfe, 0xe6, 0x81, 0x1b, 0x95, 0xc9, 0xdb, 0xc7, 0x00,
-	0x0c, 0x11, 0x7e, 0x92, 0x86, 0xad, 0x3d, 0x3b, 0x6a, 0xce, 0x69, 0x96, 0x54, 0xc5, 0xb2, 0xa7,
-	0xc4, 0x83, 0x6a, 0xd4, 0x07, 0x30, 0x1b, 0xa3, 0x1e, 0xc2, 0xfb, 0xc8, 0xd3, 0x84, 0xd5, 0x13,
-	0x4e, 0x80, 0x61, 0x35, 0xcb, 0x9e, 0x49, 0x16, 0x14, 0xf1, 0x36, 0x4c, 0x2b, 0x93, 0xd9, 0xbe,
-	0xdb, 0x28, 0xdf, 0x77, 0x46, 0xd6, 0x7d, 0xdd, 0x6d, 0x32, 0x7e, 0xdd, 0xea, 0x5f, 0x57, 0x60,
-	0x21, 0xc4, 0xc4, 0x51, 0xd6, 0xc5, 0x7e, 0xd7, 0x16, 0xcf, 0x4a, 0x8b, 0x9f, 0x94, 0xb7, 0xb8,
-	0xac, 0x2c, 0x16, 0x91, 0x5a, 0xb6, 0x11, 0x62, 0x62, 0x27, 0xab, 0xba, 0xcf, 0xe7, 0xe4, 0x0c,
-	0x16, 0x65, 0x4c, 0x4b, 0xbb, 0x2b, 0xbb, 0xe3, 0x03, 0xd4, 0xa3, 0x61, 0x88, 0x19, 0xc3, 0x94,
-	0x94, 0x3d, 0x50, 0x05, 0xb4, 0x1f, 0x6e, 0xd1, 0x40, 0xdf, 0x8b, 0xb3, 0x50, 0xb9, 0x2e, 0xa0,
-	0xea, 0x8f, 0xda, 0x66, 0x79, 0x63, 0xa9, 0x2f, 0x7f, 0x55, 0x60, 0x49, 0x6c, 0x43, 0x22, 0xf6,
-	0x64, 0x66, 0x14, 0x3d, 0xdc, 0x43, 0x8c, 0x9f, 0x8a, 0xd3, 0x62, 0x03, 0xce, 0x66, 0x2f, 0x41,
-	0xed, 0x92, 0x35, 0xb3, 0x95, 0xb6, 0x9e, 0x58, 0x23, 0x71, 0xea, 0x34, 0xfc, 0x56, 0x81, 0xd5,
-	0xb4, 0x1b, 0xd5, 0xf5, 0x9d, 0x25, 0x0d, 0x59, 0x3a, 0x15, 0x6b, 0xb0, 0x1a, 0x24, 0x16, 0x9c,
-	0x58, 0xdc, 0xaa, 0xdc, 0xc0, 0x91, 0xe3, 0x58, 0x8d, 0x07, 0x99, 0x99, 0x33, 0xb6, 0x19, 0x0c,
-	0xdc, 0x90, 0x98, 0x7b, 0xb4, 0xb7, 0xab, 0x86, 0x84, 0xb1, 0x01, 0xcd, 0x51, 0x8a, 0x9e, 0x18,
-	0x6f, 0x41, 0x42, 0x52, 0x95, 0x24, 0x2b, 0x79, 0x92, 0x3b, 0x12, 0xa4, 0x68, 0xac, 0x4b, 0xd0,
-	0x18, 0x17, 0x95, 0x0e, 0xfc, 0x5b, 0x55, 0xff, 0x35, 0xcf, 0xd3, 0x2f, 0x2d, 0x52, 0xf1, 0x05,
-	0x82, 0xbe, 0x23, 0x66, 0x85, 0x60, 0xd0, 0xfe, 0xb1, 0xfa, 0xe4, 0xa5, 0xea, 0xf5, 0xe9, 0xdb,
-	0x2b, 0xf9, 0xfa, 0x0f, 0xd9, 0x99, 0x89, 0x33, 0x4f, 0x49, 0x91, 0x46, 0x9c, 0x51, 0xbe, 0xde,
-	0xfe, 0x65, 0x0a, 0xaa, 0x1d, 0xe6, 0x1b, 0x2e, 0x5c, 0xc8, 0xbf, 0x45, 0x59, 0x79, 0x2b, 0xa3,
-	0xf7, 0x59, 0xf3, 0x95, 0xe3, 0x31, 0x89, 0x29, 0x23, 0x82, 0x85, 0xc2, 0xd7, 0x83, 0x6b, 0xc7,
-	0x73, 0x48, 0xa0, 0xd9, 0x3e, 0x21, 0x30, 0xb5, 0x68, 0x03, 0x64, 0x2e, 0xd7, 0xab, 0x05, 0xea,
-	0x03, 0xb1, 0xf9, 0xf2, 0x73, 0xc5, 0x29, 0xe7, 0x67, 0x70, 0x7e, 0xe8, 0xf2, 0xd7, 0x2c, 0x50,
-	0xcb, 0x02, 0xcc, 0x6b, 0xc7, 0x00, 0x52, 0xe6, 0xf7, 0xe1, 0x8c, 0x3c, 0x99, 0x16, 0x0b, 0x14,
-	0x84, 0xc0, 0x6c, 0x8e, 0x11, 0xa4, 0x0c, 0x1e, 0x5c, 0x1c, 0x99, 0x80, 0x57, 0x0a, 0x94, 0xf2,
-	0x20, 0xf3, 0xd5, 0x13, 0x80, 0x52, 0x2b, 0x3b, 0x70, 0x21, 0xd7, 0xf2, 0xc6, 0x8d, 0x02, 0xfd,
-	0xe2, 0xf1, 0x57, 0xb8, 0x63, 0xc6, 0x4c, 0x10, 0x83, 0xc3, 0x7c, 0x41, 0x9f, 0x19, 0x37, 0x8b,
-	0x28, 0xc6, 0x4e, 0x19, 0xb3, 0x75, 0x52, 0xf8, 0x20, 0xbe, 0x5c, 0xb7, 0x14, 0xc6, 0x57, 0xdc,
-	0xde, 0x85, 0xf1, 0x8d, 0x69, 0x3e, 0xd1, 0x74, 0xf9, 0x0b, 0x69, 0x51, 0xd3, 0xe5, 0x30, 0x85,
-	0x26, 0xc6, 0x5c, 0x1b, 0xc5, 0x96, 0x18, 0xb9, 0x32, 0x5e, 0x19, 0x9b, 0x90, 0x01, 0xa8, 0x70,
-	0x4b, 0x8c, 0xbb, 0x58, 0x19, 0x5f, 0xc1, 0xd2, 0xf8, 0x6f, 0x53, 0xaf, 0x8d, 0x65, 0x2a, 0x40,
-	0x9b, 0x6f, 0x96, 0x41, 0x27, 0x0e, 0xac, 0xaf, 0x3d, 0x7a, 0xd2, 0xa8, 0x3c, 0x7e, 0xd2, 0xa8,
-	0xfc, 0xf9, 0xa4, 0x51, 0xf9, 0xfe, 0x69, 0x63, 0xe2, 0xf1, 0xd3, 0xc6, 0xc4, 0xef, 0x4f, 0x1b,
-	0x13, 0x9f, 0x67, 0xcf, 0xb4, 0x4d, 0xbc, 0xdd, 0xdb, 0x71, 0x31, 0x69, 0x27, 0xdf, 0xda, 0x0e,
-	0xe5, 0xd7, 0x36, 0x79, 0xb0, 0x6d, 0x9d, 0x93, 0x9f, 0xda, 0xde, 0xf8, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xad, 0x4d, 0x16, 0x09, 0xe4, 0x13, 0x00, 0x00,
+var xxx_messageInfo_MsgAppendRewardPeriod proto.InternalMessageInfo
+
+func (m *MsgAppendRewardPeriod) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAppendRewardPeriod) GetRewardPeriod() *RewardPeriod {
+	if m != nil {
+		return m.RewardPeriod
+	}
+	return nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type MsgAppendRewardPeriodResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
-	RemoveLiquidityUnits(ctx context.Context, in *MsgRemoveLiquidityUnits, opts ...grpc.CallOption) (*MsgRemoveLiquidityUnitsResponse, error)
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
-	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
-	DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error)
-	UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidityRequest, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error)
-	UpdateRewardsParams(ctx context.Context, in *MsgUpdateRewardsParamsRequest, opts ...grpc.CallOption) (*MsgUpdateRewardsParamsResponse, error)
-	AddRewardPeriod(ctx context.Context, in *MsgAddRewardPeriodRequest, opts ...grpc.CallOption) (*MsgAddRewardPeriodResponse, error)
-	ModifyPmtpRates(ctx context.Context, in *MsgModifyPmtpRates, opts ...grpc.CallOption) (*MsgModifyPmtpRatesResponse, error)
-	UpdatePmtpParams(ctx context.Context, in *MsgUpdatePmtpParams, opts ...grpc.CallOption) (*MsgUpdatePmtpParamsResponse, error)
-	UpdateStakingRewardParams(ctx context.Context, in *MsgUpdateStakingRewardParams, opts ...grpc.CallOption) (*MsgUpdateStakingRewardParamsResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAppendRewardPeriodResponse) Reset()         { *m = MsgAppendRewardPeriodResponse{} }
+func (m *MsgAppendRewardPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendRewardPeriodResponse) ProtoMessage()    {}
+func (*MsgAppendRewardPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{29}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAppendRewardPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
-	out := new(MsgRemoveLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/RemoveLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAppendRewardPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendRewardPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) RemoveLiquidityUnits(ctx context.Context, in *MsgRemoveLiquidityUnits, opts ...grpc.CallOption) (*MsgRemoveLiquidityUnitsResponse, error) {
-	out := new(MsgRemoveLiquidityUnitsResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/RemoveLiquidityUnits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAppendRewardPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendRewardPeriodResponse.Merge(m, src)
+}
+func (m *MsgAppendRewardPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendRewardPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendRewardPeriodResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgAppendRewardPeriodResponse proto.InternalMessageInfo
+
+type MsgEditRewardPeriod struct {
+	Signer       string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RewardPeriod *RewardPeriod `protobuf:"bytes,2,opt,name=reward_period,json=rewardPeriod,proto3" json:"reward_period,omitempty"`
 }
 
-func (c *msgClient) AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error) {
-	out := new(MsgAddLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AddLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEditRewardPeriod) Reset()         { *m = MsgEditRewardPeriod{} }
+func (m *MsgEditRewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*MsgEditRewardPeriod) ProtoMessage()    {}
+func (*MsgEditRewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{30}
+}
+func (m *MsgEditRewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditRewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditRewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEditRewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditRewardPeriod.Merge(m, src)
+}
+func (m *MsgEditRewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditRewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditRewardPeriod.DiscardUnknown(m)
 }
 
-func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
-	out := new(MsgSwapResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEditRewardPeriod proto.InternalMessageInfo
+
+func (m *MsgEditRewardPeriod) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error) {
-	out := new(MsgDecommissionPoolResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/DecommissionPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEditRewardPeriod) GetRewardPeriod() *RewardPeriod {
+	if m != nil {
+		return m.RewardPeriod
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidityRequest, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error) {
-	out := new(MsgUnlockLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UnlockLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgEditRewardPeriodResponse struct {
 }
 
-func (c *msgClient) UpdateRewardsParams(ctx context.Context, in *MsgUpdateRewardsParamsRequest, opts ...grpc.CallOption) (*MsgUpdateRewardsParamsResponse, error) {
-	out := new(MsgUpdateRewardsParamsResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateRewardsParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEditRewardPeriodResponse) Reset()         { *m = MsgEditRewardPeriodResponse{} }
+func (m *MsgEditRewardPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEditRewardPeriodResponse) ProtoMessage()    {}
+func (*MsgEditRewardPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{31}
+}
+func (m *MsgEditRewardPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditRewardPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditRewardPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgEditRewardPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditRewardPeriodResponse.Merge(m, src)
+}
+func (m *MsgEditRewardPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditRewardPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditRewardPeriodResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddRewardPeriod(ctx context.Context, in *MsgAddRewardPeriodRequest, opts ...grpc.CallOption) (*MsgAddRewardPeriodResponse, error) {
-	out := new(MsgAddRewardPeriodResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AddRewardPeriod", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgEditRewardPeriodResponse proto.InternalMessageInfo
+
+type MsgDeleteRewardPeriod struct {
+	Signer         string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RewardPeriodId string `protobuf:"bytes,2,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
 }
 
-func (c *msgClient) ModifyPmtpRates(ctx context.Context, in *MsgModifyPmtpRates, opts ...grpc.CallOption) (*MsgModifyPmtpRatesResponse, error) {
-	out := new(MsgModifyPmtpRatesResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/ModifyPmtpRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRewardPeriod) Reset()         { *m = MsgDeleteRewardPeriod{} }
+func (m *MsgDeleteRewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRewardPeriod) ProtoMessage()    {}
+func (*MsgDeleteRewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{32}
+}
+func (m *MsgDeleteRewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteRewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeleteRewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRewardPeriod.Merge(m, src)
+}
+func (m *MsgDeleteRewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteRewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRewardPeriod.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdatePmtpParams(ctx context.Context, in *MsgUpdatePmtpParams, opts ...grpc.CallOption) (*MsgUpdatePmtpParamsResponse, error) {
-	out := new(MsgUpdatePmtpParamsResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdatePmtpParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeleteRewardPeriod proto.InternalMessageInfo
+
+func (m *MsgDeleteRewardPeriod) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateStakingRewardParams(ctx context.Context, in *MsgUpdateStakingRewardParams, opts ...grpc.CallOption) (*MsgUpdateStakingRewardParamsResponse, error) {
-	out := new(MsgUpdateStakingRewardParamsResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateStakingRewardParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteRewardPeriod) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
-	RemoveLiquidityUnits(context.Context, *MsgRemoveLiquidityUnits) (*MsgRemoveLiquidityUnitsResponse, error)
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
-	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
-	DecommissionPool(context.Context, *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error)
-	UnlockLiquidity(context.Context, *MsgUnlockLiquidityRequest) (*MsgUnlockLiquidityResponse, error)
-	UpdateRewardsParams(context.Context, *MsgUpdateRewardsParamsRequest) (*MsgUpdateRewardsParamsResponse, error)
-	AddRewardPeriod(context.Context, *MsgAddRewardPeriodRequest) (*MsgAddRewardPeriodResponse, error)
-	ModifyPmtpRates(context.Context, *MsgModifyPmtpRates) (*MsgModifyPmtpRatesResponse, error)
-	UpdatePmtpParams(context.Context, *MsgUpdatePmtpParams) (*MsgUpdatePmtpParamsResponse, error)
-	UpdateStakingRewardParams(context.Context, *MsgUpdateStakingRewardParams) (*MsgUpdateStakingRewardParamsResponse, error)
+type MsgDeleteRewardPeriodResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDeleteRewardPeriodResponse) Reset()         { *m = MsgDeleteRewardPeriodResponse{} }
+func (m *MsgDeleteRewardPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteRewardPeriodResponse) ProtoMessage()    {}
+func (*MsgDeleteRewardPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{33}
 }
-
-func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
+func (m *MsgDeleteRewardPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RemoveLiquidityUnits(ctx context.Context, req *MsgRemoveLiquidityUnits) (*MsgRemoveLiquidityUnitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidityUnits not implemented")
+func (m *MsgDeleteRewardPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteRewardPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgDeleteRewardPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteRewardPeriodResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiquidity) (*MsgAddLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLiquidity not implemented")
+func (m *MsgDeleteRewardPeriodResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *MsgDeleteRewardPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteRewardPeriodResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) DecommissionPool(ctx context.Context, req *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecommissionPool not implemented")
+
+var xxx_messageInfo_MsgDeleteRewardPeriodResponse proto.InternalMessageInfo
+
+type MsgFundRewardEscrow struct {
+	Signer         string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RewardPeriodId string                                  `protobuf:"bytes,2,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount" yaml:"amount"`
 }
-func (*UnimplementedMsgServer) UnlockLiquidity(ctx context.Context, req *MsgUnlockLiquidityRequest) (*MsgUnlockLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlockLiquidity not implemented")
+
+func (m *MsgFundRewardEscrow) Reset()         { *m = MsgFundRewardEscrow{} }
+func (m *MsgFundRewardEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardEscrow) ProtoMessage()    {}
+func (*MsgFundRewardEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{34}
 }
-func (*UnimplementedMsgServer) UpdateRewardsParams(ctx context.Context, req *MsgUpdateRewardsParamsRequest) (*MsgUpdateRewardsParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRewardsParams not implemented")
+func (m *MsgFundRewardEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AddRewardPeriod(ctx context.Context, req *MsgAddRewardPeriodRequest) (*MsgAddRewardPeriodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRewardPeriod not implemented")
+func (m *MsgFundRewardEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ModifyPmtpRates(ctx context.Context, req *MsgModifyPmtpRates) (*MsgModifyPmtpRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyPmtpRates not implemented")
+func (m *MsgFundRewardEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardEscrow.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdatePmtpParams(ctx context.Context, req *MsgUpdatePmtpParams) (*MsgUpdatePmtpParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePmtpParams not implemented")
+func (m *MsgFundRewardEscrow) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateStakingRewardParams(ctx context.Context, req *MsgUpdateStakingRewardParams) (*MsgUpdateStakingRewardParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingRewardParams not implemented")
+func (m *MsgFundRewardEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardEscrow.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgFundRewardEscrow proto.InternalMessageInfo
 
-func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveLiquidity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/RemoveLiquidity",
+func (m *MsgFundRewardEscrow) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
+	return ""
+}
+
+func (m *MsgFundRewardEscrow) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RemoveLiquidityUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveLiquidityUnits)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveLiquidityUnits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/RemoveLiquidityUnits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveLiquidityUnits(ctx, req.(*MsgRemoveLiquidityUnits))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgFundRewardEscrowResponse struct {
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/CreatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+func (m *MsgFundRewardEscrowResponse) Reset()         { *m = MsgFundRewardEscrowResponse{} }
+func (m *MsgFundRewardEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardEscrowResponse) ProtoMessage()    {}
+func (*MsgFundRewardEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{35}
+}
+func (m *MsgFundRewardEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFundRewardEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardEscrowResponse.Merge(m, src)
+}
+func (m *MsgFundRewardEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardEscrowResponse.DiscardUnknown(m)
 }
 
-func _Msg_AddLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddLiquidity)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/AddLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddLiquidity(ctx, req.(*MsgAddLiquidity))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgFundRewardEscrowResponse proto.InternalMessageInfo
+
+type MsgRefundRewardEscrow struct {
+	Signer         string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RewardPeriodId string `protobuf:"bytes,2,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
 }
 
-func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
+func (m *MsgRefundRewardEscrow) Reset()         { *m = MsgRefundRewardEscrow{} }
+func (m *MsgRefundRewardEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundRewardEscrow) ProtoMessage()    {}
+func (*MsgRefundRewardEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{36}
+}
+func (m *MsgRefundRewardEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundRewardEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundRewardEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRefundRewardEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundRewardEscrow.Merge(m, src)
+}
+func (m *MsgRefundRewardEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundRewardEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundRewardEscrow.DiscardUnknown(m)
 }
 
-func _Msg_DecommissionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDecommissionPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DecommissionPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/DecommissionPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DecommissionPool(ctx, req.(*MsgDecommissionPool))
+var xxx_messageInfo_MsgRefundRewardEscrow proto.InternalMessageInfo
+
+func (m *MsgRefundRewardEscrow) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UnlockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnlockLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnlockLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UnlockLiquidity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnlockLiquidity(ctx, req.(*MsgUnlockLiquidityRequest))
+func (m *MsgRefundRewardEscrow) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRewardsParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRewardsParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRewardsParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UpdateRewardsParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRewardsParams(ctx, req.(*MsgUpdateRewardsParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRefundRewardEscrowResponse struct {
+	Refunded github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=refunded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"refunded"`
 }
 
-func _Msg_AddRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRewardPeriodRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddRewardPeriod(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/AddRewardPeriod",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddRewardPeriod(ctx, req.(*MsgAddRewardPeriodRequest))
+func (m *MsgRefundRewardEscrowResponse) Reset()         { *m = MsgRefundRewardEscrowResponse{} }
+func (m *MsgRefundRewardEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundRewardEscrowResponse) ProtoMessage()    {}
+func (*MsgRefundRewardEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{37}
+}
+func (m *MsgRefundRewardEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundRewardEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundRewardEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRefundRewardEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundRewardEscrowResponse.Merge(m, src)
+}
+func (m *MsgRefundRewardEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundRewardEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundRewardEscrowResponse.DiscardUnknown(m)
 }
 
-func _Msg_ModifyPmtpRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyPmtpRates)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModifyPmtpRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/ModifyPmtpRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyPmtpRates(ctx, req.(*MsgModifyPmtpRates))
+var xxx_messageInfo_MsgRefundRewardEscrowResponse proto.InternalMessageInfo
+
+type MsgPlaceLimitOrder struct {
+	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	SentAsset     *Asset                                  `protobuf:"bytes,2,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty" yaml:"sent_asset"`
+	ReceivedAsset *Asset                                  `protobuf:"bytes,3,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty" yaml:"received_asset"`
+	SentAmount    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	// limit_price is the minimum amount of received_asset per sent_asset, in the
+	// same units as the pool swap prices
+	LimitPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=limit_price,json=limitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"limit_price" yaml:"limit_price"`
+	ExpiryHeight int64                                  `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
+func (m *MsgPlaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrder) ProtoMessage()    {}
+func (*MsgPlaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{38}
+}
+func (m *MsgPlaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPlaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrder.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrder.DiscardUnknown(m)
 }
 
-func _Msg_UpdatePmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePmtpParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePmtpParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UpdatePmtpParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePmtpParams(ctx, req.(*MsgUpdatePmtpParams))
+var xxx_messageInfo_MsgPlaceLimitOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrder) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateStakingRewardParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateStakingRewardParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateStakingRewardParams(ctx, in)
+func (m *MsgPlaceLimitOrder) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UpdateStakingRewardParams",
+	return nil
+}
+
+func (m *MsgPlaceLimitOrder) GetReceivedAsset() *Asset {
+	if m != nil {
+		return m.ReceivedAsset
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateStakingRewardParams(ctx, req.(*MsgUpdateStakingRewardParams))
+	return nil
+}
+
+func (m *MsgPlaceLimitOrder) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.clp.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RemoveLiquidity",
-			Handler:    _Msg_RemoveLiquidity_Handler,
-		},
-		{
-			MethodName: "RemoveLiquidityUnits",
-			Handler:    _Msg_RemoveLiquidityUnits_Handler,
-		},
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "AddLiquidity",
-			Handler:    _Msg_AddLiquidity_Handler,
-		},
-		{
-			MethodName: "Swap",
-			Handler:    _Msg_Swap_Handler,
-		},
-		{
-			MethodName: "DecommissionPool",
-			Handler:    _Msg_DecommissionPool_Handler,
-		},
-		{
-			MethodName: "UnlockLiquidity",
-			Handler:    _Msg_UnlockLiquidity_Handler,
-		},
-		{
-			MethodName: "UpdateRewardsParams",
-			Handler:    _Msg_UpdateRewardsParams_Handler,
-		},
-		{
-			MethodName: "AddRewardPeriod",
-			Handler:    _Msg_AddRewardPeriod_Handler,
-		},
-		{
-			MethodName: "ModifyPmtpRates",
-			Handler:    _Msg_ModifyPmtpRates_Handler,
-		},
-		{
-			MethodName: "UpdatePmtpParams",
-			Handler:    _Msg_UpdatePmtpParams_Handler,
-		},
-		{
-			MethodName: "UpdateStakingRewardParams",
-			Handler:    _Msg_UpdateStakingRewardParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/clp/v1/tx.proto",
+type MsgPlaceLimitOrderResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateStakingRewardParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
+func (m *MsgPlaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{39}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateStakingRewardParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPlaceLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStakingRewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Params.Size()
-		i -= size
-		if _, err := m.Params.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_MsgPlaceLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrderResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Minter.Size()
-		i -= size
-		if _, err := m.Minter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type MsgCancelLimitOrder struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelLimitOrder) Reset()         { *m = MsgCancelLimitOrder{} }
+func (m *MsgCancelLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrder) ProtoMessage()    {}
+func (*MsgCancelLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{40}
+}
+func (m *MsgCancelLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrder.Merge(m, src)
+}
+func (m *MsgCancelLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrder.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStakingRewardParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelLimitOrder proto.InternalMessageInfo
+
+func (m *MsgCancelLimitOrder) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateStakingRewardParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelLimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateStakingRewardParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCancelLimitOrderResponse struct {
 }
 
-func (m *MsgRemoveLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelLimitOrderResponse) Reset()         { *m = MsgCancelLimitOrderResponse{} }
+func (m *MsgCancelLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCancelLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{41}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
+
+type MsgUpdateSwapFeeRate struct {
+	Signer        string                                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset                                 `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	SwapFeeRate   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
 }
 
-func (m *MsgRemoveLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Asymmetry.Size()
-		i -= size
-		if _, err := m.Asymmetry.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.WBasisPoints.Size()
-		i -= size
-		if _, err := m.WBasisPoints.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateSwapFeeRate) Reset()         { *m = MsgUpdateSwapFeeRate{} }
+func (m *MsgUpdateSwapFeeRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSwapFeeRate) ProtoMessage()    {}
+func (*MsgUpdateSwapFeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{42}
+}
+func (m *MsgUpdateSwapFeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSwapFeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSwapFeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdateSwapFeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSwapFeeRate.Merge(m, src)
+}
+func (m *MsgUpdateSwapFeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSwapFeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSwapFeeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSwapFeeRate proto.InternalMessageInfo
+
+func (m *MsgUpdateSwapFeeRate) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSwapFeeRate) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRemoveLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateSwapFeeRateResponse struct {
 }
 
-func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateSwapFeeRateResponse) Reset()         { *m = MsgUpdateSwapFeeRateResponse{} }
+func (m *MsgUpdateSwapFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSwapFeeRateResponse) ProtoMessage()    {}
+func (*MsgUpdateSwapFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{43}
 }
-
-func (m *MsgRemoveLiquidityUnits) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateSwapFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSwapFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSwapFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateSwapFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSwapFeeRateResponse.Merge(m, src)
+}
+func (m *MsgUpdateSwapFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSwapFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSwapFeeRateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveLiquidityUnits) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateSwapFeeRateResponse proto.InternalMessageInfo
+
+type MsgUpdateProtocolFeeRate struct {
+	Signer          string                                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ProtocolFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=protocol_fee_rate,json=protocolFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_rate" yaml:"protocol_fee_rate"`
 }
 
-func (m *MsgRemoveLiquidityUnits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.WithdrawUnits.Size()
-		i -= size
-		if _, err := m.WithdrawUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateProtocolFeeRate) Reset()         { *m = MsgUpdateProtocolFeeRate{} }
+func (m *MsgUpdateProtocolFeeRate) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProtocolFeeRate) ProtoMessage()    {}
+func (*MsgUpdateProtocolFeeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{44}
+}
+func (m *MsgUpdateProtocolFeeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProtocolFeeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProtocolFeeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateProtocolFeeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProtocolFeeRate.Merge(m, src)
+}
+func (m *MsgUpdateProtocolFeeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProtocolFeeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProtocolFeeRate.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveLiquidityUnitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateProtocolFeeRate proto.InternalMessageInfo
+
+func (m *MsgUpdateProtocolFeeRate) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveLiquidityUnitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateProtocolFeeRateResponse struct {
 }
 
-func (m *MsgRemoveLiquidityUnitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateProtocolFeeRateResponse) Reset()         { *m = MsgUpdateProtocolFeeRateResponse{} }
+func (m *MsgUpdateProtocolFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProtocolFeeRateResponse) ProtoMessage()    {}
+func (*MsgUpdateProtocolFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{45}
 }
-
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateProtocolFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProtocolFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProtocolFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateProtocolFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProtocolFeeRateResponse.Merge(m, src)
+}
+func (m *MsgUpdateProtocolFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProtocolFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProtocolFeeRateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateProtocolFeeRateResponse proto.InternalMessageInfo
+
+type MsgUpdatePoolPauseState struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	SwapsPaused   bool   `protobuf:"varint,3,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty"`
+	AddsPaused    bool   `protobuf:"varint,4,opt,name=adds_paused,json=addsPaused,proto3" json:"adds_paused,omitempty"`
+	RemovesPaused bool   `protobuf:"varint,5,opt,name=removes_paused,json=removesPaused,proto3" json:"removes_paused,omitempty"`
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExternalAssetAmount.Size()
-		i -= size
-		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdatePoolPauseState) Reset()         { *m = MsgUpdatePoolPauseState{} }
+func (m *MsgUpdatePoolPauseState) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolPauseState) ProtoMessage()    {}
+func (*MsgUpdatePoolPauseState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{46}
+}
+func (m *MsgUpdatePoolPauseState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolPauseState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolPauseState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NativeAssetAmount.Size()
-		i -= size
-		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUpdatePoolPauseState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolPauseState.Merge(m, src)
+}
+func (m *MsgUpdatePoolPauseState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolPauseState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolPauseState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolPauseState proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolPauseState) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgUpdatePoolPauseState) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgUpdatePoolPauseState) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePoolPauseState) GetAddsPaused() bool {
+	if m != nil {
+		return m.AddsPaused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePoolPauseState) GetRemovesPaused() bool {
+	if m != nil {
+		return m.RemovesPaused
+	}
+	return false
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdatePoolPauseStateResponse struct {
 }
 
-func (m *MsgAddLiquidity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePoolPauseStateResponse) Reset()         { *m = MsgUpdatePoolPauseStateResponse{} }
+func (m *MsgUpdatePoolPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolPauseStateResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{47}
+}
+func (m *MsgUpdatePoolPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdatePoolPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolPauseStateResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolPauseStateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdatePoolPauseStateResponse proto.InternalMessageInfo
+
+type MsgUpdatePoolSwapMode struct {
+	Signer        string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset   `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	SwapMode      SwapMode `protobuf:"varint,3,opt,name=swap_mode,json=swapMode,proto3,enum=sifnode.clp.v1.SwapMode" json:"swap_mode,omitempty"`
 }
 
-func (m *MsgAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExternalAssetAmount.Size()
-		i -= size
-		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdatePoolSwapMode) Reset()         { *m = MsgUpdatePoolSwapMode{} }
+func (m *MsgUpdatePoolSwapMode) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolSwapMode) ProtoMessage()    {}
+func (*MsgUpdatePoolSwapMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{48}
+}
+func (m *MsgUpdatePoolSwapMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolSwapMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolSwapMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.NativeAssetAmount.Size()
-		i -= size
-		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgUpdatePoolSwapMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolSwapMode.Merge(m, src)
+}
+func (m *MsgUpdatePoolSwapMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolSwapMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolSwapMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolSwapMode proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolSwapMode) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePoolSwapMode) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdatePoolSwapMode) GetSwapMode() SwapMode {
+	if m != nil {
+		return m.SwapMode
+	}
+	return SwapMode_SWAP_MODE_SEQUENTIAL
 }
 
-func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdatePoolSwapModeResponse struct {
 }
 
-func (m *MsgModifyPmtpRates) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdatePoolSwapModeResponse) Reset()         { *m = MsgUpdatePoolSwapModeResponse{} }
+func (m *MsgUpdatePoolSwapModeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolSwapModeResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolSwapModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{49}
+}
+func (m *MsgUpdatePoolSwapModeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolSwapModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolSwapModeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdatePoolSwapModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolSwapModeResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolSwapModeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolSwapModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolSwapModeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgModifyPmtpRates) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdatePoolSwapModeResponse proto.InternalMessageInfo
+
+type MsgApprovePendingPool struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 }
 
-func (m *MsgModifyPmtpRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndPolicy {
-		i--
-		if m.EndPolicy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgApprovePendingPool) Reset()         { *m = MsgApprovePendingPool{} }
+func (m *MsgApprovePendingPool) String() string { return proto.CompactTextString(m) }
+func (*MsgApprovePendingPool) ProtoMessage()    {}
+func (*MsgApprovePendingPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{50}
+}
+func (m *MsgApprovePendingPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprovePendingPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprovePendingPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RunningRate) > 0 {
-		i -= len(m.RunningRate)
-		copy(dAtA[i:], m.RunningRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RunningRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlockRate) > 0 {
-		i -= len(m.BlockRate)
-		copy(dAtA[i:], m.BlockRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockRate)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgApprovePendingPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprovePendingPool.Merge(m, src)
+}
+func (m *MsgApprovePendingPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprovePendingPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprovePendingPool.DiscardUnknown(m)
 }
 
-func (m *MsgModifyPmtpRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgApprovePendingPool proto.InternalMessageInfo
+
+func (m *MsgApprovePendingPool) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgModifyPmtpRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgApprovePendingPool) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
 }
 
-func (m *MsgModifyPmtpRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgApprovePendingPoolResponse struct {
 }
 
-func (m *MsgUpdatePmtpParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgApprovePendingPoolResponse) Reset()         { *m = MsgApprovePendingPoolResponse{} }
+func (m *MsgApprovePendingPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApprovePendingPoolResponse) ProtoMessage()    {}
+func (*MsgApprovePendingPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{51}
+}
+func (m *MsgApprovePendingPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApprovePendingPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApprovePendingPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgApprovePendingPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApprovePendingPoolResponse.Merge(m, src)
+}
+func (m *MsgApprovePendingPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApprovePendingPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApprovePendingPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePmtpParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgApprovePendingPoolResponse proto.InternalMessageInfo
+
+// MsgCancelPoolWindDown lets a clp admin end the wind down of a pool, for
+// instance when its settlement keeps failing
+type MsgCancelPoolWindDown struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 }
 
-func (m *MsgUpdatePmtpParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PmtpPeriodEndBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodEndBlock))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.PmtpPeriodStartBlock != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodStartBlock))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PmtpPeriodEpochLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodEpochLength))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.PmtpPeriodGovernanceRate) > 0 {
-		i -= len(m.PmtpPeriodGovernanceRate)
-		copy(dAtA[i:], m.PmtpPeriodGovernanceRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PmtpPeriodGovernanceRate)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgCancelPoolWindDown) Reset()         { *m = MsgCancelPoolWindDown{} }
+func (m *MsgCancelPoolWindDown) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPoolWindDown) ProtoMessage()    {}
+func (*MsgCancelPoolWindDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{52}
+}
+func (m *MsgCancelPoolWindDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPoolWindDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPoolWindDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCancelPoolWindDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPoolWindDown.Merge(m, src)
+}
+func (m *MsgCancelPoolWindDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPoolWindDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPoolWindDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPoolWindDown proto.InternalMessageInfo
+
+func (m *MsgCancelPoolWindDown) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdatePmtpParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelPoolWindDown) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdatePmtpParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelPoolWindDownResponse struct {
 }
 
-func (m *MsgUpdatePmtpParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelPoolWindDownResponse) Reset()         { *m = MsgCancelPoolWindDownResponse{} }
+func (m *MsgCancelPoolWindDownResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPoolWindDownResponse) ProtoMessage()    {}
+func (*MsgCancelPoolWindDownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{53}
 }
-
-func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelPoolWindDownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPoolWindDownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPoolWindDownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelPoolWindDownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPoolWindDownResponse.Merge(m, src)
+}
+func (m *MsgCancelPoolWindDownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPoolWindDownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPoolWindDownResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelPoolWindDownResponse proto.InternalMessageInfo
+
+type MsgUpdateCircuitBreakerParams struct {
+	Signer         string                                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	MaxPriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_price_impact,json=maxPriceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_impact" yaml:"max_price_impact"`
+	MaxPriceChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_price_change,json=maxPriceChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_change" yaml:"max_price_change"`
 }
 
-func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinReceivingAmount.Size()
-		i -= size
-		if _, err := m.MinReceivingAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SentAmount.Size()
-		i -= size
-		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.ReceivedAsset != nil {
-		{
-			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SentAsset != nil {
-		{
-			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateCircuitBreakerParams) Reset()         { *m = MsgUpdateCircuitBreakerParams{} }
+func (m *MsgUpdateCircuitBreakerParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerParams) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{54}
+}
+func (m *MsgUpdateCircuitBreakerParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateCircuitBreakerParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerParams.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerParams.DiscardUnknown(m)
 }
 
-func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateCircuitBreakerParams proto.InternalMessageInfo
+
+func (m *MsgUpdateCircuitBreakerParams) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateCircuitBreakerParamsResponse struct {
 }
 
-func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateCircuitBreakerParamsResponse) Reset()         { *m = MsgUpdateCircuitBreakerParamsResponse{} }
+func (m *MsgUpdateCircuitBreakerParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCircuitBreakerParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateCircuitBreakerParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{55}
 }
-
-func (m *MsgDecommissionPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCircuitBreakerParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCircuitBreakerParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCircuitBreakerParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateCircuitBreakerParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCircuitBreakerParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCircuitBreakerParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCircuitBreakerParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCircuitBreakerParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDecommissionPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateCircuitBreakerParamsResponse proto.InternalMessageInfo
+
+type MsgCancelPmtpPolicy struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	PolicyId uint64 `protobuf:"varint,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 }
 
-func (m *MsgDecommissionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCancelPmtpPolicy) Reset()         { *m = MsgCancelPmtpPolicy{} }
+func (m *MsgCancelPmtpPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPmtpPolicy) ProtoMessage()    {}
+func (*MsgCancelPmtpPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{56}
+}
+func (m *MsgCancelPmtpPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPmtpPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPmtpPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelPmtpPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPmtpPolicy.Merge(m, src)
+}
+func (m *MsgCancelPmtpPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPmtpPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPmtpPolicy.DiscardUnknown(m)
 }
 
-func (m *MsgDecommissionPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelPmtpPolicy proto.InternalMessageInfo
+
+func (m *MsgCancelPmtpPolicy) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDecommissionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelPmtpPolicy) GetPolicyId() uint64 {
+	if m != nil {
+		return m.PolicyId
+	}
+	return 0
 }
 
-func (m *MsgDecommissionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgCancelPmtpPolicyResponse struct {
 }
 
-func (m *MsgUnlockLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelPmtpPolicyResponse) Reset()         { *m = MsgCancelPmtpPolicyResponse{} }
+func (m *MsgCancelPmtpPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPmtpPolicyResponse) ProtoMessage()    {}
+func (*MsgCancelPmtpPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{57}
+}
+func (m *MsgCancelPmtpPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPmtpPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPmtpPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelPmtpPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPmtpPolicyResponse.Merge(m, src)
+}
+func (m *MsgCancelPmtpPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPmtpPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPmtpPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnlockLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelPmtpPolicyResponse proto.InternalMessageInfo
+
+type MsgClaimRewards struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
 }
 
-func (m *MsgUnlockLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{58}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUnlockLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
 }
-
-func (m *MsgUnlockLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUnlockLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateRewardsParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRewardsParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRewards) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
 }
 
-func (m *MsgUpdateRewardsParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LiquidityRemovalCancelPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalCancelPeriod))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LiquidityRemovalLockPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalLockPeriod))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgClaimRewardsResponse struct {
+	Claimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed"`
 }
 
-func (m *MsgUpdateRewardsParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{59}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateRewardsParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateRewardsParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddRewardPeriodRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardsResponse) GetClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Claimed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddRewardPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddLiquiditySingleSided adds amount of sent_asset, rowan or the pool
+// asset, to the pool of external_asset. The share of amount matching the
+// pool ratio after the swap is swapped into the other asset first.
+type MsgAddLiquiditySingleSided struct {
+	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	SentAsset     *Asset                                  `protobuf:"bytes,3,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty" yaml:"sent_asset"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount" yaml:"amount"`
+	// min_pool_units is the least liquidity units the signer accepts to
+	// receive, unset or zero for no minimum
+	MinPoolUnits github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=min_pool_units,json=minPoolUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_pool_units" yaml:"min_pool_units"`
+	// deadline_height is the last height the message can be executed at, 0
+	// for no deadline
+	DeadlineHeight int64 `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty" yaml:"deadline_height"`
+}
+
+func (m *MsgAddLiquiditySingleSided) Reset()         { *m = MsgAddLiquiditySingleSided{} }
+func (m *MsgAddLiquiditySingleSided) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLiquiditySingleSided) ProtoMessage()    {}
+func (*MsgAddLiquiditySingleSided) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{60}
+}
+func (m *MsgAddLiquiditySingleSided) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgAddRewardPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardPeriods) > 0 {
-		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgAddLiquiditySingleSided) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLiquiditySingleSided.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddLiquiditySingleSided) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLiquiditySingleSided.Merge(m, src)
 }
-
-func (m *MsgAddRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddLiquiditySingleSided) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgAddRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAddLiquiditySingleSided) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLiquiditySingleSided.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgAddLiquiditySingleSided proto.InternalMessageInfo
+
+func (m *MsgAddLiquiditySingleSided) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgUpdateStakingRewardParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgAddLiquiditySingleSided) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
 	}
-	l = m.Minter.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgUpdateStakingRewardParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddLiquiditySingleSided) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgRemoveLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddLiquiditySingleSided) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
 	}
-	l = m.WBasisPoints.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Asymmetry.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgRemoveLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type MsgAddLiquiditySingleSidedResponse struct {
+	SwapAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=swap_amount,json=swapAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"swap_amount"`
+	SwapResult github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=swap_result,json=swapResult,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"swap_result"`
+	LpUnits    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=lp_units,json=lpUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_units"`
 }
 
-func (m *MsgRemoveLiquidityUnits) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAddLiquiditySingleSidedResponse) Reset()         { *m = MsgAddLiquiditySingleSidedResponse{} }
+func (m *MsgAddLiquiditySingleSidedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddLiquiditySingleSidedResponse) ProtoMessage()    {}
+func (*MsgAddLiquiditySingleSidedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{61}
+}
+func (m *MsgAddLiquiditySingleSidedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddLiquiditySingleSidedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddLiquiditySingleSidedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.WithdrawUnits.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgAddLiquiditySingleSidedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddLiquiditySingleSidedResponse.Merge(m, src)
+}
+func (m *MsgAddLiquiditySingleSidedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddLiquiditySingleSidedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddLiquiditySingleSidedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveLiquidityUnitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgAddLiquiditySingleSidedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateStakingRewardParams)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParams")
+	proto.RegisterType((*MsgUpdateStakingRewardParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParamsResponse")
+	proto.RegisterType((*MsgRemoveLiquidity)(nil), "sifnode.clp.v1.MsgRemoveLiquidity")
+	proto.RegisterType((*MsgRemoveLiquidityResponse)(nil), "sifnode.clp.v1.MsgRemoveLiquidityResponse")
+	proto.RegisterType((*MsgRemoveLiquidityUnits)(nil), "sifnode.clp.v1.MsgRemoveLiquidityUnits")
+	proto.RegisterType((*MsgRemoveLiquidityUnitsResponse)(nil), "sifnode.clp.v1.MsgRemoveLiquidityUnitsResponse")
+	proto.RegisterType((*MsgTransferLiquidityPosition)(nil), "sifnode.clp.v1.MsgTransferLiquidityPosition")
+	proto.RegisterType((*MsgTransferLiquidityPositionResponse)(nil), "sifnode.clp.v1.MsgTransferLiquidityPositionResponse")
+	proto.RegisterType((*MsgCreatePool)(nil), "sifnode.clp.v1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "sifnode.clp.v1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgAddLiquidity)(nil), "sifnode.clp.v1.MsgAddLiquidity")
+	proto.RegisterType((*MsgAddLiquidityResponse)(nil), "sifnode.clp.v1.MsgAddLiquidityResponse")
+	proto.RegisterType((*MsgModifyPmtpRates)(nil), "sifnode.clp.v1.MsgModifyPmtpRates")
+	proto.RegisterType((*MsgModifyPmtpRatesResponse)(nil), "sifnode.clp.v1.MsgModifyPmtpRatesResponse")
+	proto.RegisterType((*MsgUpdatePmtpParams)(nil), "sifnode.clp.v1.MsgUpdatePmtpParams")
+	proto.RegisterType((*MsgUpdatePmtpParamsResponse)(nil), "sifnode.clp.v1.MsgUpdatePmtpParamsResponse")
+	proto.RegisterType((*MsgSwap)(nil), "sifnode.clp.v1.MsgSwap")
+	proto.RegisterType((*MsgSwapResponse)(nil), "sifnode.clp.v1.MsgSwapResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "sifnode.clp.v1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "sifnode.clp.v1.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgDecommissionPool)(nil), "sifnode.clp.v1.MsgDecommissionPool")
+	proto.RegisterType((*MsgDecommissionPoolResponse)(nil), "sifnode.clp.v1.MsgDecommissionPoolResponse")
+	proto.RegisterType((*MsgUnlockLiquidityRequest)(nil), "sifnode.clp.v1.MsgUnlockLiquidityRequest")
+	proto.RegisterType((*MsgUnlockLiquidityResponse)(nil), "sifnode.clp.v1.MsgUnlockLiquidityResponse")
+	proto.RegisterType((*MsgUpdateRewardsParamsRequest)(nil), "sifnode.clp.v1.MsgUpdateRewardsParamsRequest")
+	proto.RegisterType((*MsgUpdateRewardsParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateRewardsParamsResponse")
+	proto.RegisterType((*MsgAddRewardPeriodRequest)(nil), "sifnode.clp.v1.MsgAddRewardPeriodRequest")
+	proto.RegisterType((*MsgAddRewardPeriodResponse)(nil), "sifnode.clp.v1.MsgAddRewardPeriodResponse")
+	proto.RegisterType((*MsgAppendRewardPeriod)(nil), "sifnode.clp.v1.MsgAppendRewardPeriod")
+	proto.RegisterType((*MsgAppendRewardPeriodResponse)(nil), "sifnode.clp.v1.MsgAppendRewardPeriodResponse")
+	proto.RegisterType((*MsgEditRewardPeriod)(nil), "sifnode.clp.v1.MsgEditRewardPeriod")
+	proto.RegisterType((*MsgEditRewardPeriodResponse)(nil), "sifnode.clp.v1.MsgEditRewardPeriodResponse")
+	proto.RegisterType((*MsgDeleteRewardPeriod)(nil), "sifnode.clp.v1.MsgDeleteRewardPeriod")
+	proto.RegisterType((*MsgDeleteRewardPeriodResponse)(nil), "sifnode.clp.v1.MsgDeleteRewardPeriodResponse")
+	proto.RegisterType((*MsgFundRewardEscrow)(nil), "sifnode.clp.v1.MsgFundRewardEscrow")
+	proto.RegisterType((*MsgFundRewardEscrowResponse)(nil), "sifnode.clp.v1.MsgFundRewardEscrowResponse")
+	proto.RegisterType((*MsgRefundRewardEscrow)(nil), "sifnode.clp.v1.MsgRefundRewardEscrow")
+	proto.RegisterType((*MsgRefundRewardEscrowResponse)(nil), "sifnode.clp.v1.MsgRefundRewardEscrowResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "sifnode.clp.v1.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "sifnode.clp.v1.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "sifnode.clp.v1.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "sifnode.clp.v1.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MsgUpdateSwapFeeRate)(nil), "sifnode.clp.v1.MsgUpdateSwapFeeRate")
+	proto.RegisterType((*MsgUpdateSwapFeeRateResponse)(nil), "sifnode.clp.v1.MsgUpdateSwapFeeRateResponse")
+	proto.RegisterType((*MsgUpdateProtocolFeeRate)(nil), "sifnode.clp.v1.MsgUpdateProtocolFeeRate")
+	proto.RegisterType((*MsgUpdateProtocolFeeRateResponse)(nil), "sifnode.clp.v1.MsgUpdateProtocolFeeRateResponse")
+	proto.RegisterType((*MsgUpdatePoolPauseState)(nil), "sifnode.clp.v1.MsgUpdatePoolPauseState")
+	proto.RegisterType((*MsgUpdatePoolPauseStateResponse)(nil), "sifnode.clp.v1.MsgUpdatePoolPauseStateResponse")
+	proto.RegisterType((*MsgUpdatePoolSwapMode)(nil), "sifnode.clp.v1.MsgUpdatePoolSwapMode")
+	proto.RegisterType((*MsgUpdatePoolSwapModeResponse)(nil), "sifnode.clp.v1.MsgUpdatePoolSwapModeResponse")
+	proto.RegisterType((*MsgApprovePendingPool)(nil), "sifnode.clp.v1.MsgApprovePendingPool")
+	proto.RegisterType((*MsgApprovePendingPoolResponse)(nil), "sifnode.clp.v1.MsgApprovePendingPoolResponse")
+	proto.RegisterType((*MsgCancelPoolWindDown)(nil), "sifnode.clp.v1.MsgCancelPoolWindDown")
+	proto.RegisterType((*MsgCancelPoolWindDownResponse)(nil), "sifnode.clp.v1.MsgCancelPoolWindDownResponse")
+	proto.RegisterType((*MsgUpdateCircuitBreakerParams)(nil), "sifnode.clp.v1.MsgUpdateCircuitBreakerParams")
+	proto.RegisterType((*MsgUpdateCircuitBreakerParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateCircuitBreakerParamsResponse")
+	proto.RegisterType((*MsgCancelPmtpPolicy)(nil), "sifnode.clp.v1.MsgCancelPmtpPolicy")
+	proto.RegisterType((*MsgCancelPmtpPolicyResponse)(nil), "sifnode.clp.v1.MsgCancelPmtpPolicyResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "sifnode.clp.v1.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "sifnode.clp.v1.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgAddLiquiditySingleSided)(nil), "sifnode.clp.v1.MsgAddLiquiditySingleSided")
+	proto.RegisterType((*MsgAddLiquiditySingleSidedResponse)(nil), "sifnode.clp.v1.MsgAddLiquiditySingleSidedResponse")
 }
 
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.NativeAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExternalAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func init() { proto.RegisterFile("sifnode/clp/v1/tx.proto", fileDescriptor_a3bff5b30808c4f3) }
+
+var fileDescriptor_a3bff5b30808c4f3 = []byte{
+	// 2739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x6c, 0xdc, 0xc6,
+	0xf9, 0xf7, 0xee, 0x4a, 0xb2, 0xf4, 0x69, 0x25, 0xd9, 0xb4, 0x14, 0xad, 0xa8, 0xc7, 0x3a, 0x8c,
+	0x15, 0x39, 0x52, 0xa4, 0x8d, 0xfd, 0x4f, 0x90, 0x3f, 0x02, 0x04, 0x8d, 0x24, 0x2b, 0x89, 0x12,
+	0x2b, 0x5e, 0x50, 0x35, 0x12, 0x14, 0x28, 0x58, 0x8a, 0x1c, 0xad, 0x26, 0xe6, 0x92, 0x0c, 0xc9,
+	0xd5, 0xe3, 0x50, 0x34, 0x68, 0xd0, 0xa2, 0x68, 0x2f, 0x3d, 0xa4, 0x40, 0x81, 0x5e, 0x8a, 0x1e,
+	0x0b, 0xf4, 0xd6, 0x4b, 0x0f, 0x3d, 0xb5, 0x87, 0xdc, 0x1a, 0x14, 0x3d, 0xb4, 0x3d, 0xa8, 0x85,
+	0x0d, 0xe4, 0xd6, 0x8b, 0xce, 0x3d, 0x14, 0xf3, 0xe0, 0xf0, 0xb1, 0x5c, 0x89, 0x54, 0x54, 0x47,
+	0x05, 0x72, 0xb2, 0x38, 0xf3, 0x9b, 0xef, 0x3d, 0xdf, 0xf7, 0xcd, 0xcc, 0x1a, 0x26, 0x7d, 0xbc,
+	0x6b, 0x3b, 0x26, 0x6a, 0x18, 0x96, 0xdb, 0xd8, 0xbf, 0xd3, 0x08, 0x0e, 0x57, 0x5c, 0xcf, 0x09,
+	0x1c, 0x69, 0x94, 0x4f, 0xac, 0x18, 0x96, 0xbb, 0xb2, 0x7f, 0x47, 0x1e, 0x6f, 0x39, 0x2d, 0x87,
+	0x4e, 0x35, 0xc8, 0x5f, 0x0c, 0x25, 0xcb, 0xe9, 0xe5, 0x47, 0x2e, 0xf2, 0xf9, 0xdc, 0x74, 0x6a,
+	0xce, 0xd5, 0x3d, 0xbd, 0x1d, 0x4e, 0x3e, 0x63, 0x38, 0x7e, 0xdb, 0xf1, 0x1b, 0x3b, 0xba, 0x8f,
+	0x1a, 0x86, 0x83, 0x6d, 0x36, 0xae, 0xfc, 0xab, 0x04, 0x33, 0x5b, 0x7e, 0xeb, 0xa1, 0x6b, 0xea,
+	0x01, 0xda, 0x0e, 0xf4, 0x47, 0xd8, 0x6e, 0xa9, 0xe8, 0x40, 0xf7, 0xcc, 0x26, 0x5d, 0x2e, 0xbd,
+	0x00, 0x03, 0x3e, 0x6e, 0xd9, 0xc8, 0xab, 0x95, 0x6e, 0x96, 0x6e, 0x0f, 0xad, 0x5d, 0x3f, 0x39,
+	0xae, 0x8f, 0x1c, 0xe9, 0x6d, 0xeb, 0x35, 0x85, 0x8d, 0x2b, 0x2a, 0x07, 0x48, 0x4d, 0x18, 0x68,
+	0x63, 0x3b, 0x40, 0x5e, 0xad, 0x4c, 0xa1, 0xff, 0xff, 0xd9, 0x71, 0xfd, 0xca, 0xdf, 0x8f, 0xeb,
+	0x2f, 0xb5, 0x70, 0xb0, 0xd7, 0xd9, 0x59, 0x31, 0x9c, 0x76, 0x83, 0x8b, 0xc1, 0xfe, 0x59, 0xf6,
+	0xcd, 0x47, 0x8d, 0xc3, 0x06, 0x59, 0xc4, 0x35, 0xd9, 0xa2, 0xeb, 0x55, 0x4e, 0x87, 0x50, 0x64,
+	0x5a, 0xd4, 0x2a, 0xe7, 0xa5, 0xc8, 0xd4, 0x50, 0x39, 0x1d, 0xe5, 0x79, 0xb8, 0x75, 0x9a, 0xba,
+	0x2a, 0xf2, 0x5d, 0xc7, 0xf6, 0x91, 0xf2, 0x69, 0x3f, 0x48, 0x5b, 0x7e, 0x4b, 0x45, 0x6d, 0x67,
+	0x1f, 0xdd, 0xc7, 0x1f, 0x75, 0xb0, 0x89, 0x83, 0xa3, 0x22, 0xd6, 0x78, 0x1f, 0x46, 0xd1, 0x61,
+	0x80, 0x3c, 0x5b, 0xb7, 0x34, 0xdd, 0xf7, 0x51, 0x40, 0xad, 0x32, 0x7c, 0x77, 0x62, 0x25, 0xe9,
+	0xe9, 0x95, 0x55, 0x32, 0xb9, 0x36, 0x75, 0x72, 0x5c, 0x9f, 0x60, 0x94, 0x92, 0xcb, 0x14, 0x75,
+	0x24, 0x1c, 0xa0, 0x48, 0xa9, 0x0d, 0xa3, 0x07, 0xda, 0x8e, 0xee, 0x63, 0x5f, 0x73, 0x1d, 0x6c,
+	0x07, 0xa1, 0x71, 0xde, 0xe2, 0xc6, 0x79, 0xfe, 0x54, 0xe3, 0x30, 0xab, 0x6c, 0xda, 0x41, 0xc4,
+	0x2f, 0x49, 0x4d, 0x51, 0xab, 0x07, 0x6b, 0xe4, 0xbb, 0x49, 0x3f, 0xa5, 0xef, 0xc0, 0x90, 0xee,
+	0x1f, 0xb5, 0xdb, 0x28, 0xf0, 0x8e, 0x6a, 0x7d, 0x94, 0xd3, 0x5a, 0x61, 0x4e, 0xd7, 0x18, 0x27,
+	0x41, 0x48, 0x51, 0x23, 0xa2, 0x92, 0x0d, 0xa3, 0x6d, 0x6c, 0x6b, 0xb6, 0x1e, 0xe0, 0x7d, 0xa4,
+	0x39, 0x9d, 0xa0, 0xd6, 0x4f, 0xd9, 0xbc, 0xcd, 0xd9, 0x2c, 0xe4, 0x60, 0xf3, 0x10, 0xc7, 0x35,
+	0x4a, 0x92, 0x53, 0xd4, 0x6a, 0x1b, 0xdb, 0xef, 0xd1, 0xef, 0x07, 0x9d, 0x40, 0x0a, 0xe0, 0x1a,
+	0x01, 0x08, 0x33, 0x13, 0x8e, 0x03, 0x94, 0xe3, 0x3b, 0xc5, 0x39, 0x4e, 0x46, 0x1c, 0xe3, 0x04,
+	0x15, 0x95, 0xe8, 0xb4, 0xc1, 0x47, 0x08, 0xd7, 0x75, 0x18, 0x33, 0x91, 0x6e, 0x5a, 0xd8, 0x46,
+	0xda, 0x1e, 0xc2, 0xad, 0xbd, 0xa0, 0x76, 0xf5, 0x66, 0xe9, 0x76, 0x65, 0x4d, 0x3e, 0x39, 0xae,
+	0x3f, 0xc3, 0xa8, 0xa4, 0x00, 0x8a, 0x3a, 0x1a, 0x8e, 0xbc, 0xcd, 0x06, 0x66, 0x40, 0xee, 0x8e,
+	0x4a, 0x11, 0xb4, 0xbf, 0xeb, 0x83, 0xc9, 0xee, 0xe9, 0x87, 0x36, 0x0e, 0xfc, 0x4b, 0x11, 0xb9,
+	0x0e, 0x8c, 0x1e, 0xe0, 0x60, 0xcf, 0xf4, 0xf4, 0x03, 0xad, 0x63, 0x63, 0x11, 0xb9, 0xe7, 0x77,
+	0x74, 0x92, 0x9c, 0xa2, 0x8e, 0x84, 0x03, 0x4c, 0xe9, 0xee, 0xc8, 0xea, 0x7b, 0xea, 0x91, 0xd5,
+	0xff, 0x55, 0x44, 0xd6, 0x40, 0xe1, 0xc8, 0x7a, 0x16, 0xea, 0x3d, 0x42, 0x47, 0x84, 0xd7, 0x6f,
+	0xca, 0xb4, 0x56, 0x7c, 0xd3, 0xd3, 0x6d, 0x7f, 0x17, 0x79, 0x02, 0xd5, 0x74, 0x7c, 0x1c, 0x60,
+	0xc7, 0x2e, 0x12, 0x63, 0x77, 0x61, 0xc8, 0x43, 0x06, 0x76, 0x31, 0xb2, 0x03, 0x5e, 0x2e, 0xc6,
+	0xa3, 0x3c, 0x21, 0xa6, 0x14, 0x35, 0x82, 0x65, 0xc4, 0x65, 0xe5, 0x62, 0xe2, 0xf2, 0x21, 0xf4,
+	0xb3, 0x70, 0x64, 0xd1, 0xf1, 0x8d, 0xe2, 0xbe, 0xaa, 0x32, 0x3e, 0x3c, 0x0a, 0x19, 0x35, 0x5e,
+	0x6b, 0x7a, 0x9a, 0x4b, 0xd8, 0xf5, 0xe7, 0x15, 0x18, 0xd9, 0xf2, 0x5b, 0xeb, 0x1e, 0xd2, 0x03,
+	0xd4, 0x74, 0x1c, 0xeb, 0x52, 0x6c, 0xd6, 0xef, 0xc2, 0x0d, 0x1e, 0xe8, 0x74, 0x5e, 0xd3, 0xdb,
+	0x4e, 0xc7, 0x0e, 0xf8, 0x8e, 0xdd, 0x2a, 0x6e, 0x22, 0x99, 0x71, 0xcd, 0xa0, 0xa9, 0xa8, 0xd7,
+	0xd9, 0x28, 0x65, 0xbc, 0x4a, 0xc7, 0xa4, 0x4f, 0x4a, 0x30, 0x91, 0x94, 0x30, 0x94, 0x80, 0x39,
+	0xe9, 0x41, 0x71, 0x09, 0x66, 0xb2, 0xf4, 0x16, 0x32, 0xdc, 0x48, 0xa8, 0xcf, 0xa4, 0x50, 0x26,
+	0x61, 0x22, 0xe1, 0x19, 0xe1, 0xb3, 0x3f, 0xf5, 0xc1, 0xd8, 0x96, 0xdf, 0x5a, 0x35, 0xcd, 0xcb,
+	0xd5, 0x1c, 0x7c, 0xed, 0x35, 0x3b, 0x08, 0xd3, 0xbe, 0xeb, 0x38, 0x16, 0xaf, 0x33, 0x17, 0xd1,
+	0x50, 0x44, 0xe4, 0x58, 0xda, 0x27, 0xf1, 0xc0, 0xca, 0xcc, 0x85, 0x24, 0xe0, 0x29, 0x98, 0x4c,
+	0x05, 0x94, 0x08, 0xb6, 0x5f, 0x96, 0x68, 0x33, 0xba, 0xe5, 0x98, 0x78, 0xf7, 0xa8, 0xd9, 0x0e,
+	0x5c, 0x55, 0x0f, 0x50, 0xa1, 0x92, 0x3e, 0x0b, 0xb0, 0x63, 0x39, 0xc6, 0x23, 0xcd, 0xd3, 0x03,
+	0xc4, 0xf2, 0xad, 0x3a, 0x44, 0x47, 0x08, 0x29, 0xe9, 0x59, 0xa8, 0x7a, 0x1d, 0xdb, 0xc6, 0x76,
+	0x8b, 0x01, 0x68, 0xb8, 0xa8, 0xc3, 0x7c, 0x8c, 0x42, 0x66, 0x01, 0x90, 0x6d, 0x6a, 0xae, 0x63,
+	0x61, 0x83, 0xf5, 0x81, 0x83, 0xea, 0x10, 0xb2, 0xcd, 0x26, 0x1d, 0xe0, 0x8d, 0x49, 0x4a, 0x42,
+	0xa1, 0xc0, 0xaf, 0xca, 0x70, 0x43, 0xb4, 0xdd, 0x64, 0xba, 0xf8, 0xe1, 0xe2, 0x75, 0x98, 0x76,
+	0xdb, 0x81, 0xab, 0xb9, 0xc8, 0xc3, 0x8e, 0xa9, 0xb5, 0x9c, 0x7d, 0xe2, 0x76, 0xdb, 0x40, 0x71,
+	0x95, 0x6a, 0x04, 0xd2, 0xa4, 0x88, 0xb7, 0x04, 0x80, 0x8a, 0xff, 0x2a, 0xd4, 0xe2, 0xcb, 0x91,
+	0xeb, 0x18, 0x7b, 0x9a, 0x85, 0xec, 0x56, 0xb0, 0x47, 0xb5, 0xad, 0xa8, 0x13, 0xd1, 0xda, 0x0d,
+	0x32, 0x7b, 0x9f, 0x4e, 0x4a, 0xaf, 0xc0, 0x64, 0x7c, 0xa1, 0x1f, 0xe8, 0x5e, 0xa0, 0x51, 0xcb,
+	0x51, 0x23, 0x54, 0xd4, 0xf1, 0x68, 0xdd, 0x36, 0x99, 0x5c, 0x23, 0x73, 0xd2, 0x1d, 0x98, 0x48,
+	0xf0, 0xb3, 0x4d, 0xbe, 0xa8, 0x9f, 0x2e, 0x92, 0x62, 0xcc, 0x6c, 0x93, 0x2e, 0x51, 0x5e, 0x83,
+	0xe9, 0x0c, 0x1b, 0x85, 0x36, 0x94, 0xa6, 0x61, 0x88, 0x19, 0x5f, 0xc3, 0x26, 0x35, 0x57, 0x9f,
+	0x3a, 0xc8, 0x06, 0x36, 0x4d, 0xe5, 0xc7, 0x7d, 0x70, 0x75, 0xcb, 0x6f, 0x6d, 0x1f, 0xe8, 0x6e,
+	0x11, 0xa3, 0xbe, 0x0b, 0xe0, 0x23, 0x3b, 0xc8, 0x93, 0x82, 0x26, 0x4e, 0x8e, 0xeb, 0xd7, 0x39,
+	0x15, 0xb1, 0x44, 0x51, 0x87, 0xc8, 0x07, 0x4b, 0x3d, 0xef, 0xc3, 0xa8, 0x87, 0x0c, 0x84, 0xf7,
+	0x91, 0x59, 0xb0, 0x3c, 0x27, 0x97, 0x29, 0xea, 0x48, 0x38, 0xc0, 0x08, 0xef, 0xc2, 0x30, 0x63,
+	0x19, 0xcf, 0x24, 0x1b, 0xc5, 0xf7, 0xb2, 0x14, 0x17, 0x9f, 0xe7, 0x0f, 0xaa, 0x3f, 0x4f, 0x1b,
+	0x1f, 0x97, 0x60, 0x9c, 0x6c, 0x74, 0xc6, 0x9d, 0x6c, 0x06, 0xce, 0x91, 0x65, 0x8f, 0xf7, 0x8a,
+	0x73, 0x9c, 0x8e, 0xb2, 0x47, 0x9a, 0xa8, 0xa2, 0x4a, 0x6d, 0x6c, 0xab, 0xe1, 0x28, 0x17, 0xe1,
+	0x42, 0x32, 0xc9, 0xab, 0x30, 0xc6, 0x63, 0x41, 0x04, 0xcf, 0x2d, 0x18, 0xfd, 0xa8, 0x83, 0x3a,
+	0xc8, 0xd4, 0xfc, 0x03, 0xdd, 0x8d, 0x22, 0xa8, 0xca, 0x46, 0x09, 0x76, 0xd3, 0x54, 0xbe, 0x28,
+	0x43, 0x35, 0x5c, 0xe9, 0x74, 0x02, 0x54, 0x24, 0x94, 0xde, 0x80, 0x01, 0xea, 0x3d, 0xbf, 0x56,
+	0xbe, 0x59, 0xe9, 0xed, 0xf5, 0x18, 0x05, 0x06, 0x57, 0x54, 0xbe, 0x2e, 0xed, 0xe6, 0xca, 0x53,
+	0x77, 0x73, 0xdf, 0xd3, 0x72, 0xb3, 0xf2, 0x0c, 0x8c, 0xc7, 0xed, 0x2c, 0xf2, 0xe4, 0x17, 0x25,
+	0x9a, 0x27, 0xef, 0x21, 0xc3, 0x69, 0xb7, 0xb1, 0xef, 0x63, 0xc7, 0x2e, 0xda, 0x0f, 0x12, 0xe8,
+	0x51, 0x7b, 0xc7, 0xb1, 0x6a, 0xe5, 0x2e, 0x28, 0x1d, 0x27, 0x50, 0xfa, 0x87, 0xf4, 0x1a, 0x54,
+	0x5b, 0x9e, 0x6e, 0x20, 0x9e, 0xa4, 0x58, 0x1e, 0x5c, 0x9b, 0x3c, 0x39, 0xae, 0xdf, 0x60, 0x0b,
+	0xe2, 0xb3, 0x8a, 0x3a, 0x4c, 0x3f, 0x59, 0xd2, 0x92, 0x5e, 0x87, 0x11, 0x57, 0x3f, 0x72, 0x3a,
+	0x81, 0xc6, 0xb9, 0x31, 0xe3, 0xd5, 0x4e, 0x8e, 0xeb, 0xe3, 0x6c, 0x71, 0x62, 0x5a, 0x51, 0xab,
+	0xec, 0x7b, 0x9b, 0x7d, 0xce, 0xc2, 0x74, 0x86, 0x9e, 0x71, 0x3b, 0x4c, 0x91, 0x5c, 0x68, 0x93,
+	0xc4, 0x18, 0xab, 0x87, 0x1f, 0x75, 0x90, 0x1f, 0x5c, 0x8a, 0x3e, 0x6b, 0x23, 0x3c, 0x32, 0xb0,
+	0x30, 0x6d, 0x14, 0x0c, 0x9a, 0xf0, 0x88, 0xc0, 0xca, 0x66, 0x97, 0x9e, 0xdc, 0x0c, 0x7f, 0x29,
+	0xc1, 0xac, 0x28, 0x09, 0xec, 0x9a, 0xca, 0x0f, 0xab, 0x42, 0x61, 0x53, 0xac, 0xc2, 0xac, 0x15,
+	0x72, 0xd0, 0x3c, 0x72, 0xce, 0xd3, 0x2d, 0x8d, 0xf6, 0x04, 0xdc, 0xfd, 0x65, 0x9a, 0x11, 0x64,
+	0x2b, 0x12, 0x83, 0x62, 0xee, 0x3b, 0xc6, 0x23, 0xee, 0xf4, 0x0d, 0xa8, 0x77, 0x93, 0x30, 0x48,
+	0x8d, 0xb5, 0xe2, 0x31, 0xd4, 0xa7, 0xce, 0xa4, 0x89, 0xac, 0x53, 0x10, 0x23, 0xa3, 0xdc, 0x84,
+	0xb9, 0x5e, 0x5a, 0x71, 0xc5, 0x7f, 0xc2, 0xfc, 0xbf, 0x6a, 0x9a, 0x6c, 0x9e, 0x2d, 0x3c, 0x87,
+	0xd2, 0xeb, 0xa4, 0x26, 0x11, 0x0a, 0x5c, 0xbe, 0x30, 0x3b, 0xcd, 0xa4, 0xfd, 0x9f, 0xe0, 0x33,
+	0xe2, 0xc5, 0xbe, 0x42, 0x27, 0x75, 0x09, 0xc3, 0x65, 0xfd, 0x41, 0x89, 0x9e, 0x11, 0x56, 0x5d,
+	0x17, 0xd9, 0x09, 0x44, 0x31, 0xe7, 0x8c, 0x24, 0xe4, 0xe4, 0x61, 0x7a, 0xba, 0x98, 0xd5, 0xb8,
+	0x98, 0x4a, 0x1d, 0x66, 0x33, 0xc5, 0x10, 0x82, 0x7e, 0xc2, 0x92, 0xcb, 0x86, 0x89, 0x83, 0xaf,
+	0x50, 0x4c, 0xb6, 0xf3, 0xd3, 0x42, 0x08, 0x21, 0x2d, 0x6a, 0xcc, 0x7b, 0xc8, 0x42, 0x01, 0x8a,
+	0x03, 0x8a, 0x48, 0x79, 0x1b, 0xae, 0x25, 0xa4, 0xd4, 0x30, 0x13, 0x74, 0x48, 0x1d, 0x8d, 0x8b,
+	0xb2, 0x19, 0xda, 0xac, 0x9b, 0x9b, 0x10, 0xe7, 0x8f, 0xcc, 0x66, 0x6f, 0x76, 0x42, 0x9b, 0x6e,
+	0xf8, 0x86, 0xe7, 0x1c, 0xfc, 0x57, 0xa4, 0x91, 0x3e, 0x80, 0x81, 0x44, 0xed, 0x7b, 0xa3, 0x78,
+	0x25, 0x0a, 0x4b, 0x2b, 0xaf, 0x3d, 0x9c, 0x1e, 0x37, 0x7a, 0x5a, 0x8b, 0x94, 0xd1, 0x55, 0xb4,
+	0xfb, 0x34, 0xd4, 0x54, 0x2c, 0x98, 0xcd, 0xe4, 0x26, 0x9a, 0x95, 0x77, 0x61, 0xd0, 0xa3, 0xb3,
+	0xc8, 0xac, 0x95, 0xce, 0x97, 0x5e, 0x05, 0x01, 0xe5, 0x6f, 0x15, 0x7a, 0x76, 0x6a, 0x5a, 0xba,
+	0x81, 0xee, 0xe3, 0x36, 0x0e, 0x1e, 0x78, 0x26, 0x2f, 0x93, 0x5f, 0x37, 0xc9, 0xe7, 0xe8, 0x9e,
+	0x10, 0x0c, 0x5b, 0xc4, 0x8c, 0x9a, 0xeb, 0x61, 0x03, 0xf1, 0xd6, 0xf8, 0x5e, 0x81, 0x07, 0x81,
+	0x7b, 0xc8, 0x88, 0xd8, 0xc4, 0x48, 0x29, 0x2a, 0xd0, 0xaf, 0x26, 0xf9, 0x90, 0x9e, 0x83, 0x11,
+	0x74, 0xe8, 0x62, 0xef, 0x28, 0xd1, 0x06, 0xab, 0x55, 0x36, 0xc8, 0x1b, 0xdd, 0x17, 0x41, 0xee,
+	0x76, 0xad, 0x08, 0xa3, 0x51, 0x28, 0x8b, 0x3e, 0xb7, 0x8c, 0x4d, 0xa5, 0x49, 0xb7, 0x32, 0xab,
+	0x44, 0xe7, 0x8b, 0x04, 0x46, 0xb1, 0x2c, 0x28, 0xb2, 0x6d, 0x95, 0xa6, 0x28, 0xb6, 0xd5, 0x0f,
+	0xcb, 0x30, 0x2e, 0x0a, 0x1d, 0x69, 0xf6, 0xde, 0x44, 0xec, 0x30, 0x7a, 0x19, 0x1a, 0x98, 0x0f,
+	0x61, 0x84, 0x1e, 0x05, 0x76, 0x11, 0x8a, 0x9d, 0xf9, 0xd7, 0xde, 0x2c, 0xec, 0x49, 0xde, 0xee,
+	0x25, 0x88, 0x29, 0xea, 0xb0, 0x1f, 0xe9, 0xab, 0xcc, 0xc5, 0xdf, 0x18, 0xa3, 0x71, 0x61, 0xa8,
+	0xdf, 0x97, 0xa0, 0x16, 0x1d, 0x7d, 0x3d, 0x27, 0x70, 0x0c, 0xc7, 0x3a, 0x87, 0xb1, 0xf6, 0xe1,
+	0xba, 0xcb, 0x57, 0x47, 0x7a, 0x95, 0x13, 0xf7, 0xef, 0xf9, 0xf5, 0xaa, 0x31, 0x1e, 0x5d, 0x04,
+	0x15, 0x75, 0xcc, 0x4d, 0x8a, 0xa8, 0x28, 0x70, 0xb3, 0x97, 0xf8, 0x42, 0xc7, 0x1f, 0x95, 0x61,
+	0x32, 0x02, 0x39, 0x8e, 0xd5, 0xd4, 0x3b, 0x3e, 0x79, 0x82, 0xbc, 0x24, 0xf1, 0xf0, 0x2c, 0x54,
+	0x89, 0xcb, 0x7c, 0xcd, 0x25, 0x72, 0xb1, 0x46, 0x6e, 0x90, 0xb9, 0xd1, 0xa7, 0xa2, 0x9a, 0x52,
+	0x1d, 0x86, 0x75, 0xd3, 0x14, 0x08, 0x76, 0x07, 0x04, 0x64, 0x88, 0x03, 0xe6, 0x49, 0x72, 0x23,
+	0x0f, 0x08, 0x02, 0xd3, 0x4f, 0x31, 0x23, 0x7c, 0x94, 0xc1, 0xf8, 0x53, 0x43, 0x96, 0x25, 0x84,
+	0xb5, 0xfe, 0xcc, 0x9a, 0xaa, 0x08, 0x43, 0xc2, 0x66, 0xcb, 0x31, 0x2f, 0x87, 0xad, 0x5e, 0x81,
+	0x21, 0x1a, 0xee, 0x6d, 0xc7, 0x64, 0xfb, 0x66, 0xf4, 0x6e, 0x2d, 0x4d, 0x33, 0x14, 0x58, 0x1d,
+	0xf4, 0xf9, 0x5f, 0xbc, 0xdb, 0xe8, 0xd6, 0x49, 0x68, 0xfd, 0x0b, 0xd1, 0x4a, 0x7a, 0xce, 0x3e,
+	0x6a, 0x22, 0xdb, 0xc4, 0x76, 0xeb, 0xb2, 0x3c, 0x08, 0x44, 0x0d, 0x66, 0x4a, 0xb8, 0xb4, 0xf8,
+	0xbc, 0xd7, 0x77, 0x1c, 0xeb, 0x7d, 0x6c, 0x9b, 0xf7, 0x9c, 0x03, 0xfb, 0x12, 0x89, 0xdf, 0x2d,
+	0x9c, 0x10, 0xff, 0xb7, 0xe5, 0x98, 0x7f, 0xd6, 0xb1, 0x67, 0x74, 0x70, 0xb0, 0xe6, 0x21, 0xfd,
+	0x11, 0xf2, 0x8a, 0x5f, 0x57, 0xfa, 0x70, 0xad, 0xad, 0x1f, 0xb2, 0xca, 0xa6, 0xe1, 0xb6, 0xab,
+	0x1b, 0xe1, 0x33, 0xd7, 0x66, 0xe1, 0x4c, 0x14, 0x3e, 0x04, 0xa6, 0xe8, 0x91, 0x87, 0x40, 0xfd,
+	0x90, 0x96, 0xcb, 0x4d, 0x3a, 0x90, 0x64, 0x6a, 0xec, 0xe9, 0x76, 0x2b, 0x4c, 0xeb, 0x17, 0xc0,
+	0x94, 0xd1, 0x8b, 0x31, 0x5d, 0x67, 0x03, 0x0b, 0x30, 0x7f, 0xaa, 0xd5, 0x84, 0x7d, 0xbf, 0x1d,
+	0xab, 0xbf, 0xf4, 0x7e, 0x93, 0x5e, 0x5e, 0x16, 0x31, 0x6a, 0xe2, 0x0a, 0xb4, 0x9c, 0xba, 0x02,
+	0x8d, 0x17, 0xe3, 0x88, 0xbc, 0xe0, 0xfe, 0xb3, 0x12, 0xbd, 0x15, 0x5b, 0xb7, 0x74, 0xdc, 0xe6,
+	0x87, 0xce, 0x4b, 0x11, 0x96, 0x1f, 0x97, 0x60, 0x32, 0x25, 0x97, 0xe8, 0x60, 0x10, 0x5c, 0x35,
+	0xc8, 0x38, 0xed, 0x83, 0xc9, 0xb1, 0x75, 0x6a, 0x85, 0x79, 0x6b, 0x85, 0xfc, 0x8c, 0x67, 0x65,
+	0xff, 0xce, 0x0e, 0x0a, 0xf4, 0x3b, 0x2b, 0xeb, 0x0e, 0xb6, 0xd7, 0x5e, 0x22, 0x1e, 0xfe, 0xf5,
+	0x3f, 0xea, 0xb7, 0x73, 0x78, 0x98, 0x2c, 0xf0, 0xd5, 0x90, 0xb6, 0xf2, 0xef, 0x4a, 0x78, 0xc0,
+	0x15, 0x57, 0x10, 0xdb, 0xd8, 0x6e, 0x59, 0x68, 0x1b, 0x9b, 0xc8, 0xbc, 0x14, 0x19, 0x37, 0xd9,
+	0x83, 0x57, 0xbe, 0x5c, 0x0f, 0x1e, 0x9d, 0xb3, 0xfa, 0x2e, 0xf6, 0x9c, 0xf5, 0xbf, 0xf9, 0xf0,
+	0xf4, 0x69, 0x19, 0x94, 0xde, 0xee, 0x17, 0xc1, 0xd8, 0x04, 0xda, 0x0c, 0x84, 0x07, 0x8c, 0x73,
+	0x1e, 0xcc, 0x80, 0xd0, 0xe0, 0x47, 0x89, 0x90, 0xa2, 0x87, 0xfc, 0x8e, 0x15, 0xa6, 0xc7, 0xf3,
+	0x51, 0x54, 0x29, 0x09, 0xe9, 0x1d, 0x18, 0xb4, 0x5c, 0xed, 0x4b, 0x5d, 0xcc, 0x5d, 0xb5, 0x5c,
+	0x6a, 0xdb, 0xbb, 0x7f, 0x98, 0x82, 0xca, 0x96, 0xdf, 0x92, 0x74, 0x18, 0x4b, 0xff, 0x0a, 0x4c,
+	0x49, 0x47, 0x5e, 0xf7, 0x2f, 0x27, 0xe4, 0xc5, 0xb3, 0x31, 0xc2, 0xb4, 0x2e, 0x8c, 0x67, 0xfe,
+	0x66, 0x67, 0xe1, 0x6c, 0x1a, 0x14, 0x28, 0x37, 0x72, 0x02, 0x05, 0x47, 0x15, 0x20, 0xf6, 0x73,
+	0x83, 0xd9, 0x8c, 0xe5, 0xd1, 0xb4, 0x3c, 0x7f, 0xea, 0xb4, 0xa0, 0xf9, 0x01, 0x54, 0x13, 0xcf,
+	0xe1, 0xf5, 0x8c, 0x65, 0x71, 0x80, 0xbc, 0x70, 0x06, 0x40, 0x50, 0x7e, 0x03, 0xfa, 0xe8, 0xcb,
+	0xd6, 0x64, 0xc6, 0x02, 0x32, 0x21, 0xd7, 0x7b, 0x4c, 0x08, 0x0a, 0x0f, 0x60, 0x28, 0x7a, 0xd5,
+	0x98, 0xe9, 0x85, 0x26, 0xb3, 0xf2, 0xad, 0xd3, 0x66, 0x05, 0x41, 0x13, 0xae, 0x75, 0xdd, 0xd2,
+	0x3f, 0x97, 0xb1, 0x32, 0x0d, 0x92, 0x97, 0x72, 0x80, 0x04, 0x97, 0x3d, 0x18, 0x4b, 0xdd, 0x0d,
+	0x4b, 0x2f, 0x64, 0xac, 0xcf, 0xbe, 0x27, 0x97, 0x17, 0xf3, 0x40, 0x39, 0xa7, 0x00, 0x6e, 0x64,
+	0x5c, 0xc8, 0x4a, 0xcb, 0x59, 0x24, 0x7a, 0x5e, 0x47, 0xcb, 0x2b, 0x79, 0xe1, 0x91, 0x7e, 0xa9,
+	0x6b, 0xd5, 0x4c, 0xfd, 0xb2, 0xef, 0x81, 0xe5, 0xc5, 0x3c, 0x50, 0xce, 0x49, 0x87, 0xb1, 0xf4,
+	0xf3, 0x79, 0xd6, 0x2e, 0x4e, 0x61, 0xe4, 0xc5, 0xb3, 0x31, 0xf1, 0x90, 0xe8, 0x7a, 0xe0, 0x7e,
+	0xae, 0xa7, 0x41, 0x22, 0x90, 0xbc, 0x94, 0x03, 0x24, 0xb8, 0x7c, 0x0f, 0xa6, 0x7a, 0xff, 0x58,
+	0xf7, 0xc5, 0x9e, 0x94, 0x32, 0xd0, 0xf2, 0xcb, 0x45, 0xd0, 0x71, 0x4b, 0xa6, 0x2f, 0xd3, 0xb2,
+	0x2c, 0x99, 0xc2, 0xc8, 0x8b, 0x67, 0x63, 0xe2, 0x96, 0xec, 0xba, 0xa6, 0xc9, 0xb2, 0x64, 0x1a,
+	0x24, 0x2f, 0xe5, 0x00, 0xc5, 0x2d, 0xd9, 0xfb, 0xa7, 0x6c, 0x59, 0x96, 0xec, 0x89, 0x96, 0x5f,
+	0x2e, 0x82, 0x16, 0x02, 0xb4, 0xe0, 0x7a, 0xf7, 0xdd, 0xd0, 0xad, 0xde, 0x4e, 0x89, 0x50, 0xf2,
+	0x8b, 0x79, 0x50, 0x82, 0x91, 0x0f, 0x13, 0xd9, 0x77, 0x2b, 0xb7, 0x7b, 0x47, 0x5e, 0x12, 0x29,
+	0xbf, 0x94, 0x17, 0x19, 0x2f, 0x6a, 0x99, 0x97, 0x1d, 0x0b, 0xbd, 0x29, 0x25, 0x80, 0x72, 0x23,
+	0x27, 0x50, 0x70, 0xfc, 0x7e, 0x09, 0xe4, 0x53, 0x4e, 0x6f, 0xbd, 0x73, 0x59, 0x16, 0x5c, 0x7e,
+	0xa5, 0x10, 0xbc, 0x3b, 0x76, 0x63, 0x47, 0x9c, 0xde, 0xb1, 0x1b, 0x81, 0xe4, 0xa5, 0x1c, 0xa0,
+	0x78, 0xad, 0x4d, 0x9c, 0x64, 0xb2, 0x0a, 0x60, 0x1c, 0x20, 0x2f, 0x9c, 0x01, 0x10, 0x94, 0x3f,
+	0x04, 0x29, 0xe3, 0x29, 0x2b, 0xab, 0x05, 0xe8, 0x86, 0xc9, 0xcb, 0xb9, 0x60, 0x71, 0x5b, 0x75,
+	0xbd, 0x46, 0x65, 0xd9, 0x2a, 0x0d, 0x92, 0x97, 0x72, 0x80, 0xe2, 0x1a, 0x65, 0xbc, 0x27, 0xcd,
+	0x67, 0xd6, 0xe1, 0x34, 0x4c, 0x5e, 0xce, 0x05, 0x8b, 0x6b, 0xd4, 0xf5, 0x56, 0x94, 0xa5, 0x51,
+	0x1a, 0x24, 0x2f, 0xe5, 0x00, 0xc5, 0x35, 0xca, 0x78, 0xac, 0x99, 0xcf, 0x6c, 0x02, 0xd3, 0x30,
+	0x79, 0x39, 0x17, 0x4c, 0xf0, 0x3a, 0x82, 0xc9, 0x5e, 0x07, 0xc3, 0xc5, 0x33, 0xfa, 0xb7, 0x18,
+	0x56, 0xbe, 0x9b, 0x1f, 0x1b, 0x57, 0x33, 0xe3, 0x02, 0x70, 0xfe, 0xd4, 0xb4, 0x10, 0xc2, 0xe4,
+	0xe5, 0x5c, 0xb0, 0x54, 0xd8, 0xa7, 0xaf, 0xdd, 0x7a, 0x84, 0x7d, 0x0a, 0x26, 0x2f, 0xe7, 0x82,
+	0xc5, 0x79, 0x65, 0xdc, 0x91, 0xcd, 0xf7, 0xde, 0xff, 0x31, 0x98, 0xbc, 0x9c, 0x0b, 0x16, 0xf2,
+	0x5a, 0x5b, 0xfd, 0xec, 0xf1, 0x5c, 0xe9, 0xf3, 0xc7, 0x73, 0xa5, 0x7f, 0x3e, 0x9e, 0x2b, 0xfd,
+	0xf4, 0xc9, 0xdc, 0x95, 0xcf, 0x9f, 0xcc, 0x5d, 0xf9, 0xeb, 0x93, 0xb9, 0x2b, 0xdf, 0x8a, 0x9f,
+	0x88, 0xb6, 0xf1, 0xae, 0xb1, 0xa7, 0x63, 0xbb, 0xc1, 0x69, 0x37, 0x0e, 0xe9, 0x7f, 0x22, 0xa2,
+	0xc7, 0xa2, 0x9d, 0x01, 0x7a, 0xdd, 0xfd, 0x7f, 0xff, 0x19, 0x00, 0x6d, 0x69, 0xd4, 0x8f, 0xbb,
+	0x34, 0x00, 0x00,
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error)
+	RemoveLiquidityUnits(ctx context.Context, in *MsgRemoveLiquidityUnits, opts ...grpc.CallOption) (*MsgRemoveLiquidityUnitsResponse, error)
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
+	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error)
+	UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidityRequest, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error)
+	UpdateRewardsParams(ctx context.Context, in *MsgUpdateRewardsParamsRequest, opts ...grpc.CallOption) (*MsgUpdateRewardsParamsResponse, error)
+	AddRewardPeriod(ctx context.Context, in *MsgAddRewardPeriodRequest, opts ...grpc.CallOption) (*MsgAddRewardPeriodResponse, error)
+	ModifyPmtpRates(ctx context.Context, in *MsgModifyPmtpRates, opts ...grpc.CallOption) (*MsgModifyPmtpRatesResponse, error)
+	UpdatePmtpParams(ctx context.Context, in *MsgUpdatePmtpParams, opts ...grpc.CallOption) (*MsgUpdatePmtpParamsResponse, error)
+	UpdateStakingRewardParams(ctx context.Context, in *MsgUpdateStakingRewardParams, opts ...grpc.CallOption) (*MsgUpdateStakingRewardParamsResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	TransferLiquidityPosition(ctx context.Context, in *MsgTransferLiquidityPosition, opts ...grpc.CallOption) (*MsgTransferLiquidityPositionResponse, error)
+	UpdateSwapFeeRate(ctx context.Context, in *MsgUpdateSwapFeeRate, opts ...grpc.CallOption) (*MsgUpdateSwapFeeRateResponse, error)
+	UpdateProtocolFeeRate(ctx context.Context, in *MsgUpdateProtocolFeeRate, opts ...grpc.CallOption) (*MsgUpdateProtocolFeeRateResponse, error)
+	UpdatePoolPauseState(ctx context.Context, in *MsgUpdatePoolPauseState, opts ...grpc.CallOption) (*MsgUpdatePoolPauseStateResponse, error)
+	UpdateCircuitBreakerParams(ctx context.Context, in *MsgUpdateCircuitBreakerParams, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerParamsResponse, error)
+	CancelPmtpPolicy(ctx context.Context, in *MsgCancelPmtpPolicy, opts ...grpc.CallOption) (*MsgCancelPmtpPolicyResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	AppendRewardPeriod(ctx context.Context, in *MsgAppendRewardPeriod, opts ...grpc.CallOption) (*MsgAppendRewardPeriodResponse, error)
+	EditRewardPeriod(ctx context.Context, in *MsgEditRewardPeriod, opts ...grpc.CallOption) (*MsgEditRewardPeriodResponse, error)
+	DeleteRewardPeriod(ctx context.Context, in *MsgDeleteRewardPeriod, opts ...grpc.CallOption) (*MsgDeleteRewardPeriodResponse, error)
+	FundRewardEscrow(ctx context.Context, in *MsgFundRewardEscrow, opts ...grpc.CallOption) (*MsgFundRewardEscrowResponse, error)
+	RefundRewardEscrow(ctx context.Context, in *MsgRefundRewardEscrow, opts ...grpc.CallOption) (*MsgRefundRewardEscrowResponse, error)
+	AddLiquiditySingleSided(ctx context.Context, in *MsgAddLiquiditySingleSided, opts ...grpc.CallOption) (*MsgAddLiquiditySingleSidedResponse, error)
+	UpdatePoolSwapMode(ctx context.Context, in *MsgUpdatePoolSwapMode, opts ...grpc.CallOption) (*MsgUpdatePoolSwapModeResponse, error)
+	ApprovePendingPool(ctx context.Context, in *MsgApprovePendingPool, opts ...grpc.CallOption) (*MsgApprovePendingPoolResponse, error)
+	CancelPoolWindDown(ctx context.Context, in *MsgCancelPoolWindDown, opts ...grpc.CallOption) (*MsgCancelPoolWindDownResponse, error)
 }
 
-func (m *MsgAddLiquidity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.NativeAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExternalAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgAddLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgModifyPmtpRates) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BlockRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RunningRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EndPolicy {
-		n += 2
+func (c *msgClient) RemoveLiquidity(ctx context.Context, in *MsgRemoveLiquidity, opts ...grpc.CallOption) (*MsgRemoveLiquidityResponse, error) {
+	out := new(MsgRemoveLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/RemoveLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgModifyPmtpRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveLiquidityUnits(ctx context.Context, in *MsgRemoveLiquidityUnits, opts ...grpc.CallOption) (*MsgRemoveLiquidityUnitsResponse, error) {
+	out := new(MsgRemoveLiquidityUnitsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/RemoveLiquidityUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdatePmtpParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PmtpPeriodGovernanceRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PmtpPeriodEpochLength != 0 {
-		n += 1 + sovTx(uint64(m.PmtpPeriodEpochLength))
-	}
-	if m.PmtpPeriodStartBlock != 0 {
-		n += 1 + sovTx(uint64(m.PmtpPeriodStartBlock))
-	}
-	if m.PmtpPeriodEndBlock != 0 {
-		n += 1 + sovTx(uint64(m.PmtpPeriodEndBlock))
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CreatePool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdatePmtpParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error) {
+	out := new(MsgAddLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AddLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SentAsset != nil {
-		l = m.SentAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ReceivedAsset != nil {
-		l = m.ReceivedAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error) {
+	out := new(MsgSwapResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.SentAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MinReceivingAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDecommissionPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error) {
+	out := new(MsgDecommissionPoolResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/DecommissionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidityRequest, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error) {
+	out := new(MsgUnlockLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UnlockLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateRewardsParams(ctx context.Context, in *MsgUpdateRewardsParamsRequest, opts ...grpc.CallOption) (*MsgUpdateRewardsParamsResponse, error) {
+	out := new(MsgUpdateRewardsParamsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateRewardsParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDecommissionPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddRewardPeriod(ctx context.Context, in *MsgAddRewardPeriodRequest, opts ...grpc.CallOption) (*MsgAddRewardPeriodResponse, error) {
+	out := new(MsgAddRewardPeriodResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AddRewardPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUnlockLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) ModifyPmtpRates(ctx context.Context, in *MsgModifyPmtpRates, opts ...grpc.CallOption) (*MsgModifyPmtpRatesResponse, error) {
+	out := new(MsgModifyPmtpRatesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/ModifyPmtpRates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdatePmtpParams(ctx context.Context, in *MsgUpdatePmtpParams, opts ...grpc.CallOption) (*MsgUpdatePmtpParamsResponse, error) {
+	out := new(MsgUpdatePmtpParamsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdatePmtpParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateStakingRewardParams(ctx context.Context, in *MsgUpdateStakingRewardParams, opts ...grpc.CallOption) (*MsgUpdateStakingRewardParamsResponse, error) {
+	out := new(MsgUpdateStakingRewardParamsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateStakingRewardParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Units.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUnlockLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRewardsParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) TransferLiquidityPosition(ctx context.Context, in *MsgTransferLiquidityPosition, opts ...grpc.CallOption) (*MsgTransferLiquidityPositionResponse, error) {
+	out := new(MsgTransferLiquidityPositionResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/TransferLiquidityPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LiquidityRemovalLockPeriod != 0 {
-		n += 1 + sovTx(uint64(m.LiquidityRemovalLockPeriod))
+	return out, nil
+}
+
+func (c *msgClient) UpdateSwapFeeRate(ctx context.Context, in *MsgUpdateSwapFeeRate, opts ...grpc.CallOption) (*MsgUpdateSwapFeeRateResponse, error) {
+	out := new(MsgUpdateSwapFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateSwapFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LiquidityRemovalCancelPeriod != 0 {
-		n += 1 + sovTx(uint64(m.LiquidityRemovalCancelPeriod))
+	return out, nil
+}
+
+func (c *msgClient) UpdateProtocolFeeRate(ctx context.Context, in *MsgUpdateProtocolFeeRate, opts ...grpc.CallOption) (*MsgUpdateProtocolFeeRateResponse, error) {
+	out := new(MsgUpdateProtocolFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateProtocolFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRewardsParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdatePoolPauseState(ctx context.Context, in *MsgUpdatePoolPauseState, opts ...grpc.CallOption) (*MsgUpdatePoolPauseStateResponse, error) {
+	out := new(MsgUpdatePoolPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdatePoolPauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgAddRewardPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateCircuitBreakerParams(ctx context.Context, in *MsgUpdateCircuitBreakerParams, opts ...grpc.CallOption) (*MsgUpdateCircuitBreakerParamsResponse, error) {
+	out := new(MsgUpdateCircuitBreakerParamsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdateCircuitBreakerParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelPmtpPolicy(ctx context.Context, in *MsgCancelPmtpPolicy, opts ...grpc.CallOption) (*MsgCancelPmtpPolicyResponse, error) {
+	out := new(MsgCancelPmtpPolicyResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CancelPmtpPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.RewardPeriods) > 0 {
-		for _, e := range m.RewardPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddRewardPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AppendRewardPeriod(ctx context.Context, in *MsgAppendRewardPeriod, opts ...grpc.CallOption) (*MsgAppendRewardPeriodResponse, error) {
+	out := new(MsgAppendRewardPeriodResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AppendRewardPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *msgClient) EditRewardPeriod(ctx context.Context, in *MsgEditRewardPeriod, opts ...grpc.CallOption) (*MsgEditRewardPeriodResponse, error) {
+	out := new(MsgEditRewardPeriodResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/EditRewardPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *msgClient) DeleteRewardPeriod(ctx context.Context, in *MsgDeleteRewardPeriod, opts ...grpc.CallOption) (*MsgDeleteRewardPeriodResponse, error) {
+	out := new(MsgDeleteRewardPeriodResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/DeleteRewardPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardEscrow(ctx context.Context, in *MsgFundRewardEscrow, opts ...grpc.CallOption) (*MsgFundRewardEscrowResponse, error) {
+	out := new(MsgFundRewardEscrowResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/FundRewardEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundRewardEscrow(ctx context.Context, in *MsgRefundRewardEscrow, opts ...grpc.CallOption) (*MsgRefundRewardEscrowResponse, error) {
+	out := new(MsgRefundRewardEscrowResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/RefundRewardEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddLiquiditySingleSided(ctx context.Context, in *MsgAddLiquiditySingleSided, opts ...grpc.CallOption) (*MsgAddLiquiditySingleSidedResponse, error) {
+	out := new(MsgAddLiquiditySingleSidedResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/AddLiquiditySingleSided", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePoolSwapMode(ctx context.Context, in *MsgUpdatePoolSwapMode, opts ...grpc.CallOption) (*MsgUpdatePoolSwapModeResponse, error) {
+	out := new(MsgUpdatePoolSwapModeResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/UpdatePoolSwapMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApprovePendingPool(ctx context.Context, in *MsgApprovePendingPool, opts ...grpc.CallOption) (*MsgApprovePendingPoolResponse, error) {
+	out := new(MsgApprovePendingPoolResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/ApprovePendingPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPoolWindDown(ctx context.Context, in *MsgCancelPoolWindDown, opts ...grpc.CallOption) (*MsgCancelPoolWindDownResponse, error) {
+	out := new(MsgCancelPoolWindDownResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CancelPoolWindDown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
+	RemoveLiquidityUnits(context.Context, *MsgRemoveLiquidityUnits) (*MsgRemoveLiquidityUnitsResponse, error)
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
+	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	DecommissionPool(context.Context, *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error)
+	UnlockLiquidity(context.Context, *MsgUnlockLiquidityRequest) (*MsgUnlockLiquidityResponse, error)
+	UpdateRewardsParams(context.Context, *MsgUpdateRewardsParamsRequest) (*MsgUpdateRewardsParamsResponse, error)
+	AddRewardPeriod(context.Context, *MsgAddRewardPeriodRequest) (*MsgAddRewardPeriodResponse, error)
+	ModifyPmtpRates(context.Context, *MsgModifyPmtpRates) (*MsgModifyPmtpRatesResponse, error)
+	UpdatePmtpParams(context.Context, *MsgUpdatePmtpParams) (*MsgUpdatePmtpParamsResponse, error)
+	UpdateStakingRewardParams(context.Context, *MsgUpdateStakingRewardParams) (*MsgUpdateStakingRewardParamsResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	TransferLiquidityPosition(context.Context, *MsgTransferLiquidityPosition) (*MsgTransferLiquidityPositionResponse, error)
+	UpdateSwapFeeRate(context.Context, *MsgUpdateSwapFeeRate) (*MsgUpdateSwapFeeRateResponse, error)
+	UpdateProtocolFeeRate(context.Context, *MsgUpdateProtocolFeeRate) (*MsgUpdateProtocolFeeRateResponse, error)
+	UpdatePoolPauseState(context.Context, *MsgUpdatePoolPauseState) (*MsgUpdatePoolPauseStateResponse, error)
+	UpdateCircuitBreakerParams(context.Context, *MsgUpdateCircuitBreakerParams) (*MsgUpdateCircuitBreakerParamsResponse, error)
+	CancelPmtpPolicy(context.Context, *MsgCancelPmtpPolicy) (*MsgCancelPmtpPolicyResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	AppendRewardPeriod(context.Context, *MsgAppendRewardPeriod) (*MsgAppendRewardPeriodResponse, error)
+	EditRewardPeriod(context.Context, *MsgEditRewardPeriod) (*MsgEditRewardPeriodResponse, error)
+	DeleteRewardPeriod(context.Context, *MsgDeleteRewardPeriod) (*MsgDeleteRewardPeriodResponse, error)
+	FundRewardEscrow(context.Context, *MsgFundRewardEscrow) (*MsgFundRewardEscrowResponse, error)
+	RefundRewardEscrow(context.Context, *MsgRefundRewardEscrow) (*MsgRefundRewardEscrowResponse, error)
+	AddLiquiditySingleSided(context.Context, *MsgAddLiquiditySingleSided) (*MsgAddLiquiditySingleSidedResponse, error)
+	UpdatePoolSwapMode(context.Context, *MsgUpdatePoolSwapMode) (*MsgUpdatePoolSwapModeResponse, error)
+	ApprovePendingPool(context.Context, *MsgApprovePendingPool) (*MsgApprovePendingPoolResponse, error)
+	CancelPoolWindDown(context.Context, *MsgCancelPoolWindDown) (*MsgCancelPoolWindDownResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RemoveLiquidity(ctx context.Context, req *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) RemoveLiquidityUnits(ctx context.Context, req *MsgRemoveLiquidityUnits) (*MsgRemoveLiquidityUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLiquidityUnits not implemented")
+}
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+}
+func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiquidity) (*MsgAddLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) DecommissionPool(ctx context.Context, req *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionPool not implemented")
+}
+func (*UnimplementedMsgServer) UnlockLiquidity(ctx context.Context, req *MsgUnlockLiquidityRequest) (*MsgUnlockLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRewardsParams(ctx context.Context, req *MsgUpdateRewardsParamsRequest) (*MsgUpdateRewardsParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRewardsParams not implemented")
+}
+func (*UnimplementedMsgServer) AddRewardPeriod(ctx context.Context, req *MsgAddRewardPeriodRequest) (*MsgAddRewardPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRewardPeriod not implemented")
+}
+func (*UnimplementedMsgServer) ModifyPmtpRates(ctx context.Context, req *MsgModifyPmtpRates) (*MsgModifyPmtpRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyPmtpRates not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePmtpParams(ctx context.Context, req *MsgUpdatePmtpParams) (*MsgUpdatePmtpParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePmtpParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateStakingRewardParams(ctx context.Context, req *MsgUpdateStakingRewardParams) (*MsgUpdateStakingRewardParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingRewardParams not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) TransferLiquidityPosition(ctx context.Context, req *MsgTransferLiquidityPosition) (*MsgTransferLiquidityPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLiquidityPosition not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSwapFeeRate(ctx context.Context, req *MsgUpdateSwapFeeRate) (*MsgUpdateSwapFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwapFeeRate not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProtocolFeeRate(ctx context.Context, req *MsgUpdateProtocolFeeRate) (*MsgUpdateProtocolFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtocolFeeRate not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePoolPauseState(ctx context.Context, req *MsgUpdatePoolPauseState) (*MsgUpdatePoolPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolPauseState not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCircuitBreakerParams(ctx context.Context, req *MsgUpdateCircuitBreakerParams) (*MsgUpdateCircuitBreakerParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCircuitBreakerParams not implemented")
+}
+func (*UnimplementedMsgServer) CancelPmtpPolicy(ctx context.Context, req *MsgCancelPmtpPolicy) (*MsgCancelPmtpPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPmtpPolicy not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) AppendRewardPeriod(ctx context.Context, req *MsgAppendRewardPeriod) (*MsgAppendRewardPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendRewardPeriod not implemented")
+}
+func (*UnimplementedMsgServer) EditRewardPeriod(ctx context.Context, req *MsgEditRewardPeriod) (*MsgEditRewardPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRewardPeriod not implemented")
+}
+func (*UnimplementedMsgServer) DeleteRewardPeriod(ctx context.Context, req *MsgDeleteRewardPeriod) (*MsgDeleteRewardPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRewardPeriod not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardEscrow(ctx context.Context, req *MsgFundRewardEscrow) (*MsgFundRewardEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardEscrow not implemented")
+}
+func (*UnimplementedMsgServer) RefundRewardEscrow(ctx context.Context, req *MsgRefundRewardEscrow) (*MsgRefundRewardEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundRewardEscrow not implemented")
+}
+func (*UnimplementedMsgServer) AddLiquiditySingleSided(ctx context.Context, req *MsgAddLiquiditySingleSided) (*MsgAddLiquiditySingleSidedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLiquiditySingleSided not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePoolSwapMode(ctx context.Context, req *MsgUpdatePoolSwapMode) (*MsgUpdatePoolSwapModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolSwapMode not implemented")
+}
+func (*UnimplementedMsgServer) ApprovePendingPool(ctx context.Context, req *MsgApprovePendingPool) (*MsgApprovePendingPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePendingPool not implemented")
+}
+func (*UnimplementedMsgServer) CancelPoolWindDown(ctx context.Context, req *MsgCancelPoolWindDown) (*MsgCancelPoolWindDownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPoolWindDown not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RemoveLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/RemoveLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquidity(ctx, req.(*MsgRemoveLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveLiquidityUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveLiquidityUnits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveLiquidityUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/RemoveLiquidityUnits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveLiquidityUnits(ctx, req.(*MsgRemoveLiquidityUnits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/AddLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLiquidity(ctx, req.(*MsgAddLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DecommissionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecommissionPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DecommissionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/DecommissionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DecommissionPool(ctx, req.(*MsgDecommissionPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UnlockLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockLiquidity(ctx, req.(*MsgUnlockLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRewardsParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRewardsParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRewardsParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateRewardsParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRewardsParams(ctx, req.(*MsgUpdateRewardsParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRewardPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRewardPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/AddRewardPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRewardPeriod(ctx, req.(*MsgAddRewardPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyPmtpRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyPmtpRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyPmtpRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/ModifyPmtpRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyPmtpRates(ctx, req.(*MsgModifyPmtpRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePmtpParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePmtpParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdatePmtpParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePmtpParams(ctx, req.(*MsgUpdatePmtpParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateStakingRewardParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStakingRewardParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateStakingRewardParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateStakingRewardParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateStakingRewardParams(ctx, req.(*MsgUpdateStakingRewardParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLiquidityPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLiquidityPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLiquidityPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/TransferLiquidityPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLiquidityPosition(ctx, req.(*MsgTransferLiquidityPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSwapFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSwapFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSwapFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateSwapFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSwapFeeRate(ctx, req.(*MsgUpdateSwapFeeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProtocolFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProtocolFeeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProtocolFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateProtocolFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProtocolFeeRate(ctx, req.(*MsgUpdateProtocolFeeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePoolPauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolPauseState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolPauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdatePoolPauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolPauseState(ctx, req.(*MsgUpdatePoolPauseState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCircuitBreakerParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCircuitBreakerParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCircuitBreakerParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateCircuitBreakerParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCircuitBreakerParams(ctx, req.(*MsgUpdateCircuitBreakerParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPmtpPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPmtpPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPmtpPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/CancelPmtpPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPmtpPolicy(ctx, req.(*MsgCancelPmtpPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendRewardPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendRewardPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/AppendRewardPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendRewardPeriod(ctx, req.(*MsgAppendRewardPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditRewardPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditRewardPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/EditRewardPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditRewardPeriod(ctx, req.(*MsgEditRewardPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteRewardPeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteRewardPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/DeleteRewardPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteRewardPeriod(ctx, req.(*MsgDeleteRewardPeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/FundRewardEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardEscrow(ctx, req.(*MsgFundRewardEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundRewardEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundRewardEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundRewardEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/RefundRewardEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundRewardEscrow(ctx, req.(*MsgRefundRewardEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddLiquiditySingleSided_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddLiquiditySingleSided)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddLiquiditySingleSided(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/AddLiquiditySingleSided",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddLiquiditySingleSided(ctx, req.(*MsgAddLiquiditySingleSided))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePoolSwapMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolSwapMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolSwapMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdatePoolSwapMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolSwapMode(ctx, req.(*MsgUpdatePoolSwapMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApprovePendingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprovePendingPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApprovePendingPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/ApprovePendingPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApprovePendingPool(ctx, req.(*MsgApprovePendingPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPoolWindDown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPoolWindDown)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPoolWindDown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/CancelPoolWindDown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPoolWindDown(ctx, req.(*MsgCancelPoolWindDown))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.clp.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveLiquidity",
+			Handler:    _Msg_RemoveLiquidity_Handler,
+		},
+		{
+			MethodName: "RemoveLiquidityUnits",
+			Handler:    _Msg_RemoveLiquidityUnits_Handler,
+		},
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "AddLiquidity",
+			Handler:    _Msg_AddLiquidity_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "DecommissionPool",
+			Handler:    _Msg_DecommissionPool_Handler,
+		},
+		{
+			MethodName: "UnlockLiquidity",
+			Handler:    _Msg_UnlockLiquidity_Handler,
+		},
+		{
+			MethodName: "UpdateRewardsParams",
+			Handler:    _Msg_UpdateRewardsParams_Handler,
+		},
+		{
+			MethodName: "AddRewardPeriod",
+			Handler:    _Msg_AddRewardPeriod_Handler,
+		},
+		{
+			MethodName: "ModifyPmtpRates",
+			Handler:    _Msg_ModifyPmtpRates_Handler,
+		},
+		{
+			MethodName: "UpdatePmtpParams",
+			Handler:    _Msg_UpdatePmtpParams_Handler,
+		},
+		{
+			MethodName: "UpdateStakingRewardParams",
+			Handler:    _Msg_UpdateStakingRewardParams_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "TransferLiquidityPosition",
+			Handler:    _Msg_TransferLiquidityPosition_Handler,
+		},
+		{
+			MethodName: "UpdateSwapFeeRate",
+			Handler:    _Msg_UpdateSwapFeeRate_Handler,
+		},
+		{
+			MethodName: "UpdateProtocolFeeRate",
+			Handler:    _Msg_UpdateProtocolFeeRate_Handler,
+		},
+		{
+			MethodName: "UpdatePoolPauseState",
+			Handler:    _Msg_UpdatePoolPauseState_Handler,
+		},
+		{
+			MethodName: "UpdateCircuitBreakerParams",
+			Handler:    _Msg_UpdateCircuitBreakerParams_Handler,
+		},
+		{
+			MethodName: "CancelPmtpPolicy",
+			Handler:    _Msg_CancelPmtpPolicy_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "AppendRewardPeriod",
+			Handler:    _Msg_AppendRewardPeriod_Handler,
+		},
+		{
+			MethodName: "EditRewardPeriod",
+			Handler:    _Msg_EditRewardPeriod_Handler,
+		},
+		{
+			MethodName: "DeleteRewardPeriod",
+			Handler:    _Msg_DeleteRewardPeriod_Handler,
+		},
+		{
+			MethodName: "FundRewardEscrow",
+			Handler:    _Msg_FundRewardEscrow_Handler,
+		},
+		{
+			MethodName: "RefundRewardEscrow",
+			Handler:    _Msg_RefundRewardEscrow_Handler,
+		},
+		{
+			MethodName: "AddLiquiditySingleSided",
+			Handler:    _Msg_AddLiquiditySingleSided_Handler,
+		},
+		{
+			MethodName: "UpdatePoolSwapMode",
+			Handler:    _Msg_UpdatePoolSwapMode_Handler,
+		},
+		{
+			MethodName: "ApprovePendingPool",
+			Handler:    _Msg_ApprovePendingPool_Handler,
+		},
+		{
+			MethodName: "CancelPoolWindDown",
+			Handler:    _Msg_CancelPoolWindDown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/clp/v1/tx.proto",
+}
+
+func (m *MsgUpdateStakingRewardParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStakingRewardParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStakingRewardParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Params.Size()
+		i -= size
+		if _, err := m.Params.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Minter.Size()
+		i -= size
+		if _, err := m.Minter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStakingRewardParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStakingRewardParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStakingRewardParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinExternalOut.Size()
+		i -= size
+		if _, err := m.MinExternalOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinNativeOut.Size()
+		i -= size
+		if _, err := m.MinNativeOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Asymmetry.Size()
+		i -= size
+		if _, err := m.Asymmetry.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WBasisPoints.Size()
+		i -= size
+		if _, err := m.WBasisPoints.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidityUnits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidityUnits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidityUnits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinExternalOut.Size()
+		i -= size
+		if _, err := m.MinExternalOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinNativeOut.Size()
+		i -= size
+		if _, err := m.MinNativeOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WithdrawUnits.Size()
+		i -= size
+		if _, err := m.WithdrawUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveLiquidityUnitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveLiquidityUnitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveLiquidityUnitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLiquidityPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLiquidityPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLiquidityPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLiquidityPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLiquidityPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLiquidityPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinPoolUnits.Size()
+		i -= size
+		if _, err := m.MinPoolUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyPmtpRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPmtpRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPmtpRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndPolicy {
+		i--
+		if m.EndPolicy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunningRate) > 0 {
+		i -= len(m.RunningRate)
+		copy(dAtA[i:], m.RunningRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RunningRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockRate) > 0 {
+		i -= len(m.BlockRate)
+		copy(dAtA[i:], m.BlockRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModifyPmtpRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyPmtpRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyPmtpRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePmtpParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePmtpParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePmtpParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PmtpPeriodEndBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodEndBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PmtpPeriodStartBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodStartBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PmtpPeriodEpochLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PmtpPeriodEpochLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PmtpPeriodGovernanceRate) > 0 {
+		i -= len(m.PmtpPeriodGovernanceRate)
+		copy(dAtA[i:], m.PmtpPeriodGovernanceRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PmtpPeriodGovernanceRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePmtpParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePmtpParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePmtpParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PolicyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PolicyId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinReceivingAmount.Size()
+		i -= size
+		if _, err := m.MinReceivingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReceivedAsset != nil {
+		{
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueuedSwapId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueuedSwapId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinReceivingAmount.Size()
+		i -= size
+		if _, err := m.MinReceivingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayoutSymbol) > 0 {
+		i -= len(m.PayoutSymbol)
+		copy(dAtA[i:], m.PayoutSymbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayoutSymbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GracePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDecommissionPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDecommissionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDecommissionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRewardsParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRewardsParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRewardsParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityRemovalCancelPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalCancelPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LiquidityRemovalLockPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalLockPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRewardsParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRewardsParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRewardsParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewardPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewardPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewardPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendRewardPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendRewardPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardPeriod != nil {
+		{
+			size, err := m.RewardPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditRewardPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditRewardPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardPeriod != nil {
+		{
+			size, err := m.RewardPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundRewardEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundRewardEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundRewardEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundRewardEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundRewardEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundRewardEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Refunded.Size()
+		i -= size
+		if _, err := m.Refunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReceivedAsset != nil {
+		{
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSwapFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSwapFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSwapFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSwapFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSwapFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSwapFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProtocolFeeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProtocolFeeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProtocolFeeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProtocolFeeRate.Size()
+		i -= size
+		if _, err := m.ProtocolFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProtocolFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProtocolFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProtocolFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolPauseState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolPauseState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolPauseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemovesPaused {
+		i--
+		if m.RemovesPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AddsPaused {
+		i--
+		if m.AddsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolSwapMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolSwapMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolSwapMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SwapMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolSwapModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolSwapModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolSwapModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApprovePendingPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprovePendingPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprovePendingPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApprovePendingPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApprovePendingPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApprovePendingPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPoolWindDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPoolWindDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPoolWindDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPoolWindDownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPoolWindDownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPoolWindDownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCircuitBreakerParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriceChange.Size()
+		i -= size
+		if _, err := m.MaxPriceChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPriceImpact.Size()
+		i -= size
+		if _, err := m.MaxPriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCircuitBreakerParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCircuitBreakerParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCircuitBreakerParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPmtpPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPmtpPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPmtpPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PolicyId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PolicyId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPmtpPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPmtpPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPmtpPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for iNdEx := len(m.Claimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquiditySingleSided) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquiditySingleSided) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquiditySingleSided) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinPoolUnits.Size()
+		i -= size
+		if _, err := m.MinPoolUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddLiquiditySingleSidedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddLiquiditySingleSidedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddLiquiditySingleSidedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LpUnits.Size()
+		i -= size
+		if _, err := m.LpUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapResult.Size()
+		i -= size
+		if _, err := m.SwapResult.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SwapAmount.Size()
+		i -= size
+		if _, err := m.SwapAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateStakingRewardParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Minter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateStakingRewardParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WBasisPoints.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Asymmetry.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinNativeOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinExternalOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveLiquidityUnits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WithdrawUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinNativeOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinExternalOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgRemoveLiquidityUnitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferLiquidityPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLiquidityPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPoolUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgAddLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgModifyPmtpRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RunningRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndPolicy {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgModifyPmtpRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePmtpParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PmtpPeriodGovernanceRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PmtpPeriodEpochLength != 0 {
+		n += 1 + sovTx(uint64(m.PmtpPeriodEpochLength))
+	}
+	if m.PmtpPeriodStartBlock != 0 {
+		n += 1 + sovTx(uint64(m.PmtpPeriodStartBlock))
+	}
+	if m.PmtpPeriodEndBlock != 0 {
+		n += 1 + sovTx(uint64(m.PmtpPeriodEndBlock))
+	}
+	return n
+}
+
+func (m *MsgUpdatePmtpParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PolicyId != 0 {
+		n += 1 + sovTx(uint64(m.PolicyId))
+	}
+	return n
+}
+
+func (m *MsgSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinReceivingAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedSwapId != 0 {
+		n += 1 + sovTx(uint64(m.QueuedSwapId))
+	}
+	return n
+}
+
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinReceivingAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecommissionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GracePeriod != 0 {
+		n += 1 + sovTx(uint64(m.GracePeriod))
+	}
+	l = len(m.PayoutSymbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecommissionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnlockLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRewardsParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LiquidityRemovalLockPeriod != 0 {
+		n += 1 + sovTx(uint64(m.LiquidityRemovalLockPeriod))
+	}
+	if m.LiquidityRemovalCancelPeriod != 0 {
+		n += 1 + sovTx(uint64(m.LiquidityRemovalCancelPeriod))
+	}
+	return n
+}
+
+func (m *MsgUpdateRewardsParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddRewardPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RewardPeriods) > 0 {
+		for _, e := range m.RewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddRewardPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAppendRewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardPeriod != nil {
+		l = m.RewardPeriod.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAppendRewardPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditRewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardPeriod != nil {
+		l = m.RewardPeriod.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditRewardPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteRewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteRewardPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRewardEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundRewardEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundRewardEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundRewardEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refunded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSwapFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateSwapFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateProtocolFeeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProtocolFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateProtocolFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePoolPauseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.AddsPaused {
+		n += 2
+	}
+	if m.RemovesPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePoolSwapMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SwapMode != 0 {
+		n += 1 + sovTx(uint64(m.SwapMode))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolSwapModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApprovePendingPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApprovePendingPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPoolWindDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelPoolWindDownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxPriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxPriceChange.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCircuitBreakerParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPmtpPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PolicyId != 0 {
+		n += 1 + sovTx(uint64(m.PolicyId))
+	}
+	return n
+}
+
+func (m *MsgCancelPmtpPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimed) > 0 {
+		for _, e := range m.Claimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddLiquiditySingleSided) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPoolUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgAddLiquiditySingleSidedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SwapResult.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LpUnits.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNativeOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNativeOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExternalOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinExternalOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNativeOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNativeOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExternalOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinExternalOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLiquidityPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLiquidityPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLiquidityPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLiquidityPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLiquidityPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLiquidityPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyPmtpRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyPmtpRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndPolicy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodGovernanceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPeriodGovernanceRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEpochLength", wireType)
+			}
+			m.PmtpPeriodEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+			}
+			m.PmtpPeriodStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodStartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			}
+			m.PmtpPeriodEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			m.PolicyId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedSwapId", wireType)
+			}
+			m.QueuedSwapId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedSwapId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, &Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalLockPeriod", wireType)
+			}
+			m.LiquidityRemovalLockPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityRemovalLockPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalCancelPeriod", wireType)
+			}
+			m.LiquidityRemovalCancelPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityRemovalCancelPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriods = append(m.RewardPeriods, &RewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddRewardPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendRewardPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendRewardPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardPeriod == nil {
+				m.RewardPeriod = &RewardPeriod{}
+			}
+			if err := m.RewardPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendRewardPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendRewardPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditRewardPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditRewardPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardPeriod == nil {
+				m.RewardPeriod = &RewardPeriod{}
+			}
+			if err := m.RewardPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditRewardPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditRewardPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRewardPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRewardPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundRewardEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundRewardEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundRewardEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundRewardEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundRewardEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundRewardEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3052,10 +12072,10 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3092,7 +12112,79 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3120,13 +12212,13 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3154,10 +12246,29 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3179,7 +12290,7 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,12 +12313,31 @@ func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3229,7 +12359,7 @@ func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3252,10 +12382,10 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3291,10 +12421,129 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSwapFeeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSwapFeeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSwapFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3304,33 +12553,29 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3340,29 +12585,31 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3390,7 +12637,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3415,7 +12662,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSwapFeeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3438,10 +12685,10 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSwapFeeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSwapFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3465,7 +12712,7 @@ func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProtocolFeeRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3488,10 +12735,10 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3528,43 +12775,7 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3592,7 +12803,7 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3617,7 +12828,7 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateProtocolFeeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3640,10 +12851,10 @@ func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateProtocolFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3667,7 +12878,7 @@ func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolPauseState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3690,10 +12901,10 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolPauseState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolPauseState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3765,10 +12976,10 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3778,31 +12989,17 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.SwapsPaused = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddsPaused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3812,26 +13009,32 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.AddsPaused = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovesPaused", wireType)
 			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.RemovesPaused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3853,7 +13056,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolPauseStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3876,10 +13079,10 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolPauseStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3903,7 +13106,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolSwapMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3926,10 +13129,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolSwapMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolSwapMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4001,44 +13204,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMode", wireType)
 			}
-			var stringLen uint64
+			m.SwapMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4048,26 +13217,11 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SwapMode |= SwapMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4089,7 +13243,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolSwapModeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,10 +13266,10 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolSwapModeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolSwapModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4139,7 +13293,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
+func (m *MsgApprovePendingPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4162,10 +13316,10 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyPmtpRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApprovePendingPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyPmtpRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApprovePendingPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4202,41 +13356,9 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4246,44 +13368,28 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunningRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndPolicy", wireType)
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.EndPolicy = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4305,7 +13411,7 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApprovePendingPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4328,10 +13434,10 @@ func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApprovePendingPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApprovePendingPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4355,7 +13461,7 @@ func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPoolWindDown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4378,10 +13484,10 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPoolWindDown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPoolWindDown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4418,9 +13524,9 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodGovernanceRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4430,81 +13536,28 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PmtpPeriodGovernanceRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEpochLength", wireType)
-			}
-			m.PmtpPeriodEpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PmtpPeriodEpochLength |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
-			}
-			m.PmtpPeriodStartBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PmtpPeriodStartBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
 			}
-			m.PmtpPeriodEndBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PmtpPeriodEndBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4526,7 +13579,7 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPoolWindDownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4549,10 +13602,10 @@ func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPoolWindDownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPoolWindDownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4576,7 +13629,7 @@ func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4599,10 +13652,10 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4639,79 +13692,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SentAsset == nil {
-				m.SentAsset = &Asset{}
-			}
-			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReceivedAsset == nil {
-				m.ReceivedAsset = &Asset{}
-			}
-			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4739,13 +13720,13 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceChange", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4773,7 +13754,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPriceChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4798,7 +13779,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCircuitBreakerParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4821,10 +13802,10 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCircuitBreakerParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4848,7 +13829,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPmtpPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4871,10 +13852,10 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPmtpPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPmtpPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4910,10 +13891,10 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
 			}
-			var stringLen uint64
+			m.PolicyId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4923,24 +13904,11 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PolicyId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4962,7 +13930,7 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPmtpPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4985,10 +13953,10 @@ func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPmtpPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPmtpPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5012,7 +13980,7 @@ func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5035,10 +14003,10 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5109,40 +14077,6 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5164,7 +14098,7 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5187,12 +14121,46 @@ func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimed = append(m.Claimed, types.Coin{})
+			if err := m.Claimed[len(m.Claimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5214,7 +14182,7 @@ func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddLiquiditySingleSided) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5237,10 +14205,10 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddLiquiditySingleSided: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddLiquiditySingleSided: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5276,10 +14244,10 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalLockPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			m.LiquidityRemovalLockPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5289,16 +14257,33 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityRemovalLockPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalCancelPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
 			}
-			m.LiquidityRemovalCancelPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5308,61 +14293,115 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityRemovalCancelPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MinPoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5384,7 +14423,7 @@ func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddLiquiditySingleSidedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5407,15 +14446,15 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddLiquiditySingleSidedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddLiquiditySingleSidedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5443,13 +14482,15 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.SwapAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapResult", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5459,76 +14500,60 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPeriods = append(m.RewardPeriods, &RewardPeriod{})
-			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpUnits", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddRewardPeriodResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LpUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
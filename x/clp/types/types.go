@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +24,95 @@ func NewPool(externalAsset *Asset, nativeAssetBalance, externalAssetBalance, poo
 	return pool
 }
 
+// SwapFeeRateOrZero returns the swap fee rate of the pool, pools without a rate charge no swap fee
+func (p Pool) SwapFeeRateOrZero() sdk.Dec {
+	if p.SwapFeeRate == nil || p.SwapFeeRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return *p.SwapFeeRate
+}
+
+// ValidateFeeRate checks that a swap or protocol fee rate is in [0, 1)
+func ValidateFeeRate(rate sdk.Dec) bool {
+	return !rate.IsNil() && !rate.IsNegative() && rate.LT(sdk.OneDec())
+}
+
+// NewPoolFeeAccrual returns the fee accrual of a pool that has not charged any fees yet
+func NewPoolFeeAccrual() PoolFeeAccrual {
+	return PoolFeeAccrual{
+		LpFeesNative:         sdk.ZeroUint(),
+		LpFeesExternal:       sdk.ZeroUint(),
+		ProtocolFeesNative:   sdk.ZeroUint(),
+		ProtocolFeesExternal: sdk.ZeroUint(),
+	}
+}
+
+func NewPoolStatsBucket(hour int64) PoolStatsBucket {
+	return PoolStatsBucket{
+		Hour:           hour,
+		VolumeNative:   sdk.ZeroUint(),
+		VolumeExternal: sdk.ZeroUint(),
+		FeesNative:     sdk.ZeroUint(),
+		FeesExternal:   sdk.ZeroUint(),
+	}
+}
+
+// RewardDenom returns the denom the rewards of the reward period are paid in
+func (p RewardPeriod) RewardDenom() string {
+	if p.RewardPeriodDenom == "" {
+		return GetSettlementAsset().Symbol
+	}
+	return p.RewardPeriodDenom
+}
+
+// NewPoolRewardAccumulator returns the reward accumulator of a pool that has not been distributed rewards yet
+func NewPoolRewardAccumulator(symbol string, rewardPeriodID string, denom string) PoolRewardAccumulator {
+	return PoolRewardAccumulator{
+		Symbol:         symbol,
+		RewardPeriodId: rewardPeriodID,
+		RewardPerUnit:  sdk.ZeroDec(),
+		Distributed:    sdk.ZeroUint(),
+		Denom:          denom,
+	}
+}
+
+// NewLiquidityProviderRewards returns the rewards of a liquidity provider that has not accrued rewards yet
+func NewLiquidityProviderRewards(symbol string, lpAddress string) LiquidityProviderRewards {
+	return LiquidityProviderRewards{
+		Symbol:                   symbol,
+		LiquidityProviderAddress: lpAddress,
+		Periods:                  []LiquidityProviderPeriodReward{},
+	}
+}
+
+// Pending returns the accrued rewards that have not been claimed yet
+func (r LiquidityProviderRewards) Pending() sdk.Coins {
+	pending := sdk.NewCoins()
+	for _, period := range r.Periods {
+		amount := period.Accrued.Sub(period.Claimed)
+		pending = pending.Add(sdk.NewCoin(period.Denom, sdk.NewIntFromBigInt(amount.BigInt())))
+	}
+	return pending
+}
+
+// Validate checks that no more rewards were claimed than accrued
+func (r LiquidityProviderRewards) Validate() bool {
+	for _, period := range r.Periods {
+		if period.Claimed.GT(period.Accrued) || sdk.ValidateDenom(period.Denom) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (e RewardEscrow) Validate() bool {
+	if e.RewardPeriodId == "" || sdk.ValidateDenom(e.Denom) != nil {
+		return false
+	}
+	_, err := sdk.AccAddressFromBech32(e.Funder)
+	return err == nil
+}
+
 type Pools []Pool
 type LiquidityProviders []LiquidityProvider
 
@@ -37,9 +129,89 @@ func NewLiquidityProvider(asset *Asset, liquidityProviderUnits sdk.Uint, liquidi
 	return LiquidityProvider{Asset: asset, LiquidityProviderUnits: liquidityProviderUnits, LiquidityProviderAddress: liquidityProviderAddress.String()}
 }
 
+func (o LimitOrder) Validate() bool {
+	if o.PoolAsset == nil || !o.PoolAsset.Validate() || o.PoolAsset.Equals(GetSettlementAsset()) {
+		return false
+	}
+	if o.SentAsset == nil || !o.SentAsset.Validate() {
+		return false
+	}
+	if !o.SentAsset.Equals(*o.PoolAsset) && !o.SentAsset.Equals(GetSettlementAsset()) {
+		return false
+	}
+	return !o.LimitPrice.IsNil() && o.LimitPrice.IsPositive()
+}
+
+// ReceivedAsset returns the asset the order receives once executed
+func (o LimitOrder) ReceivedAsset() Asset {
+	if o.SentAsset.Equals(GetSettlementAsset()) {
+		return *o.PoolAsset
+	}
+	return GetSettlementAsset()
+}
+
+// ParseSwapMode parses a swap mode from its name, with or without the SWAP_MODE_ prefix
+func ParseSwapMode(s string) (SwapMode, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "SWAP_MODE_") {
+		name = "SWAP_MODE_" + name
+	}
+	value, ok := SwapMode_value[name]
+	if !ok {
+		return SwapMode_SWAP_MODE_SEQUENTIAL, fmt.Errorf("invalid swap mode: %s", s)
+	}
+	return SwapMode(value), nil
+}
+
+func (s QueuedSwap) Validate() bool {
+	if s.PoolAsset == nil || !s.PoolAsset.Validate() || s.PoolAsset.Equals(GetSettlementAsset()) {
+		return false
+	}
+	if s.SentAsset == nil || s.ReceivedAsset == nil {
+		return false
+	}
+	// Queued swaps execute against a single pool, so they trade between rowan and the pool asset
+	if s.SentAsset.Equals(GetSettlementAsset()) {
+		return s.ReceivedAsset.Equals(*s.PoolAsset)
+	}
+	return s.SentAsset.Equals(*s.PoolAsset) && s.ReceivedAsset.Equals(GetSettlementAsset())
+}
+
+func (p PmtpPolicy) Validate() bool {
+	if p.Id == 0 || p.Status == PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED {
+		return false
+	}
+	if p.Params.PmtpPeriodEpochLength <= 0 || p.Params.PmtpPeriodEndBlock < p.Params.PmtpPeriodStartBlock {
+		return false
+	}
+	return !p.Params.PmtpPeriodGovernanceRate.IsNil() && !p.FinalRunningRate.IsNil()
+}
+
 // ----------------------------------------------------------------------------
 // Client Types
 
+// ParsePmtpPolicyStatus parses a policy status such as "pending" or "PMTP_POLICY_STATUS_PENDING", an empty string is unspecified
+func ParsePmtpPolicyStatus(s string) (PmtpPolicyStatus, error) {
+	if s == "" {
+		return PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED, nil
+	}
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "PMTP_POLICY_STATUS_") {
+		name = "PMTP_POLICY_STATUS_" + name
+	}
+	value, ok := PmtpPolicyStatus_value[name]
+	if !ok {
+		return PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED, fmt.Errorf("invalid pmtp policy status: %s", s)
+	}
+	return PmtpPolicyStatus(value), nil
+}
+
+// GetLiquidityProviderTokenDenom returns the bank denom of the liquidity units of the pool of symbol
+// Example : clp/ceth
+func GetLiquidityProviderTokenDenom(symbol string) string {
+	return LiquidityProviderTokenPrefix + symbol
+}
+
 func NewLiquidityProviderResponse(liquidityProvider LiquidityProvider, height int64, nativeBalance string, externalBalance string) LiquidityProviderRes {
 	return LiquidityProviderRes{LiquidityProvider: &liquidityProvider, Height: height, NativeAssetBalance: nativeBalance, ExternalAssetBalance: externalBalance}
 }
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -24,6 +25,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SwapMode is how swaps of a pool are executed
+type SwapMode int32
+
+const (
+	// swaps execute one after the other as their messages are delivered
+	SwapMode_SWAP_MODE_SEQUENTIAL SwapMode = 0
+	// MsgSwap swaps are queued during the block and cleared together in the
+	// EndBlocker at a single uniform price, other messages swapping against the
+	// pool are rejected and limit orders stay open
+	SwapMode_SWAP_MODE_BATCH SwapMode = 1
+)
+
+var SwapMode_name = map[int32]string{
+	0: "SWAP_MODE_SEQUENTIAL",
+	1: "SWAP_MODE_BATCH",
+}
+
+var SwapMode_value = map[string]int32{
+	"SWAP_MODE_SEQUENTIAL": 0,
+	"SWAP_MODE_BATCH":      1,
+}
+
+func (x SwapMode) String() string {
+	return proto.EnumName(SwapMode_name, int32(x))
+}
+
+func (SwapMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{0}
+}
+
 type Asset struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
@@ -76,6 +107,29 @@ type Pool struct {
 	SwapPriceNative               *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=swap_price_native,json=swapPriceNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price_native,omitempty" yaml:"swap_price_native "`
 	SwapPriceExternal             *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_price_external,json=swapPriceExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price_external,omitempty" yaml:"swap_price_external "`
 	RewardPeriodNativeDistributed github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=reward_period_native_distributed,json=rewardPeriodNativeDistributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"reward_period_native_distributed" yaml:"reward_period_native_distributed"`
+	// swap_fee_rate is the share of every swap output kept by the pool on top of
+	// the slip based liquidity fee, pools without a rate charge no extra fee
+	SwapFeeRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate,omitempty" yaml:"swap_fee_rate"`
+	// paused actions fail until an admin resumes them, swaps are also paused by
+	// the circuit breaker
+	SwapsPaused   bool     `protobuf:"varint,9,opt,name=swaps_paused,json=swapsPaused,proto3" json:"swaps_paused,omitempty"`
+	AddsPaused    bool     `protobuf:"varint,10,opt,name=adds_paused,json=addsPaused,proto3" json:"adds_paused,omitempty"`
+	RemovesPaused bool     `protobuf:"varint,11,opt,name=removes_paused,json=removesPaused,proto3" json:"removes_paused,omitempty"`
+	SwapMode      SwapMode `protobuf:"varint,12,opt,name=swap_mode,json=swapMode,proto3,enum=sifnode.clp.v1.SwapMode" json:"swap_mode,omitempty"`
+	// pending pools were created for a token requiring approval and cannot be
+	// swapped against until a clp admin approves them
+	Pending bool `protobuf:"varint,13,opt,name=pending,proto3" json:"pending,omitempty"`
+	// pools winding down are settled pro rata to their liquidity providers at
+	// this height, swaps and additions fail until then while withdrawals do not
+	// need an unlock, zero for pools not winding down
+	WindDownEndHeight int64 `protobuf:"varint,14,opt,name=wind_down_end_height,json=windDownEndHeight,proto3" json:"wind_down_end_height,omitempty"`
+	// wind_down_settle_failures counts the blocks in a row the settlement of a
+	// pool winding down failed, it is not retried anymore once it reaches
+	// MaxSettlePoolFailures until an admin reschedules or cancels the wind down
+	WindDownSettleFailures uint32 `protobuf:"varint,15,opt,name=wind_down_settle_failures,json=windDownSettleFailures,proto3" json:"wind_down_settle_failures,omitempty"`
+	// wind_down_payout_symbol is the asset the liquidity providers of a pool
+	// winding down are paid in at its settlement, both assets when empty
+	WindDownPayoutSymbol string `protobuf:"bytes,16,opt,name=wind_down_payout_symbol,json=windDownPayoutSymbol,proto3" json:"wind_down_payout_symbol,omitempty"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -118,6 +172,62 @@ func (m *Pool) GetExternalAsset() *Asset {
 	return nil
 }
 
+func (m *Pool) GetSwapsPaused() bool {
+	if m != nil {
+		return m.SwapsPaused
+	}
+	return false
+}
+
+func (m *Pool) GetAddsPaused() bool {
+	if m != nil {
+		return m.AddsPaused
+	}
+	return false
+}
+
+func (m *Pool) GetRemovesPaused() bool {
+	if m != nil {
+		return m.RemovesPaused
+	}
+	return false
+}
+
+func (m *Pool) GetSwapMode() SwapMode {
+	if m != nil {
+		return m.SwapMode
+	}
+	return SwapMode_SWAP_MODE_SEQUENTIAL
+}
+
+func (m *Pool) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *Pool) GetWindDownEndHeight() int64 {
+	if m != nil {
+		return m.WindDownEndHeight
+	}
+	return 0
+}
+
+func (m *Pool) GetWindDownSettleFailures() uint32 {
+	if m != nil {
+		return m.WindDownSettleFailures
+	}
+	return 0
+}
+
+func (m *Pool) GetWindDownPayoutSymbol() string {
+	if m != nil {
+		return m.WindDownPayoutSymbol
+	}
+	return ""
+}
+
 type LiquidityProvider struct {
 	Asset                    *Asset                                  `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 	LiquidityProviderUnits   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_provider_units,json=liquidityProviderUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_provider_units" yaml:"liquidity_provider_units"`
@@ -440,668 +550,5444 @@ func (m *EventPolicy) GetPmtpPeriodEndBlock() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Asset)(nil), "sifnode.clp.v1.Asset")
-	proto.RegisterType((*Pool)(nil), "sifnode.clp.v1.Pool")
-	proto.RegisterType((*LiquidityProvider)(nil), "sifnode.clp.v1.LiquidityProvider")
-	proto.RegisterType((*LiquidityUnlock)(nil), "sifnode.clp.v1.LiquidityUnlock")
-	proto.RegisterType((*PmtpEpoch)(nil), "sifnode.clp.v1.PmtpEpoch")
-	proto.RegisterType((*WhiteList)(nil), "sifnode.clp.v1.WhiteList")
-	proto.RegisterType((*LiquidityProviderData)(nil), "sifnode.clp.v1.LiquidityProviderData")
-	proto.RegisterType((*EventPolicy)(nil), "sifnode.clp.v1.EventPolicy")
+// LimitOrder sells sent_amount of sent_asset into the pool of pool_asset once
+// the pool swap price of sent_asset reaches limit_price
+type LimitOrder struct {
+	Id         uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner      string                                  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PoolAsset  *Asset                                  `protobuf:"bytes,3,opt,name=pool_asset,json=poolAsset,proto3" json:"pool_asset,omitempty"`
+	SentAsset  *Asset                                  `protobuf:"bytes,4,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty"`
+	SentAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	LimitPrice github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=limit_price,json=limitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"limit_price" yaml:"limit_price"`
+	// expiry_height is the last height at which the order can execute, zero
+	// for orders that never expire
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	PlacedHeight int64 `protobuf:"varint,8,opt,name=placed_height,json=placedHeight,proto3" json:"placed_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("sifnode/clp/v1/types.proto", fileDescriptor_a09f92a67752e669) }
-
-var fileDescriptor_a09f92a67752e669 = []byte{
-	// 817 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0x8e, 0x09, 0x09, 0xca, 0xd0, 0x80, 0x32, 0x0d, 0xd4, 0x05, 0x11, 0xa7, 0xae, 0x5a, 0x90,
-	0xaa, 0x26, 0x85, 0xb6, 0x87, 0x56, 0x5c, 0x48, 0x13, 0xb5, 0x95, 0x10, 0xb2, 0x86, 0x46, 0x95,
-	0xb8, 0x58, 0x8e, 0x3d, 0x24, 0x23, 0x1c, 0x8f, 0xf1, 0x4c, 0x02, 0x39, 0xed, 0x9e, 0xf6, 0xb4,
-	0x87, 0x3d, 0xed, 0x1f, 0xd8, 0x3f, 0x83, 0xb4, 0x17, 0xf6, 0xb6, 0xe2, 0x10, 0xad, 0xe0, 0x1f,
-	0xf0, 0x0b, 0x56, 0x33, 0x63, 0x87, 0x40, 0x02, 0x4b, 0x4e, 0xb6, 0xbf, 0xf7, 0xbd, 0xf7, 0xbd,
-	0xf7, 0xfc, 0xde, 0x0c, 0x58, 0x63, 0xe4, 0x38, 0xa0, 0x1e, 0xae, 0xba, 0x7e, 0x58, 0xed, 0x6f,
-	0x57, 0xf9, 0x20, 0xc4, 0xac, 0x12, 0x46, 0x94, 0x53, 0xb8, 0x14, 0xdb, 0x2a, 0xae, 0x1f, 0x56,
-	0xfa, 0xdb, 0x6b, 0xc5, 0x36, 0x6d, 0x53, 0x69, 0xaa, 0x8a, 0x37, 0xc5, 0x32, 0x0d, 0x90, 0xd9,
-	0x63, 0x0c, 0x73, 0xb8, 0x0a, 0xb2, 0x6c, 0xd0, 0x6d, 0x51, 0x5f, 0xd7, 0xca, 0xda, 0x56, 0x0e,
-	0xc5, 0x5f, 0xe6, 0xfb, 0x2c, 0x98, 0xb7, 0x28, 0xf5, 0xe1, 0x2e, 0x58, 0xc2, 0xe7, 0x1c, 0x47,
-	0x81, 0xe3, 0xdb, 0x8e, 0x70, 0x91, 0xc4, 0xc5, 0x9d, 0x95, 0xca, 0x7d, 0xa1, 0x8a, 0x8c, 0x87,
-	0xf2, 0x09, 0x59, 0x85, 0x7f, 0xa9, 0x81, 0x62, 0xe0, 0x70, 0xd2, 0xc7, 0xca, 0xd9, 0x6e, 0x39,
-	0xbe, 0x13, 0xb8, 0x58, 0x9f, 0x13, 0x6a, 0xb5, 0x83, 0x8b, 0xa1, 0x91, 0xba, 0x1a, 0x1a, 0x9b,
-	0x6d, 0xc2, 0x3b, 0xbd, 0x56, 0xc5, 0xa5, 0xdd, 0xaa, 0x4b, 0x59, 0x97, 0xb2, 0xf8, 0xf1, 0x33,
-	0xf3, 0x4e, 0xe2, 0xf2, 0x9a, 0x24, 0xe0, 0xb7, 0x43, 0x63, 0x7d, 0xe0, 0x74, 0xfd, 0x3f, 0xcd,
-	0x69, 0x41, 0x4d, 0x04, 0x15, 0x2c, 0xb5, 0x6b, 0x0a, 0x84, 0xaf, 0x34, 0xb0, 0x7a, 0xbf, 0x82,
-	0x51, 0x12, 0x69, 0x99, 0x84, 0x35, 0x7b, 0x12, 0x1b, 0x2a, 0x89, 0xe9, 0x61, 0x4d, 0x54, 0xbc,
-	0xd7, 0x84, 0x24, 0x11, 0x17, 0x80, 0x90, 0x52, 0xdf, 0xee, 0x05, 0x84, 0x33, 0x7d, 0x5e, 0x6a,
-	0xd7, 0x67, 0xd7, 0x2e, 0x28, 0xed, 0xbb, 0x50, 0x26, 0xca, 0x89, 0x8f, 0xa6, 0x78, 0x87, 0x0c,
-	0x14, 0xd8, 0x99, 0x13, 0xda, 0x61, 0x44, 0x5c, 0x6c, 0xab, 0x76, 0xe8, 0x19, 0xa9, 0xf5, 0xf7,
-	0xd5, 0xd0, 0xf8, 0xf1, 0x19, 0x3a, 0x75, 0xec, 0xde, 0x0e, 0x8d, 0x6f, 0x95, 0xcc, 0x44, 0xb0,
-	0xb2, 0x89, 0x96, 0x05, 0x68, 0x09, 0xec, 0x40, 0x42, 0x70, 0x00, 0xbe, 0x1e, 0xe3, 0x25, 0xc5,
-	0xeb, 0x59, 0x29, 0xfb, 0xef, 0x4c, 0xb2, 0xeb, 0x13, 0xb2, 0x49, 0xb8, 0xb2, 0x89, 0x0a, 0x23,
-	0xe1, 0x46, 0x0c, 0xc2, 0x77, 0x1a, 0x28, 0x47, 0xf8, 0xcc, 0x89, 0x3c, 0x3b, 0xc4, 0x11, 0xa1,
-	0x5e, 0x9c, 0xa6, 0xed, 0x11, 0xc6, 0x23, 0xd2, 0xea, 0x71, 0xec, 0xe9, 0x0b, 0x32, 0x91, 0xa3,
-	0xd9, 0x7b, 0xbd, 0xa9, 0xb2, 0xf9, 0x92, 0x80, 0x89, 0x36, 0x14, 0xc5, 0x92, 0x0c, 0xd5, 0x95,
-	0xfa, 0x98, 0xfd, 0x62, 0x0e, 0x14, 0xf6, 0xc9, 0x69, 0x8f, 0x78, 0x84, 0x0f, 0xac, 0x88, 0xf6,
-	0x89, 0x87, 0x23, 0xf8, 0x13, 0xc8, 0x3c, 0x63, 0xa3, 0x14, 0x07, 0xbe, 0xd6, 0x80, 0xee, 0x27,
-	0x21, 0xec, 0x30, 0x8e, 0x11, 0x0f, 0x93, 0xda, 0x26, 0x34, 0x7b, 0x81, 0x86, 0x2a, 0xf0, 0xb1,
-	0xc0, 0x26, 0x5a, 0xf5, 0x1f, 0xa6, 0xad, 0xe6, 0x6c, 0x17, 0xac, 0x4d, 0x71, 0x72, 0x3c, 0x2f,
-	0xc2, 0x8c, 0xa9, 0xc5, 0x42, 0xfa, 0x84, 0xef, 0x9e, 0xb2, 0xc3, 0x3f, 0xc0, 0x42, 0x2f, 0xf0,
-	0xa9, 0x7b, 0x22, 0xf6, 0x20, 0xbd, 0xb5, 0xb8, 0x63, 0x3c, 0xac, 0x7d, 0xd4, 0xad, 0xa6, 0xe4,
-	0xa1, 0x84, 0x6f, 0xbe, 0x00, 0xcb, 0x0f, 0x6c, 0xf0, 0x07, 0xb0, 0x14, 0xe1, 0xd3, 0x1e, 0x66,
-	0xdc, 0xee, 0x60, 0xd2, 0xee, 0xa8, 0x86, 0xa6, 0x51, 0x3e, 0x46, 0xff, 0x91, 0x20, 0x6c, 0x80,
-	0xcc, 0x78, 0xb7, 0xaa, 0x33, 0x76, 0x0b, 0x29, 0x6f, 0xb3, 0x09, 0x72, 0x56, 0x97, 0x87, 0x8d,
-	0x90, 0xba, 0x1d, 0xf8, 0x3d, 0xc8, 0x63, 0xf1, 0x62, 0xbb, 0xb4, 0x17, 0x70, 0x1c, 0xc5, 0xca,
-	0x5f, 0x49, 0xf0, 0x2f, 0x85, 0x09, 0x52, 0x4b, 0x24, 0x3a, 0x22, 0xcd, 0x29, 0x92, 0x04, 0x63,
-	0x92, 0xb9, 0x03, 0x72, 0xff, 0x77, 0x08, 0xc7, 0xfb, 0x84, 0x71, 0x51, 0x51, 0xdf, 0xf1, 0x89,
-	0xe7, 0x70, 0x1a, 0xd9, 0x3e, 0x61, 0xa2, 0xa2, 0xf4, 0x56, 0x0e, 0xe5, 0x47, 0xa8, 0xa0, 0x99,
-	0x1f, 0x34, 0xb0, 0x32, 0x31, 0x56, 0x75, 0x87, 0x3b, 0xd0, 0x02, 0x70, 0xf2, 0xf7, 0xc4, 0x73,
-	0xf6, 0xdd, 0xa3, 0xbd, 0x4e, 0x42, 0xa0, 0xc2, 0xc4, 0x9f, 0x83, 0xbf, 0x3c, 0x75, 0x90, 0x4f,
-	0x3d, 0x78, 0x7f, 0x7b, 0xfa, 0xdc, 0x9d, 0x7e, 0x4a, 0x9a, 0x6f, 0x35, 0xb0, 0xd8, 0xe8, 0xe3,
-	0x80, 0x5b, 0xd4, 0x27, 0xee, 0x00, 0x6e, 0x00, 0x80, 0xc5, 0xa7, 0x2d, 0xfe, 0x44, 0x7c, 0x49,
-	0xe5, 0x24, 0xf2, 0xdf, 0x20, 0xc4, 0xf0, 0x77, 0xf0, 0x4d, 0xd8, 0xe5, 0x61, 0xb2, 0x9b, 0x8c,
-	0x3b, 0x11, 0xb7, 0x65, 0x63, 0xe3, 0xcc, 0x8a, 0xc2, 0xac, 0xf6, 0xf2, 0x50, 0x18, 0x6b, 0x72,
-	0x64, 0xb6, 0xc1, 0xca, 0xb8, 0x1b, 0x0e, 0xbc, 0xd8, 0x49, 0xa5, 0x06, 0xef, 0x9c, 0x1a, 0x81,
-	0x27, 0x5d, 0x6a, 0x7b, 0x17, 0xd7, 0x25, 0xed, 0xf2, 0xba, 0xa4, 0x7d, 0xba, 0x2e, 0x69, 0x6f,
-	0x6e, 0x4a, 0xa9, 0xcb, 0x9b, 0x52, 0xea, 0xe3, 0x4d, 0x29, 0x75, 0x34, 0x3e, 0x41, 0x87, 0xe4,
-	0xd8, 0xed, 0x38, 0x24, 0xa8, 0x26, 0x57, 0xf4, 0xb9, 0xbc, 0xa4, 0xe5, 0x18, 0xb5, 0xb2, 0xf2,
-	0xf2, 0xfd, 0xf5, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xa9, 0x3e, 0x62, 0xc0, 0x07, 0x00,
-	0x00,
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{8}
 }
-
-func (m *Asset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
 }
 
-func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LimitOrder) GetPoolAsset() *Asset {
+	if m != nil {
+		return m.PoolAsset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LimitOrder) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
+	}
+	return nil
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardPeriodNativeDistributed.Size()
-		i -= size
-		if _, err := m.RewardPeriodNativeDistributed.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *LimitOrder) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.SwapPriceExternal != nil {
-		{
-			size := m.SwapPriceExternal.Size()
-			i -= size
-			if _, err := m.SwapPriceExternal.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return 0
+}
+
+func (m *LimitOrder) GetPlacedHeight() int64 {
+	if m != nil {
+		return m.PlacedHeight
 	}
-	if m.SwapPriceNative != nil {
-		{
-			size := m.SwapPriceNative.Size()
-			i -= size
-			if _, err := m.SwapPriceNative.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	return 0
+}
+
+// QueuedSwap is a swap against a pool in batch swap mode waiting to be
+// cleared at the end of the block, the sent amount is held by the module
+type QueuedSwap struct {
+	Id                 uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Signer             string                                  `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	PoolAsset          *Asset                                  `protobuf:"bytes,3,opt,name=pool_asset,json=poolAsset,proto3" json:"pool_asset,omitempty"`
+	SentAsset          *Asset                                  `protobuf:"bytes,4,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty"`
+	ReceivedAsset      *Asset                                  `protobuf:"bytes,5,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty"`
+	SentAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	MinReceivingAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=min_receiving_amount,json=minReceivingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_receiving_amount" yaml:"min_receiving_amount"`
+}
+
+func (m *QueuedSwap) Reset()         { *m = QueuedSwap{} }
+func (m *QueuedSwap) String() string { return proto.CompactTextString(m) }
+func (*QueuedSwap) ProtoMessage()    {}
+func (*QueuedSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{9}
+}
+func (m *QueuedSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	{
-		size := m.PoolUnits.Size()
-		i -= size
-		if _, err := m.PoolUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+}
+func (m *QueuedSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedSwap.Merge(m, src)
+}
+func (m *QueuedSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedSwap proto.InternalMessageInfo
+
+func (m *QueuedSwap) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExternalAssetBalance.Size()
-		i -= size
-		if _, err := m.ExternalAssetBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *QueuedSwap) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NativeAssetBalance.Size()
-		i -= size
-		if _, err := m.NativeAssetBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *QueuedSwap) GetPoolAsset() *Asset {
+	if m != nil {
+		return m.PoolAsset
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueuedSwap) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LiquidityProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueuedSwap) GetReceivedAsset() *Asset {
+	if m != nil {
+		return m.ReceivedAsset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TwapRecord is a snapshot of the cumulative prices of a pool, the time
+// weighted average price between two records is the difference of their
+// cumulative prices divided by the seconds elapsed between them
+type TwapRecord struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the block time in unix seconds
+	Timestamp               int64                                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PriceNativeCumulative   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_native_cumulative,json=priceNativeCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_native_cumulative" yaml:"price_native_cumulative"`
+	PriceExternalCumulative github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price_external_cumulative,json=priceExternalCumulative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_external_cumulative" yaml:"price_external_cumulative"`
+	// last prices are the pool swap prices at the time of the record, they are
+	// accumulated until the next record
+	LastPriceNative   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=last_price_native,json=lastPriceNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price_native" yaml:"last_price_native"`
+	LastPriceExternal github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=last_price_external,json=lastPriceExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_price_external" yaml:"last_price_external"`
 }
 
-func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unlocks) > 0 {
-		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.LiquidityProviderAddress) > 0 {
-		i -= len(m.LiquidityProviderAddress)
-		copy(dAtA[i:], m.LiquidityProviderAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.LiquidityProviderAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.LiquidityProviderUnits.Size()
-		i -= size
-		if _, err := m.LiquidityProviderUnits.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{10}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Asset != nil {
-		{
-			size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LiquidityUnlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TwapRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LiquidityUnlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TwapAccumulator tracks the range of timestamps of the stored price records
+// of a pool
+type TwapAccumulator struct {
+	FirstTimestamp int64 `protobuf:"varint,1,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"`
+	LastTimestamp  int64 `protobuf:"varint,2,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
 }
 
-func (m *LiquidityUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TwapAccumulator) Reset()         { *m = TwapAccumulator{} }
+func (m *TwapAccumulator) String() string { return proto.CompactTextString(m) }
+func (*TwapAccumulator) ProtoMessage()    {}
+func (*TwapAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{11}
+}
+func (m *TwapAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.RequestHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.RequestHeight))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PmtpEpoch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TwapAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapAccumulator.Merge(m, src)
 }
-
-func (m *PmtpEpoch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TwapAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapAccumulator.DiscardUnknown(m)
 }
 
-func (m *PmtpEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockCounter != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockCounter))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochCounter != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EpochCounter))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_TwapAccumulator proto.InternalMessageInfo
+
+func (m *TwapAccumulator) GetFirstTimestamp() int64 {
+	if m != nil {
+		return m.FirstTimestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *WhiteList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TwapAccumulator) GetLastTimestamp() int64 {
+	if m != nil {
+		return m.LastTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WhiteList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PoolFeeAccrual tracks the swap fees a pool has charged since it was created,
+// split between the liquidity providers and the protocol
+type PoolFeeAccrual struct {
+	LpFeesNative         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=lp_fees_native,json=lpFeesNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_fees_native"`
+	LpFeesExternal       github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=lp_fees_external,json=lpFeesExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"lp_fees_external"`
+	ProtocolFeesNative   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=protocol_fees_native,json=protocolFeesNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"protocol_fees_native"`
+	ProtocolFeesExternal github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=protocol_fees_external,json=protocolFeesExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"protocol_fees_external"`
 }
 
-func (m *WhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorList) > 0 {
-		for iNdEx := len(m.ValidatorList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorList[iNdEx])
-			copy(dAtA[i:], m.ValidatorList[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *PoolFeeAccrual) Reset()         { *m = PoolFeeAccrual{} }
+func (m *PoolFeeAccrual) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeAccrual) ProtoMessage()    {}
+func (*PoolFeeAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{12}
+}
+func (m *PoolFeeAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *LiquidityProviderData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PoolFeeAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeAccrual.Merge(m, src)
+}
+func (m *PoolFeeAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeAccrual.DiscardUnknown(m)
 }
 
-func (m *LiquidityProviderData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PoolFeeAccrual proto.InternalMessageInfo
+
+// PoolRewardAccumulator tracks the liquidity mining rewards distributed to a
+// pool during a reward period, per liquidity unit of the pool
+type PoolRewardAccumulator struct {
+	Symbol         string                                  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	RewardPeriodId string                                  `protobuf:"bytes,2,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
+	RewardPerUnit  github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,3,opt,name=reward_per_unit,json=rewardPerUnit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_unit"`
+	Distributed    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=distributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"distributed"`
+	Denom          string                                  `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *LiquidityProviderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalAssetBalance) > 0 {
-		i -= len(m.ExternalAssetBalance)
-		copy(dAtA[i:], m.ExternalAssetBalance)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalAssetBalance)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NativeAssetBalance) > 0 {
-		i -= len(m.NativeAssetBalance)
-		copy(dAtA[i:], m.NativeAssetBalance)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.NativeAssetBalance)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LiquidityProvider != nil {
-		{
-			size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *PoolRewardAccumulator) Reset()         { *m = PoolRewardAccumulator{} }
+func (m *PoolRewardAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PoolRewardAccumulator) ProtoMessage()    {}
+func (*PoolRewardAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{13}
+}
+func (m *PoolRewardAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRewardAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRewardAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PoolRewardAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRewardAccumulator.Merge(m, src)
+}
+func (m *PoolRewardAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRewardAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRewardAccumulator.DiscardUnknown(m)
 }
 
-func (m *EventPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PoolRewardAccumulator proto.InternalMessageInfo
+
+func (m *PoolRewardAccumulator) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PoolRewardAccumulator) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
+	}
+	return ""
 }
 
-func (m *EventPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PmtpPeriodEndBlock) > 0 {
-		i -= len(m.PmtpPeriodEndBlock)
-		copy(dAtA[i:], m.PmtpPeriodEndBlock)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PmtpPeriodEndBlock)))
-		i--
-		dAtA[i] = 0x1a
+func (m *PoolRewardAccumulator) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.PmtpPeriodStartBlock) > 0 {
-		i -= len(m.PmtpPeriodStartBlock)
-		copy(dAtA[i:], m.PmtpPeriodStartBlock)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PmtpPeriodStartBlock)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EventType) > 0 {
-		i -= len(m.EventType)
-		copy(dAtA[i:], m.EventType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EventType)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// LiquidityProviderPeriodReward tracks the rewards a liquidity provider
+// accrued during a reward period, up to the reward per unit it was last
+// settled at
+type LiquidityProviderPeriodReward struct {
+	RewardPeriodId    string                                  `protobuf:"bytes,1,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
+	RewardPerUnitPaid github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,2,opt,name=reward_per_unit_paid,json=rewardPerUnitPaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_unit_paid"`
+	Accrued           github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=accrued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"accrued"`
+	Claimed           github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"claimed"`
+	Denom             string                                  `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
-func (m *Asset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+func (m *LiquidityProviderPeriodReward) Reset()         { *m = LiquidityProviderPeriodReward{} }
+func (m *LiquidityProviderPeriodReward) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderPeriodReward) ProtoMessage()    {}
+func (*LiquidityProviderPeriodReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{14}
+}
+func (m *LiquidityProviderPeriodReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderPeriodReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderPeriodReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LiquidityProviderPeriodReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderPeriodReward.Merge(m, src)
+}
+func (m *LiquidityProviderPeriodReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderPeriodReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderPeriodReward.DiscardUnknown(m)
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.NativeAssetBalance.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ExternalAssetBalance.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.PoolUnits.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.SwapPriceNative != nil {
-		l = m.SwapPriceNative.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.SwapPriceExternal != nil {
-		l = m.SwapPriceExternal.Size()
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_LiquidityProviderPeriodReward proto.InternalMessageInfo
+
+func (m *LiquidityProviderPeriodReward) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
 	}
-	l = m.RewardPeriodNativeDistributed.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return ""
 }
 
-func (m *LiquidityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Asset != nil {
-		l = m.Asset.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.LiquidityProviderUnits.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.LiquidityProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (m *LiquidityProviderPeriodReward) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Unlocks) > 0 {
-		for _, e := range m.Unlocks {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// LiquidityProviderRewards tracks the liquidity mining rewards of a liquidity
+// provider of a pool, the pending rewards are the accrued minus the claimed
+// rewards of each reward period
+type LiquidityProviderRewards struct {
+	Symbol                   string                          `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LiquidityProviderAddress string                          `protobuf:"bytes,2,opt,name=liquidity_provider_address,json=liquidityProviderAddress,proto3" json:"liquidity_provider_address,omitempty"`
+	Periods                  []LiquidityProviderPeriodReward `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *LiquidityProviderRewards) Reset()         { *m = LiquidityProviderRewards{} }
+func (m *LiquidityProviderRewards) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProviderRewards) ProtoMessage()    {}
+func (*LiquidityProviderRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{15}
+}
+func (m *LiquidityProviderRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProviderRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProviderRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LiquidityProviderRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProviderRewards.Merge(m, src)
+}
+func (m *LiquidityProviderRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProviderRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProviderRewards.DiscardUnknown(m)
 }
 
-func (m *LiquidityUnlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequestHeight != 0 {
-		n += 1 + sovTypes(uint64(m.RequestHeight))
+var xxx_messageInfo_LiquidityProviderRewards proto.InternalMessageInfo
+
+func (m *LiquidityProviderRewards) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	l = m.Units.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return ""
 }
 
-func (m *PmtpEpoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochCounter != 0 {
-		n += 1 + sovTypes(uint64(m.EpochCounter))
-	}
-	if m.BlockCounter != 0 {
-		n += 1 + sovTypes(uint64(m.BlockCounter))
+func (m *LiquidityProviderRewards) GetLiquidityProviderAddress() string {
+	if m != nil {
+		return m.LiquidityProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *WhiteList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProviderRewards) GetPeriods() []LiquidityProviderPeriodReward {
+	if m != nil {
+		return m.Periods
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorList) > 0 {
-		for _, s := range m.ValidatorList {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// RewardEscrow holds the funds of a reward period paid from an escrow, the
+// funds are held by the clp module account
+type RewardEscrow struct {
+	RewardPeriodId string                                  `protobuf:"bytes,1,opt,name=reward_period_id,json=rewardPeriodId,proto3" json:"reward_period_id,omitempty"`
+	Denom          string                                  `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Funder         string                                  `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+	Balance        github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"balance"`
+}
+
+func (m *RewardEscrow) Reset()         { *m = RewardEscrow{} }
+func (m *RewardEscrow) String() string { return proto.CompactTextString(m) }
+func (*RewardEscrow) ProtoMessage()    {}
+func (*RewardEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{16}
+}
+func (m *RewardEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RewardEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEscrow.Merge(m, src)
+}
+func (m *RewardEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEscrow.DiscardUnknown(m)
 }
 
-func (m *LiquidityProviderData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LiquidityProvider != nil {
-		l = m.LiquidityProvider.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.NativeAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_RewardEscrow proto.InternalMessageInfo
+
+func (m *RewardEscrow) GetRewardPeriodId() string {
+	if m != nil {
+		return m.RewardPeriodId
 	}
-	l = len(m.ExternalAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *RewardEscrow) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *EventPolicy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardEscrow) GetFunder() string {
+	if m != nil {
+		return m.Funder
 	}
-	var l int
-	_ = l
-	l = len(m.EventType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// PoolSnapshot is the state of a pool recorded by the EndBlocker every
+// PoolSnapshotInterval blocks
+type PoolSnapshot struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the block time in unix seconds
+	Timestamp              int64                                   `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	NativeAssetBalance     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=native_asset_balance,json=nativeAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_balance" yaml:"native_asset_balance"`
+	ExternalAssetBalance   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=external_asset_balance,json=externalAssetBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_balance" yaml:"external_asset_balance"`
+	PoolUnits              github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=pool_units,json=poolUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"pool_units" yaml:"pool_units"`
+	SwapPriceNative        github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=swap_price_native,json=swapPriceNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price_native" yaml:"swap_price_native"`
+	SwapPriceExternal      github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,7,opt,name=swap_price_external,json=swapPriceExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price_external" yaml:"swap_price_external"`
+	PmtpCurrentRunningRate github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,8,opt,name=pmtp_current_running_rate,json=pmtpCurrentRunningRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pmtp_current_running_rate" yaml:"pmtp_current_running_rate"`
+}
+
+func (m *PoolSnapshot) Reset()         { *m = PoolSnapshot{} }
+func (m *PoolSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PoolSnapshot) ProtoMessage()    {}
+func (*PoolSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{17}
+}
+func (m *PoolSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PmtpPeriodStartBlock)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *PoolSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSnapshot.Merge(m, src)
+}
+func (m *PoolSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolSnapshot proto.InternalMessageInfo
+
+func (m *PoolSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = len(m.PmtpPeriodEndBlock)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *PoolSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return n
+	return 0
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// PoolHistoryIndex tracks the range of heights of the stored snapshots of a
+// pool, snapshots before first_height were pruned
+type PoolHistoryIndex struct {
+	FirstHeight int64 `protobuf:"varint,1,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	LastHeight  int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PoolHistoryIndex) Reset()         { *m = PoolHistoryIndex{} }
+func (m *PoolHistoryIndex) String() string { return proto.CompactTextString(m) }
+func (*PoolHistoryIndex) ProtoMessage()    {}
+func (*PoolHistoryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{18}
 }
-func (m *Asset) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *PoolHistoryIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHistoryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHistoryIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHistoryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHistoryIndex.Merge(m, src)
+}
+func (m *PoolHistoryIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHistoryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHistoryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHistoryIndex proto.InternalMessageInfo
+
+func (m *PoolHistoryIndex) GetFirstHeight() int64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
+}
+
+func (m *PoolHistoryIndex) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// PoolStatsBucket aggregates the swaps and reward emissions of a pool during
+// one hour, volumes count the amounts sent to the pool
+type PoolStatsBucket struct {
+	// hour is the block time in unix seconds truncated to the hour
+	Hour            int64                                    `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	VolumeNative    github_com_cosmos_cosmos_sdk_types.Uint  `protobuf:"bytes,2,opt,name=volume_native,json=volumeNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"volume_native"`
+	VolumeExternal  github_com_cosmos_cosmos_sdk_types.Uint  `protobuf:"bytes,3,opt,name=volume_external,json=volumeExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"volume_external"`
+	FeesNative      github_com_cosmos_cosmos_sdk_types.Uint  `protobuf:"bytes,4,opt,name=fees_native,json=feesNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"fees_native"`
+	FeesExternal    github_com_cosmos_cosmos_sdk_types.Uint  `protobuf:"bytes,5,opt,name=fees_external,json=feesExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"fees_external"`
+	RewardEmissions github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=reward_emissions,json=rewardEmissions,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_emissions"`
+	SwapCount       uint64                                   `protobuf:"varint,7,opt,name=swap_count,json=swapCount,proto3" json:"swap_count,omitempty"`
+}
+
+func (m *PoolStatsBucket) Reset()         { *m = PoolStatsBucket{} }
+func (m *PoolStatsBucket) String() string { return proto.CompactTextString(m) }
+func (*PoolStatsBucket) ProtoMessage()    {}
+func (*PoolStatsBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{19}
+}
+func (m *PoolStatsBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolStatsBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolStatsBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolStatsBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolStatsBucket.Merge(m, src)
+}
+func (m *PoolStatsBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolStatsBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolStatsBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolStatsBucket proto.InternalMessageInfo
+
+func (m *PoolStatsBucket) GetHour() int64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *PoolStatsBucket) GetRewardEmissions() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardEmissions
+	}
+	return nil
+}
+
+func (m *PoolStatsBucket) GetSwapCount() uint64 {
+	if m != nil {
+		return m.SwapCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("sifnode.clp.v1.SwapMode", SwapMode_name, SwapMode_value)
+	proto.RegisterType((*Asset)(nil), "sifnode.clp.v1.Asset")
+	proto.RegisterType((*Pool)(nil), "sifnode.clp.v1.Pool")
+	proto.RegisterType((*LiquidityProvider)(nil), "sifnode.clp.v1.LiquidityProvider")
+	proto.RegisterType((*LiquidityUnlock)(nil), "sifnode.clp.v1.LiquidityUnlock")
+	proto.RegisterType((*PmtpEpoch)(nil), "sifnode.clp.v1.PmtpEpoch")
+	proto.RegisterType((*WhiteList)(nil), "sifnode.clp.v1.WhiteList")
+	proto.RegisterType((*LiquidityProviderData)(nil), "sifnode.clp.v1.LiquidityProviderData")
+	proto.RegisterType((*EventPolicy)(nil), "sifnode.clp.v1.EventPolicy")
+	proto.RegisterType((*LimitOrder)(nil), "sifnode.clp.v1.LimitOrder")
+	proto.RegisterType((*QueuedSwap)(nil), "sifnode.clp.v1.QueuedSwap")
+	proto.RegisterType((*TwapRecord)(nil), "sifnode.clp.v1.TwapRecord")
+	proto.RegisterType((*TwapAccumulator)(nil), "sifnode.clp.v1.TwapAccumulator")
+	proto.RegisterType((*PoolFeeAccrual)(nil), "sifnode.clp.v1.PoolFeeAccrual")
+	proto.RegisterType((*PoolRewardAccumulator)(nil), "sifnode.clp.v1.PoolRewardAccumulator")
+	proto.RegisterType((*LiquidityProviderPeriodReward)(nil), "sifnode.clp.v1.LiquidityProviderPeriodReward")
+	proto.RegisterType((*LiquidityProviderRewards)(nil), "sifnode.clp.v1.LiquidityProviderRewards")
+	proto.RegisterType((*RewardEscrow)(nil), "sifnode.clp.v1.RewardEscrow")
+	proto.RegisterType((*PoolSnapshot)(nil), "sifnode.clp.v1.PoolSnapshot")
+	proto.RegisterType((*PoolHistoryIndex)(nil), "sifnode.clp.v1.PoolHistoryIndex")
+	proto.RegisterType((*PoolStatsBucket)(nil), "sifnode.clp.v1.PoolStatsBucket")
+}
+
+func init() { proto.RegisterFile("sifnode/clp/v1/types.proto", fileDescriptor_a09f92a67752e669) }
+
+var fileDescriptor_a09f92a67752e669 = []byte{
+	// 2070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0x94, 0x64, 0x3e, 0x8a, 0xa4, 0x34, 0xa6, 0xe5, 0xb5, 0x53, 0x8b, 0xca, 0x06,
+	0xa9, 0x85, 0x16, 0x21, 0x63, 0x37, 0x39, 0xb8, 0xf0, 0x45, 0xb2, 0xe8, 0xda, 0x85, 0xec, 0xd0,
+	0x2b, 0x29, 0x69, 0x73, 0x59, 0x8c, 0x76, 0x87, 0xd2, 0xc0, 0xcb, 0xdd, 0xcd, 0xce, 0x2c, 0x65,
+	0x02, 0x01, 0xda, 0x53, 0xd1, 0x43, 0x5a, 0x14, 0x45, 0xd1, 0x43, 0xaf, 0xbd, 0xf5, 0xd6, 0x53,
+	0x0f, 0x3d, 0xf4, 0xea, 0xde, 0x52, 0xa0, 0x28, 0x8a, 0x1c, 0xd4, 0xc2, 0xfe, 0x06, 0xf9, 0x04,
+	0xc5, 0xfc, 0xd9, 0xe5, 0x52, 0xa4, 0x64, 0x91, 0x46, 0xda, 0x9e, 0xb8, 0xf3, 0xe6, 0xfd, 0x9b,
+	0xf7, 0x66, 0xde, 0xfc, 0xe6, 0x11, 0x6e, 0x30, 0xda, 0x0d, 0x42, 0x8f, 0xb4, 0x5c, 0x3f, 0x6a,
+	0xf5, 0x6f, 0xb7, 0xf8, 0x20, 0x22, 0xac, 0x19, 0xc5, 0x21, 0x0f, 0x51, 0x55, 0xcf, 0x35, 0x5d,
+	0x3f, 0x6a, 0xf6, 0x6f, 0xdf, 0xa8, 0x1f, 0x86, 0x87, 0xa1, 0x9c, 0x6a, 0x89, 0x2f, 0xc5, 0x75,
+	0x63, 0xd5, 0x0d, 0x59, 0x2f, 0x64, 0xad, 0x03, 0xcc, 0x48, 0xcb, 0x0d, 0x69, 0xa0, 0xe8, 0x56,
+	0x03, 0xe6, 0x37, 0x19, 0x23, 0x1c, 0xad, 0xc2, 0x02, 0x1b, 0xf4, 0x0e, 0x42, 0xdf, 0x34, 0xd6,
+	0x8d, 0x8d, 0x92, 0xad, 0x47, 0xd6, 0xaf, 0x01, 0x8a, 0x9d, 0x30, 0xf4, 0xd1, 0x3d, 0xa8, 0x92,
+	0xe7, 0x9c, 0xc4, 0x01, 0xf6, 0x1d, 0x2c, 0x44, 0x24, 0x63, 0xf9, 0xce, 0xd5, 0xe6, 0xa8, 0x03,
+	0x4d, 0xa9, 0xcf, 0xae, 0xa4, 0xcc, 0x4a, 0xfd, 0x4f, 0x0d, 0xa8, 0x07, 0x98, 0xd3, 0x3e, 0x51,
+	0xc2, 0xce, 0x01, 0xf6, 0x71, 0xe0, 0x12, 0x73, 0x4e, 0x58, 0xdb, 0x7a, 0xf2, 0xe2, 0xa4, 0x71,
+	0xe9, 0xab, 0x93, 0xc6, 0xad, 0x43, 0xca, 0x8f, 0x92, 0x83, 0xa6, 0x1b, 0xf6, 0x5a, 0xda, 0x63,
+	0xf5, 0xf3, 0x1e, 0xf3, 0x9e, 0xe9, 0x65, 0xef, 0xd3, 0x80, 0x7f, 0x7d, 0xd2, 0x78, 0x6b, 0x80,
+	0x7b, 0xfe, 0xf7, 0xad, 0x49, 0x4a, 0x2d, 0x1b, 0x29, 0xb2, 0xb4, 0xbd, 0xa5, 0x88, 0xe8, 0x67,
+	0x06, 0xac, 0x8e, 0xae, 0x20, 0x73, 0xa2, 0x20, 0x9d, 0xe8, 0x4c, 0xef, 0xc4, 0x4d, 0xe5, 0xc4,
+	0x64, 0xb5, 0x96, 0x5d, 0x1f, 0x09, 0x42, 0xea, 0x88, 0x0b, 0x10, 0x85, 0xa1, 0xef, 0x24, 0x01,
+	0xe5, 0xcc, 0x2c, 0x4a, 0xdb, 0xdb, 0xd3, 0xdb, 0x5e, 0x51, 0xb6, 0x87, 0xaa, 0x2c, 0xbb, 0x24,
+	0x06, 0xfb, 0xe2, 0x1b, 0x31, 0x58, 0x61, 0xc7, 0x38, 0x72, 0xa2, 0x98, 0xba, 0xc4, 0x51, 0xe1,
+	0x30, 0xe7, 0xa5, 0xad, 0x1f, 0x7c, 0x75, 0xd2, 0xf8, 0xf6, 0x05, 0xec, 0x6c, 0x13, 0xf7, 0xeb,
+	0x93, 0xc6, 0x75, 0x65, 0x66, 0x4c, 0xd9, 0xba, 0x65, 0xd7, 0x04, 0xb1, 0x23, 0x68, 0x4f, 0x24,
+	0x09, 0x0d, 0xe0, 0x4a, 0x8e, 0x2f, 0x5d, 0xbc, 0xb9, 0x20, 0xcd, 0x3e, 0x9a, 0xca, 0xec, 0x5b,
+	0x63, 0x66, 0x53, 0x75, 0xeb, 0x96, 0xbd, 0x92, 0x19, 0x6e, 0x6b, 0x22, 0xfa, 0xbd, 0x01, 0xeb,
+	0x31, 0x39, 0xc6, 0xb1, 0xe7, 0x44, 0x24, 0xa6, 0xa1, 0xa7, 0xdd, 0x74, 0x3c, 0xca, 0x78, 0x4c,
+	0x0f, 0x12, 0x4e, 0x3c, 0x73, 0x51, 0x3a, 0xf2, 0xe9, 0xf4, 0xb1, 0xbe, 0xa5, 0xbc, 0x79, 0x9d,
+	0x01, 0xcb, 0xbe, 0xa9, 0x58, 0x3a, 0x92, 0x43, 0x45, 0x65, 0x7b, 0x38, 0x8f, 0xba, 0x50, 0x91,
+	0x2b, 0xea, 0x12, 0xe2, 0xc4, 0x98, 0x13, 0xf3, 0xb2, 0xf4, 0x68, 0x6b, 0xaa, 0xd0, 0xd4, 0x73,
+	0xa1, 0x49, 0x15, 0x59, 0x76, 0x59, 0x8c, 0x1f, 0x10, 0x62, 0x63, 0x4e, 0xd0, 0xdb, 0xb0, 0x24,
+	0x86, 0xcc, 0x89, 0x70, 0xc2, 0x88, 0x67, 0x96, 0xd6, 0x8d, 0x8d, 0xcb, 0x8a, 0x85, 0x75, 0x24,
+	0x09, 0x35, 0xa0, 0x8c, 0x3d, 0x2f, 0xe3, 0x00, 0xc9, 0x01, 0x82, 0xa4, 0x19, 0xde, 0x85, 0x6a,
+	0x4c, 0x7a, 0x61, 0x9f, 0x64, 0x3c, 0x65, 0xc9, 0x53, 0xd1, 0x54, 0xcd, 0xf6, 0x21, 0x94, 0xa4,
+	0x27, 0xbd, 0xd0, 0x23, 0xe6, 0xd2, 0xba, 0xb1, 0x51, 0xbd, 0x63, 0x9e, 0x2e, 0x09, 0xbb, 0xc7,
+	0x38, 0x7a, 0x1c, 0x7a, 0xc4, 0xbe, 0xcc, 0xf4, 0x17, 0x32, 0x61, 0x31, 0x22, 0x81, 0x47, 0x83,
+	0x43, 0xb3, 0x22, 0xd5, 0xa6, 0x43, 0xd4, 0x82, 0xfa, 0x31, 0x0d, 0x3c, 0xc7, 0x0b, 0x8f, 0x03,
+	0x87, 0x04, 0x9e, 0x73, 0x44, 0xe8, 0xe1, 0x11, 0x37, 0xab, 0xeb, 0xc6, 0x46, 0xc1, 0x5e, 0x11,
+	0x73, 0xdb, 0xe1, 0x71, 0xd0, 0x0e, 0xbc, 0x87, 0x72, 0x02, 0xdd, 0x85, 0xeb, 0x43, 0x01, 0x46,
+	0x38, 0xf7, 0x89, 0xd3, 0xc5, 0xd4, 0x4f, 0x62, 0xc2, 0xcc, 0xda, 0xba, 0xb1, 0x51, 0xb1, 0x57,
+	0x53, 0xa9, 0x5d, 0x39, 0xfd, 0x40, 0xcf, 0xa2, 0x0f, 0xe1, 0xda, 0x50, 0x34, 0xc2, 0x83, 0x30,
+	0xe1, 0x8e, 0x2e, 0x83, 0xcb, 0xb2, 0x0c, 0xd6, 0x53, 0xc1, 0x8e, 0x9c, 0xdc, 0x55, 0x45, 0xf1,
+	0xc5, 0x1c, 0xac, 0xec, 0xd0, 0xcf, 0x12, 0xea, 0x51, 0x3e, 0xe8, 0xc4, 0x61, 0x9f, 0x7a, 0x24,
+	0x46, 0xdf, 0x85, 0xf9, 0x0b, 0x14, 0x46, 0xc5, 0x83, 0xbe, 0x30, 0xc0, 0xf4, 0x53, 0x15, 0x4e,
+	0xa4, 0x75, 0xe8, 0x9a, 0xa0, 0x8a, 0xa2, 0x3d, 0xfd, 0x3e, 0x6d, 0xa8, 0xad, 0x71, 0x96, 0x62,
+	0xcb, 0x5e, 0xf5, 0x4f, 0xbb, 0xad, 0xca, 0xc5, 0x3d, 0xb8, 0x31, 0x41, 0x08, 0x7b, 0x5e, 0x4c,
+	0x18, 0x53, 0xf5, 0xd1, 0x36, 0xc7, 0x64, 0x37, 0xd5, 0x3c, 0xba, 0x0b, 0x8b, 0x49, 0xe0, 0x87,
+	0xee, 0x33, 0x51, 0xce, 0x0a, 0x1b, 0xe5, 0x3b, 0x8d, 0xd3, 0x6b, 0xcf, 0xa2, 0xb5, 0x2f, 0xf9,
+	0xec, 0x94, 0xdf, 0xfa, 0x09, 0xd4, 0x4e, 0xcd, 0xa9, 0x8d, 0xf7, 0x59, 0x42, 0x18, 0x4f, 0x53,
+	0x6f, 0xc8, 0xd4, 0x57, 0x34, 0x55, 0xa7, 0xbd, 0x0d, 0xf3, 0xf9, 0x68, 0xb5, 0xa6, 0x8c, 0x96,
+	0xad, 0xa4, 0xad, 0x7d, 0x28, 0x75, 0x7a, 0x3c, 0x6a, 0x47, 0xa1, 0x7b, 0x84, 0xde, 0x81, 0x0a,
+	0x11, 0x1f, 0x8e, 0x1b, 0x26, 0x01, 0x27, 0xb1, 0xb6, 0xbc, 0x24, 0x89, 0xf7, 0x15, 0x4d, 0x30,
+	0x1d, 0x08, 0x47, 0x33, 0xa6, 0x39, 0xc5, 0x24, 0x89, 0x9a, 0xc9, 0xba, 0x03, 0xa5, 0x4f, 0x8e,
+	0x28, 0x27, 0x3b, 0x94, 0x71, 0xb1, 0xa2, 0x3e, 0xf6, 0xa9, 0x87, 0x79, 0x18, 0x3b, 0x3e, 0x65,
+	0x62, 0x45, 0x85, 0x8d, 0x92, 0x5d, 0xc9, 0xa8, 0x82, 0xcd, 0xfa, 0x9b, 0x01, 0x57, 0xc7, 0xb6,
+	0xd5, 0x36, 0xe6, 0x18, 0x75, 0x00, 0x8d, 0xa7, 0x47, 0xef, 0xb3, 0xb7, 0xcf, 0x8c, 0x75, 0xaa,
+	0xc2, 0x5e, 0x19, 0xcb, 0x1c, 0x7a, 0xff, 0xbc, 0xfb, 0x78, 0xe2, 0xfd, 0xf9, 0xc1, 0xf9, 0xd7,
+	0xe7, 0xe4, 0xcb, 0xce, 0xfa, 0xad, 0x01, 0xe5, 0x76, 0x9f, 0x04, 0xbc, 0x13, 0xfa, 0xd4, 0x1d,
+	0xa0, 0x9b, 0x00, 0x44, 0x0c, 0x1d, 0x91, 0x09, 0x8d, 0x35, 0x4a, 0x92, 0xb2, 0x37, 0x88, 0x88,
+	0x38, 0x90, 0x51, 0x8f, 0x47, 0x69, 0x89, 0x65, 0x1c, 0xc7, 0xdc, 0x91, 0x81, 0xd5, 0x9e, 0xd5,
+	0xc5, 0xb4, 0x2a, 0xaf, 0xbb, 0x62, 0x72, 0x4b, 0x6e, 0x99, 0xdb, 0x70, 0x35, 0x2f, 0x26, 0xaa,
+	0x86, 0x12, 0x52, 0xae, 0xa1, 0xa1, 0x50, 0x3b, 0xf0, 0xa4, 0x88, 0xf5, 0xa7, 0x02, 0xc0, 0x0e,
+	0xed, 0x51, 0xfe, 0x51, 0x2c, 0xe2, 0x51, 0x85, 0x39, 0xea, 0x49, 0x7f, 0x8a, 0xf6, 0x1c, 0xf5,
+	0x50, 0x1d, 0xe6, 0xc3, 0xe3, 0x40, 0x27, 0xb7, 0x64, 0xab, 0x01, 0xfa, 0x40, 0x5f, 0xdd, 0xea,
+	0x9c, 0x17, 0xce, 0x3b, 0xe7, 0xf2, 0x2e, 0x96, 0x9f, 0x42, 0x8a, 0x89, 0x25, 0x2b, 0xa9, 0xe2,
+	0xb9, 0x52, 0x82, 0x51, 0x49, 0x75, 0xa1, 0xac, 0xa4, 0x7a, 0x62, 0x4b, 0xe9, 0xbb, 0xbb, 0x3d,
+	0x7d, 0x4d, 0x40, 0xfa, 0xba, 0x18, 0xea, 0xb2, 0x6c, 0xe9, 0xcf, 0xa6, 0x1c, 0x20, 0x02, 0x65,
+	0x5f, 0xc4, 0x41, 0x5d, 0xb3, 0xe6, 0xc2, 0x08, 0x1e, 0xb9, 0xf8, 0xad, 0x84, 0xd2, 0xd2, 0x93,
+	0xa9, 0xb2, 0x6c, 0x90, 0x23, 0x79, 0x51, 0xcb, 0xa3, 0xf5, 0x3c, 0xa2, 0xf1, 0x20, 0x3d, 0xd4,
+	0x8b, 0xfa, 0x68, 0x49, 0xa2, 0x3e, 0xd3, 0xef, 0x40, 0x25, 0xf2, 0xb1, 0x4b, 0xb2, 0xa2, 0x7f,
+	0x59, 0x31, 0x29, 0xa2, 0x62, 0xb2, 0xfe, 0x5a, 0x00, 0x78, 0x9a, 0x90, 0x84, 0x78, 0xe2, 0x5e,
+	0x19, 0xcb, 0x9c, 0x40, 0xb2, 0xf4, 0x70, 0x98, 0x3a, 0x3d, 0xfa, 0xaf, 0xe6, 0xee, 0x9e, 0x28,
+	0x61, 0x2e, 0xa1, 0x7d, 0xe2, 0x69, 0xc9, 0xf9, 0x73, 0xc1, 0x72, 0xca, 0x3c, 0x31, 0xf3, 0x0b,
+	0xdf, 0x54, 0xe6, 0x05, 0x28, 0xef, 0xd1, 0xc0, 0x51, 0xd6, 0x69, 0x70, 0x98, 0x5a, 0x5c, 0x7c,
+	0x43, 0x50, 0x3e, 0x49, 0xa9, 0x65, 0xa3, 0x1e, 0x0d, 0xec, 0x94, 0xaa, 0x5c, 0xb0, 0xfe, 0x5e,
+	0x04, 0xd8, 0x3b, 0xc6, 0x91, 0x4d, 0xdc, 0x30, 0x96, 0xb9, 0x1b, 0x29, 0xf9, 0x7a, 0x84, 0xbe,
+	0x05, 0x25, 0x4e, 0x7b, 0x84, 0x71, 0xdc, 0x8b, 0x74, 0xb9, 0x1d, 0x12, 0xd0, 0xcf, 0x0d, 0xb8,
+	0x96, 0x87, 0xa6, 0x8e, 0x9b, 0xf4, 0x12, 0x5f, 0x7e, 0x9e, 0x82, 0xf6, 0x17, 0xdf, 0xce, 0x6b,
+	0x1a, 0x5d, 0x4f, 0x56, 0x6b, 0xd9, 0x57, 0xa3, 0x21, 0xee, 0xbd, 0x9f, 0xd1, 0xd1, 0x2f, 0x0d,
+	0xb8, 0x3e, 0x0a, 0x57, 0xf3, 0xce, 0x14, 0x47, 0xee, 0xf5, 0x8b, 0x3b, 0xb3, 0x9e, 0x77, 0x66,
+	0x82, 0x62, 0xcb, 0xbe, 0x16, 0xe5, 0xd1, 0x70, 0xce, 0xa1, 0x3e, 0xac, 0xf8, 0x98, 0xf1, 0x49,
+	0xef, 0x80, 0x1f, 0x4e, 0xed, 0x87, 0xa9, 0xcf, 0xf8, 0x69, 0x85, 0x96, 0x5d, 0x13, 0xb4, 0xfc,
+	0x53, 0xe0, 0x73, 0xb8, 0x92, 0x63, 0x3b, 0xf5, 0x14, 0xd8, 0x99, 0xda, 0xf2, 0x8d, 0x31, 0xcb,
+	0xa9, 0x4a, 0xcb, 0x5e, 0xc9, 0x6c, 0xa7, 0xeb, 0xb7, 0x30, 0xd4, 0xc4, 0xae, 0xda, 0x74, 0x75,
+	0x8c, 0xc2, 0x18, 0xdd, 0x82, 0x5a, 0x97, 0xc6, 0x8c, 0x3b, 0xc3, 0x8d, 0xa4, 0xf6, 0x58, 0x55,
+	0x92, 0xf7, 0xb2, 0xdd, 0xf4, 0x2e, 0x54, 0x7d, 0x3c, 0xc2, 0xa7, 0x36, 0x5c, 0xc5, 0xc7, 0x39,
+	0x36, 0xeb, 0x8b, 0x02, 0x54, 0xc5, 0xc3, 0xf8, 0x01, 0x21, 0x9b, 0xae, 0x1b, 0x27, 0xd8, 0x47,
+	0xfb, 0x50, 0xf5, 0x25, 0x24, 0x67, 0x69, 0xa0, 0x8d, 0xd9, 0xa0, 0xc9, 0x92, 0x2f, 0x90, 0x3c,
+	0xd3, 0xa1, 0xfc, 0x31, 0x2c, 0xa7, 0x6a, 0xb3, 0x38, 0xce, 0x88, 0x79, 0xaa, 0x4a, 0x71, 0xf6,
+	0x6a, 0xc2, 0x50, 0x97, 0x7d, 0x00, 0x37, 0xf4, 0x47, 0xfc, 0x2e, 0xcc, 0xa6, 0x1e, 0xa5, 0xca,
+	0x72, 0xde, 0x13, 0x58, 0x1d, 0x35, 0x91, 0xad, 0xa1, 0x38, 0x9b, 0x91, 0x7a, 0xde, 0x48, 0x96,
+	0xf1, 0xdf, 0xcd, 0xc1, 0x55, 0x91, 0x0e, 0x5b, 0xbe, 0xbf, 0xf2, 0x89, 0x3f, 0xa3, 0xb3, 0x81,
+	0x36, 0x60, 0x79, 0xf4, 0x3d, 0x47, 0x3d, 0x7d, 0x63, 0x54, 0xf3, 0x8f, 0xb8, 0x47, 0x1e, 0xfa,
+	0x18, 0x6a, 0x43, 0x4e, 0x89, 0xa4, 0x75, 0x80, 0x9a, 0xd3, 0xed, 0x63, 0xbb, 0xa2, 0xd4, 0x74,
+	0x14, 0xea, 0x46, 0x4f, 0xa1, 0x9c, 0x7f, 0x9d, 0xce, 0x18, 0x8f, 0xbc, 0x0e, 0x01, 0x5b, 0x3c,
+	0x12, 0x84, 0x3d, 0x75, 0xc4, 0x6d, 0x35, 0xb0, 0xfe, 0x31, 0x07, 0x37, 0xc7, 0x50, 0xa1, 0x5a,
+	0x9e, 0x8a, 0xd7, 0xc4, 0x60, 0x18, 0x13, 0x83, 0xe1, 0x40, 0xfd, 0x54, 0x30, 0x9c, 0x08, 0xa7,
+	0xa1, 0x9b, 0x3a, 0x22, 0x2b, 0x23, 0x11, 0xe9, 0x60, 0xea, 0xa1, 0x47, 0xb0, 0x88, 0xc5, 0x81,
+	0x22, 0xde, 0xac, 0xdb, 0x30, 0x95, 0x17, 0xaa, 0x5c, 0x1f, 0xd3, 0xde, 0xec, 0xc1, 0x4d, 0xe5,
+	0xcf, 0x08, 0xec, 0x5f, 0x0c, 0x30, 0xc7, 0xe1, 0xb6, 0x5c, 0x12, 0x3b, 0x73, 0xe3, 0x9d, 0xff,
+	0xd6, 0x9a, 0x7b, 0xcd, 0x5b, 0xeb, 0xb1, 0x78, 0x38, 0x8b, 0x5c, 0x88, 0x67, 0x99, 0x78, 0x6b,
+	0xbd, 0xf7, 0x5a, 0xfc, 0x9f, 0xcf, 0xf4, 0x56, 0x51, 0x84, 0xc0, 0x4e, 0x75, 0x58, 0x7f, 0x34,
+	0x60, 0x49, 0xcd, 0xb4, 0x99, 0x1b, 0x87, 0xc7, 0x53, 0xec, 0x84, 0x2c, 0x24, 0x73, 0xb9, 0x90,
+	0x88, 0x55, 0x77, 0x93, 0x40, 0x3c, 0x4f, 0x14, 0xf6, 0xd6, 0x23, 0x91, 0x8b, 0xf4, 0xbd, 0x30,
+	0x6b, 0x2e, 0xd2, 0xbe, 0xda, 0x9f, 0x17, 0x60, 0x49, 0x9c, 0xf5, 0xdd, 0x00, 0x47, 0xec, 0x28,
+	0xe4, 0x33, 0xc2, 0x86, 0x33, 0x7b, 0x92, 0x85, 0xff, 0x87, 0x9e, 0x64, 0xf1, 0x7f, 0xd8, 0x93,
+	0x9c, 0xff, 0x66, 0x7a, 0x92, 0xfd, 0x49, 0x3d, 0xc9, 0x85, 0x37, 0xc3, 0x22, 0x63, 0x0a, 0x27,
+	0xb4, 0x25, 0x3f, 0x9f, 0xdc, 0x96, 0x5c, 0x7c, 0x33, 0x2c, 0x32, 0x41, 0xe5, 0xc4, 0xce, 0xe4,
+	0x2f, 0x04, 0x24, 0x14, 0x8f, 0x53, 0x37, 0x89, 0x63, 0x01, 0xc5, 0xe3, 0x24, 0x08, 0x04, 0x2e,
+	0xce, 0x35, 0x00, 0x67, 0x87, 0x84, 0x67, 0x29, 0xb6, 0xec, 0x55, 0x31, 0x77, 0x5f, 0x4d, 0xd9,
+	0x6a, 0x46, 0xf4, 0x06, 0xad, 0x8f, 0x61, 0x59, 0x1c, 0x9e, 0x87, 0x94, 0xf1, 0x30, 0x1e, 0x3c,
+	0x0a, 0x3c, 0xf2, 0x5c, 0xf4, 0x0b, 0x15, 0x38, 0x1a, 0x39, 0x46, 0x65, 0x49, 0xd3, 0x4f, 0xb3,
+	0x06, 0x94, 0x7d, 0x3c, 0xe4, 0x50, 0xa7, 0x09, 0x7c, 0x9c, 0x32, 0x58, 0xbf, 0x29, 0x42, 0x4d,
+	0x9e, 0x4a, 0x8e, 0x39, 0xdb, 0x4a, 0xdc, 0x67, 0x84, 0x23, 0x04, 0xc5, 0xa3, 0x30, 0x49, 0xdb,
+	0x28, 0xf2, 0x1b, 0xed, 0x41, 0xa5, 0x1f, 0xfa, 0x49, 0x2f, 0xdb, 0x01, 0x33, 0x62, 0x99, 0x25,
+	0xa5, 0x45, 0xe7, 0xf8, 0x47, 0x50, 0xd3, 0x5a, 0xb3, 0xfc, 0xce, 0x78, 0x7b, 0x54, 0x95, 0x9e,
+	0x2c, 0x7f, 0x1d, 0x28, 0xe7, 0xa1, 0xd1, 0x8c, 0xc5, 0x0b, 0xba, 0x43, 0x48, 0xb4, 0x07, 0x95,
+	0x51, 0x24, 0x34, 0x3f, 0x63, 0x04, 0xba, 0x79, 0x2c, 0xd7, 0xcf, 0x0a, 0x37, 0xe9, 0x51, 0xc6,
+	0x68, 0x18, 0x30, 0x73, 0x41, 0xde, 0x10, 0xd7, 0x9b, 0x4a, 0xbe, 0x29, 0xfe, 0xfd, 0x69, 0xf6,
+	0x6f, 0x1f, 0x10, 0x8e, 0x6f, 0x37, 0xef, 0x87, 0x34, 0xd8, 0x7a, 0x5f, 0xd8, 0xfc, 0xc3, 0xbf,
+	0x1a, 0x1b, 0x17, 0xb0, 0x29, 0x04, 0x98, 0xad, 0xa1, 0x50, 0x3b, 0xb5, 0x21, 0x3a, 0x3a, 0xf2,
+	0x28, 0xb8, 0xd9, 0xd3, 0xb1, 0x68, 0xcb, 0x96, 0xb0, 0x6c, 0x85, 0x7d, 0xe7, 0x2e, 0x5c, 0xde,
+	0x1d, 0x36, 0x7d, 0xeb, 0xbb, 0x9f, 0x6c, 0x76, 0x9c, 0xc7, 0x1f, 0x6d, 0xb7, 0x9d, 0xdd, 0xf6,
+	0xd3, 0xfd, 0xf6, 0x93, 0xbd, 0x47, 0x9b, 0x3b, 0xcb, 0x97, 0xd0, 0x15, 0xa8, 0x0d, 0x67, 0xb6,
+	0x36, 0xf7, 0xee, 0x3f, 0x5c, 0x36, 0xb6, 0x36, 0x5f, 0xbc, 0x5c, 0x33, 0xbe, 0x7c, 0xb9, 0x66,
+	0xfc, 0xfb, 0xe5, 0x9a, 0xf1, 0xab, 0x57, 0x6b, 0x97, 0xbe, 0x7c, 0xb5, 0x76, 0xe9, 0x9f, 0xaf,
+	0xd6, 0x2e, 0x7d, 0x9a, 0x0f, 0xd1, 0x2e, 0xed, 0xba, 0x47, 0x98, 0x06, 0xad, 0xf4, 0x4f, 0xb2,
+	0xe7, 0xf2, 0x6f, 0x32, 0xe9, 0xf3, 0xc1, 0x82, 0x04, 0x8b, 0xdf, 0xfb, 0xcf, 0x00, 0xbf, 0xe5,
+	0x62, 0x39, 0x42, 0x1b, 0x00, 0x00,
+}
+
+func (m *Asset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Asset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WindDownPayoutSymbol) > 0 {
+		i -= len(m.WindDownPayoutSymbol)
+		copy(dAtA[i:], m.WindDownPayoutSymbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WindDownPayoutSymbol)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.WindDownSettleFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindDownSettleFailures))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.WindDownEndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindDownEndHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Pending {
+		i--
+		if m.Pending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SwapMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapMode))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RemovesPaused {
+		i--
+		if m.RemovesPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AddsPaused {
+		i--
+		if m.AddsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SwapsPaused {
+		i--
+		if m.SwapsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SwapFeeRate != nil {
+		{
+			size := m.SwapFeeRate.Size()
+			i -= size
+			if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.RewardPeriodNativeDistributed.Size()
+		i -= size
+		if _, err := m.RewardPeriodNativeDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SwapPriceExternal != nil {
+		{
+			size := m.SwapPriceExternal.Size()
+			i -= size
+			if _, err := m.SwapPriceExternal.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SwapPriceNative != nil {
+		{
+			size := m.SwapPriceNative.Size()
+			i -= size
+			if _, err := m.SwapPriceNative.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.PoolUnits.Size()
+		i -= size
+		if _, err := m.PoolUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExternalAssetBalance.Size()
+		i -= size
+		if _, err := m.ExternalAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetBalance.Size()
+		i -= size
+		if _, err := m.NativeAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LiquidityProviderAddress) > 0 {
+		i -= len(m.LiquidityProviderAddress)
+		copy(dAtA[i:], m.LiquidityProviderAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LiquidityProviderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.LiquidityProviderUnits.Size()
+		i -= size
+		if _, err := m.LiquidityProviderUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Asset != nil {
+		{
+			size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RequestHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PmtpEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PmtpEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PmtpEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhiteList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhiteList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhiteList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorList) > 0 {
+		for iNdEx := len(m.ValidatorList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorList[iNdEx])
+			copy(dAtA[i:], m.ValidatorList[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProviderData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalAssetBalance) > 0 {
+		i -= len(m.ExternalAssetBalance)
+		copy(dAtA[i:], m.ExternalAssetBalance)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExternalAssetBalance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeAssetBalance) > 0 {
+		i -= len(m.NativeAssetBalance)
+		copy(dAtA[i:], m.NativeAssetBalance)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NativeAssetBalance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LiquidityProvider != nil {
+		{
+			size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PmtpPeriodEndBlock) > 0 {
+		i -= len(m.PmtpPeriodEndBlock)
+		copy(dAtA[i:], m.PmtpPeriodEndBlock)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PmtpPeriodEndBlock)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PmtpPeriodStartBlock) > 0 {
+		i -= len(m.PmtpPeriodStartBlock)
+		copy(dAtA[i:], m.PmtpPeriodStartBlock)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PmtpPeriodStartBlock)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PlacedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PlacedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LimitPrice.Size()
+		i -= size
+		if _, err := m.LimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolAsset != nil {
+		{
+			size, err := m.PoolAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinReceivingAmount.Size()
+		i -= size
+		if _, err := m.MinReceivingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ReceivedAsset != nil {
+		{
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolAsset != nil {
+		{
+			size, err := m.PoolAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LastPriceExternal.Size()
+		i -= size
+		if _, err := m.LastPriceExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LastPriceNative.Size()
+		i -= size
+		if _, err := m.LastPriceNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PriceExternalCumulative.Size()
+		i -= size
+		if _, err := m.PriceExternalCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceNativeCumulative.Size()
+		i -= size
+		if _, err := m.PriceNativeCumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FirstTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFeeAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFeeAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProtocolFeesExternal.Size()
+		i -= size
+		if _, err := m.ProtocolFeesExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProtocolFeesNative.Size()
+		i -= size
+		if _, err := m.ProtocolFeesNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LpFeesExternal.Size()
+		i -= size
+		if _, err := m.LpFeesExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LpFeesNative.Size()
+		i -= size
+		if _, err := m.LpFeesNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRewardAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRewardAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRewardAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Distributed.Size()
+		i -= size
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RewardPerUnit.Size()
+		i -= size
+		if _, err := m.RewardPerUnit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProviderPeriodReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderPeriodReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderPeriodReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Accrued.Size()
+		i -= size
+		if _, err := m.Accrued.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RewardPerUnitPaid.Size()
+		i -= size
+		if _, err := m.RewardPerUnitPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProviderRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LiquidityProviderAddress) > 0 {
+		i -= len(m.LiquidityProviderAddress)
+		copy(dAtA[i:], m.LiquidityProviderAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LiquidityProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardPeriodId) > 0 {
+		i -= len(m.RewardPeriodId)
+		copy(dAtA[i:], m.RewardPeriodId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardPeriodId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PmtpCurrentRunningRate.Size()
+		i -= size
+		if _, err := m.PmtpCurrentRunningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SwapPriceExternal.Size()
+		i -= size
+		if _, err := m.SwapPriceExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SwapPriceNative.Size()
+		i -= size
+		if _, err := m.SwapPriceNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PoolUnits.Size()
+		i -= size
+		if _, err := m.PoolUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExternalAssetBalance.Size()
+		i -= size
+		if _, err := m.ExternalAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NativeAssetBalance.Size()
+		i -= size
+		if _, err := m.NativeAssetBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Timestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolHistoryIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHistoryIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHistoryIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolStatsBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolStatsBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolStatsBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwapCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SwapCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RewardEmissions) > 0 {
+		for iNdEx := len(m.RewardEmissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEmissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.FeesExternal.Size()
+		i -= size
+		if _, err := m.FeesExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeesNative.Size()
+		i -= size
+		if _, err := m.FeesNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VolumeExternal.Size()
+		i -= size
+		if _, err := m.VolumeExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VolumeNative.Size()
+		i -= size
+		if _, err := m.VolumeNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Hour != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Asset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.NativeAssetBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ExternalAssetBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PoolUnits.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SwapPriceNative != nil {
+		l = m.SwapPriceNative.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SwapPriceExternal != nil {
+		l = m.SwapPriceExternal.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.RewardPeriodNativeDistributed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.SwapFeeRate != nil {
+		l = m.SwapFeeRate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SwapsPaused {
+		n += 2
+	}
+	if m.AddsPaused {
+		n += 2
+	}
+	if m.RemovesPaused {
+		n += 2
+	}
+	if m.SwapMode != 0 {
+		n += 1 + sovTypes(uint64(m.SwapMode))
+	}
+	if m.Pending {
+		n += 2
+	}
+	if m.WindDownEndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindDownEndHeight))
+	}
+	if m.WindDownSettleFailures != 0 {
+		n += 1 + sovTypes(uint64(m.WindDownSettleFailures))
+	}
+	l = len(m.WindDownPayoutSymbol)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Asset != nil {
+		l = m.Asset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.LiquidityProviderUnits.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.LiquidityProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityUnlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequestHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RequestHeight))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PmtpEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochCounter != 0 {
+		n += 1 + sovTypes(uint64(m.EpochCounter))
+	}
+	if m.BlockCounter != 0 {
+		n += 1 + sovTypes(uint64(m.BlockCounter))
+	}
+	return n
+}
+
+func (m *WhiteList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorList) > 0 {
+		for _, s := range m.ValidatorList {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityProviderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidityProvider != nil {
+		l = m.LiquidityProvider.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.NativeAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExternalAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PmtpPeriodStartBlock)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PmtpPeriodEndBlock)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PoolAsset != nil {
+		l = m.PoolAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LimitPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	if m.PlacedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.PlacedHeight))
+	}
+	return n
+}
+
+func (m *QueuedSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.PoolAsset != nil {
+		l = m.PoolAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinReceivingAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	l = m.PriceNativeCumulative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PriceExternalCumulative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LastPriceNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LastPriceExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TwapAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.FirstTimestamp))
+	}
+	if m.LastTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.LastTimestamp))
+	}
+	return n
+}
+
+func (m *PoolFeeAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LpFeesNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.LpFeesExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ProtocolFeesNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ProtocolFeesExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PoolRewardAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.RewardPerUnit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Distributed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderPeriodReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.RewardPerUnitPaid.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Accrued.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LiquidityProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardPeriodId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PoolSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	l = m.NativeAssetBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ExternalAssetBalance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PoolUnits.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SwapPriceNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SwapPriceExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PmtpCurrentRunningRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PoolHistoryIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstHeight != 0 {
+		n += 1 + sovTypes(uint64(m.FirstHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *PoolStatsBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hour != 0 {
+		n += 1 + sovTypes(uint64(m.Hour))
+	}
+	l = m.VolumeNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.VolumeExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeesNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.FeesExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RewardEmissions) > 0 {
+		for _, e := range m.RewardEmissions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.SwapCount != 0 {
+		n += 1 + sovTypes(uint64(m.SwapCount))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Asset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapPriceNative = &v
+			if err := m.SwapPriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapPriceExternal = &v
+			if err := m.SwapPriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodNativeDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPeriodNativeDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFeeRate = &v
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SwapsPaused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddsPaused = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovesPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RemovesPaused = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMode", wireType)
+			}
+			m.SwapMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapMode |= SwapMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pending = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownEndHeight", wireType)
+			}
+			m.WindDownEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindDownEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownSettleFailures", wireType)
+			}
+			m.WindDownSettleFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindDownSettleFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownPayoutSymbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindDownPayoutSymbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Asset == nil {
+				m.Asset = &Asset{}
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &LiquidityUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityUnlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityUnlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PmtpEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PmtpEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCounter", wireType)
+			}
+			m.EpochCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCounter", wireType)
+			}
+			m.BlockCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhiteList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhiteList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhiteList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorList = append(m.ValidatorList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityProvider == nil {
+				m.LiquidityProvider = &LiquidityProvider{}
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPeriodStartBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPeriodEndBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolAsset == nil {
+				m.PoolAsset = &Asset{}
+			}
+			if err := m.PoolAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedHeight", wireType)
+			}
+			m.PlacedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlacedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolAsset == nil {
+				m.PoolAsset = &Asset{}
+			}
+			if err := m.PoolAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceNativeCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceNativeCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceExternalCumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceExternalCumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPriceExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstTimestamp", wireType)
+			}
+			m.FirstTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTimestamp", wireType)
+			}
+			m.LastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeAccrual) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeAccrual: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpFeesNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpFeesNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpFeesExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpFeesExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeesNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeesNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeesExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeesExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRewardAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRewardAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRewardAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerUnit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderPeriodReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderPeriodReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderPeriodReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnitPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerUnitPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accrued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Asset: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Asset: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1129,7 +6015,7 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1152,7 +6038,7 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProviderRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,17 +6061,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProviderRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProviderRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1195,31 +6081,27 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1247,15 +6129,13 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidityProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1265,29 +6145,79 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, LiquidityProviderPeriodReward{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1315,13 +6245,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardPeriodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceNative", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1349,15 +6277,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.SwapPriceNative = &v
-			if err := m.SwapPriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceExternal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1385,15 +6309,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.SwapPriceExternal = &v
-			if err := m.SwapPriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriodNativeDistributed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,7 +6341,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPeriodNativeDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1446,7 +6366,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
+func (m *PoolSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,17 +6389,17 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1489,31 +6409,33 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Asset == nil {
-				m.Asset = &Asset{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1541,13 +6463,13 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityProviderUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1575,13 +6497,15 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ExternalAssetBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolUnits", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1591,81 +6515,65 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unlocks = append(m.Unlocks, &LiquidityUnlock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceNative", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityUnlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SwapPriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityUnlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPriceExternal", wireType)
 			}
-			m.RequestHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1675,14 +6583,29 @@ func (m *LiquidityUnlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapPriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpCurrentRunningRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1710,7 +6633,7 @@ func (m *LiquidityUnlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PmtpCurrentRunningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1735,7 +6658,7 @@ func (m *LiquidityUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
+func (m *PoolHistoryIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1758,17 +6681,17 @@ func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PmtpEpoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolHistoryIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PmtpEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolHistoryIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
 			}
-			m.EpochCounter = 0
+			m.FirstHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1778,16 +6701,16 @@ func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochCounter |= int64(b&0x7F) << shift
+				m.FirstHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
 			}
-			m.BlockCounter = 0
+			m.LastHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1797,7 +6720,7 @@ func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockCounter |= int64(b&0x7F) << shift
+				m.LastHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1823,7 +6746,7 @@ func (m *PmtpEpoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhiteList) Unmarshal(dAtA []byte) error {
+func (m *PoolStatsBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,17 +6769,17 @@ func (m *WhiteList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhiteList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolStatsBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhiteList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolStatsBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
 			}
-			var stringLen uint64
+			m.Hour = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1866,79 +6789,16 @@ func (m *WhiteList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Hour |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorList = append(m.ValidatorList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityProviderData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeNative", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1948,31 +6808,29 @@ func (m *LiquidityProviderData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LiquidityProvider == nil {
-				m.LiquidityProvider = &LiquidityProvider{}
-			}
-			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VolumeNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeExternal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2000,11 +6858,13 @@ func (m *LiquidityProviderData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
+			if err := m.VolumeExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesNative", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2032,61 +6892,13 @@ func (m *LiquidityProviderData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.FeesNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesExternal", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2114,13 +6926,15 @@ func (m *EventPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventType = string(dAtA[iNdEx:postIndex])
+			if err := m.FeesExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEmissions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2130,29 +6944,31 @@ func (m *EventPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PmtpPeriodStartBlock = string(dAtA[iNdEx:postIndex])
+			m.RewardEmissions = append(m.RewardEmissions, types.Coin{})
+			if err := m.RewardEmissions[len(m.RewardEmissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapCount", wireType)
 			}
-			var stringLen uint64
+			m.SwapCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2162,24 +6978,11 @@ func (m *EventPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SwapCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PmtpPeriodEndBlock = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -65,3 +65,17 @@ func Test_NewLiquidityProviderResponse(t *testing.T) {
 	assert.Equal(t, liquidityProviderResponse.Height, int64(10))
 	assert.Equal(t, liquidityProviderResponse.LiquidityProvider, &liquidityProvider)
 }
+
+func Test_ParsePmtpPolicyStatus(t *testing.T) {
+	policyStatus, err := ParsePmtpPolicyStatus("")
+	assert.NoError(t, err)
+	assert.Equal(t, PmtpPolicyStatus_PMTP_POLICY_STATUS_UNSPECIFIED, policyStatus)
+	policyStatus, err = ParsePmtpPolicyStatus("pending")
+	assert.NoError(t, err)
+	assert.Equal(t, PmtpPolicyStatus_PMTP_POLICY_STATUS_PENDING, policyStatus)
+	policyStatus, err = ParsePmtpPolicyStatus("PMTP_POLICY_STATUS_COMPLETED")
+	assert.NoError(t, err)
+	assert.Equal(t, PmtpPolicyStatus_PMTP_POLICY_STATUS_COMPLETED, policyStatus)
+	_, err = ParsePmtpPolicyStatus("running")
+	assert.Error(t, err)
+}
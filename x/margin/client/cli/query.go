@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+func GetQueryCmd() *cobra.Command {
+	// Group margin queries under a subcommand
+	marginQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	marginQueryCmd.AddCommand(
+		GetCmdMTP(),
+		GetCmdPositionsForAddress(),
+		GetCmdPositionsForPool(),
+		GetCmdParams(),
+	)
+	return marginQueryCmd
+}
+
+func GetCmdMTP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mtp [id]",
+		Short: "Get a margin trading position",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a margin trading position by id.
+Example:
+$ %s q margin mtp 1`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetMTP(cmd.Context(), &types.MTPReq{Id: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(result)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPositionsForAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions-for-address [address]",
+		Short: "Get the margin trading positions of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open margin trading positions of an address.
+Example:
+$ %s q margin positions-for-address sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetPositionsForAddress(cmd.Context(), &types.PositionsForAddressReq{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(result)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions-for-address")
+	return cmd
+}
+
+func GetCmdPositionsForPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions-for-pool [External Asset symbol]",
+		Short: "Get the margin trading positions against a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the open margin trading positions against a pool.
+Example:
+$ %s q margin positions-for-pool ceth`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetPositionsForPool(cmd.Context(), &types.PositionsForPoolReq{
+				Asset:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(result)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "positions-for-pool")
+	return cmd
+}
+
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the margin params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			result, err := queryClient.GetParams(cmd.Context(), &types.ParamsReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(result)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+const (
+	FlagPoolAsset        = "pool_asset"
+	FlagPosition         = "position"
+	FlagCollateralAmount = "collateral_amount"
+	FlagLeverage         = "leverage"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	marginTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	marginTxCmd.AddCommand(
+		GetCmdOpen(),
+		GetCmdClose(),
+		GetCmdAddCollateral(),
+	)
+	return marginTxCmd
+}
+
+func GetCmdOpen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open",
+		Short: "Open a margin trading position against a pool",
+		Long: `Open a leveraged position against a pool.
+Long positions take rowan collateral and hold the external asset of the pool,
+short positions take the external asset as collateral and hold rowan.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			poolAsset, err := cmd.Flags().GetString(FlagPoolAsset)
+			if err != nil {
+				return err
+			}
+			positionFlag, err := cmd.Flags().GetString(FlagPosition)
+			if err != nil {
+				return err
+			}
+			position, ok := types.Position_value[strings.ToUpper(positionFlag)]
+			if !ok {
+				return types.ErrInvalidPosition
+			}
+			collateralAmountFlag, err := cmd.Flags().GetString(FlagCollateralAmount)
+			if err != nil {
+				return err
+			}
+			collateralAmount, err := sdk.ParseUint(collateralAmountFlag)
+			if err != nil {
+				return err
+			}
+			leverageFlag, err := cmd.Flags().GetString(FlagLeverage)
+			if err != nil {
+				return err
+			}
+			leverage, err := sdk.NewDecFromStr(leverageFlag)
+			if err != nil {
+				return err
+			}
+			collateralAsset, _, err := types.GetPositionAssets(types.Position(position), poolAsset)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgOpen(clientCtx.GetFromAddress(), poolAsset, types.Position(position), collateralAsset, collateralAmount, leverage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagPoolAsset, "", "External asset of the pool to trade against")
+	cmd.Flags().String(FlagPosition, "", "Position to open, long or short")
+	cmd.Flags().String(FlagCollateralAmount, "0", "Amount of collateral")
+	cmd.Flags().String(FlagLeverage, "", "Leverage of the position")
+	_ = cmd.MarkFlagRequired(FlagPoolAsset)
+	_ = cmd.MarkFlagRequired(FlagPosition)
+	_ = cmd.MarkFlagRequired(FlagCollateralAmount)
+	_ = cmd.MarkFlagRequired(FlagLeverage)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdClose() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close [id]",
+		Short: "Close a margin trading position",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgClose(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAddCollateral() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-collateral [id] [amount]",
+		Short: "Add collateral to a margin trading position, swapped into its custody asset",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAddCollateral(clientCtx.GetFromAddress(), id, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
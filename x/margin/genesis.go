@@ -0,0 +1,30 @@
+package margin
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
+	k.SetParams(ctx, data.Params)
+	for _, mtp := range data.MtpList {
+		k.SetMTP(ctx, mtp)
+	}
+	k.SetNextMTPID(ctx, data.NextMtpId)
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.GenesisState{
+		Params:    k.GetParams(ctx),
+		MtpList:   k.GetMTPs(ctx),
+		NextMtpId: k.GetNextMTPID(ctx),
+	}
+}
+
+func ValidateGenesis(data types.GenesisState) error {
+	return data.Validate()
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+const MaxPageLimit = 200
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (k Querier) GetMTP(c context.Context, req *types.MTPReq) (*types.MTPRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mtp, err := k.Keeper.GetMTP(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "mtp %d not found", req.Id)
+	}
+	return &types.MTPRes{Mtp: &mtp, Height: ctx.BlockHeight()}, nil
+}
+
+func (k Querier) GetPositionsForAddress(c context.Context, req *types.PositionsForAddressReq) (*types.PositionsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	pagination, err := checkPagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mtps, pageRes, err := k.Keeper.GetMTPsForAddress(ctx, req.Address, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PositionsRes{Mtps: mtps, Height: ctx.BlockHeight(), Pagination: pageRes}, nil
+}
+
+func (k Querier) GetPositionsForPool(c context.Context, req *types.PositionsForPoolReq) (*types.PositionsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	pagination, err := checkPagination(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	mtps, pageRes, err := k.Keeper.GetMTPsForPool(ctx, req.Asset, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.PositionsRes{Mtps: mtps, Height: ctx.BlockHeight(), Pagination: pageRes}, nil
+}
+
+func (k Querier) GetParams(c context.Context, _ *types.ParamsReq) (*types.ParamsRes, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.ParamsRes{Params: k.Keeper.GetParams(ctx)}, nil
+}
+
+func checkPagination(pagination *query.PageRequest) (*query.PageRequest, error) {
+	if pagination == nil {
+		return &query.PageRequest{Limit: MaxPageLimit}, nil
+	}
+	if pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	return pagination, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+)
+
+// Hooks wraps the margin keeper to settle the positions against the clp pools that are decommissioned
+type Hooks struct {
+	k Keeper
+}
+
+var _ clptypes.ClpHooks = Hooks{}
+
+// Hooks returns the clp hooks of the margin keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterSwap(_ sdk.Context, _ sdk.AccAddress, _ clptypes.Pool, _ sdk.Coin, _ sdk.Coin, _ sdk.Coin) {
+}
+
+func (h Hooks) AfterAddLiquidity(_ sdk.Context, _ sdk.AccAddress, _ clptypes.Pool, _ sdk.Uint, _ sdk.Uint, _ sdk.Uint) {
+}
+
+func (h Hooks) AfterRemoveLiquidity(_ sdk.Context, _ sdk.AccAddress, _ clptypes.Pool, _ sdk.Uint, _ sdk.Uint, _ sdk.Uint) {
+}
+
+func (h Hooks) AfterPoolCreated(_ sdk.Context, _ sdk.AccAddress, _ clptypes.Pool) {}
+
+// BeforePoolDecommissioned closes the positions against the pool while their liabilities can still be repaid to it
+func (h Hooks) BeforePoolDecommissioned(ctx sdk.Context, pool clptypes.Pool) {
+	h.k.ForceCloseMTPsForPool(ctx, pool.ExternalAsset.Symbol)
+}
+
+// AfterPoolDecommissioned refunds the custody of the positions that could not be closed before the pool was settled
+func (h Hooks) AfterPoolDecommissioned(ctx sdk.Context, pool clptypes.Pool) {
+	h.k.RefundMTPsForPool(ctx, pool.ExternalAsset.Symbol)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// Keeper of the margin store
+type Keeper struct {
+	storeKey            sdk.StoreKey
+	cdc                 codec.BinaryCodec
+	bankKeeper          types.BankKeeper
+	clpKeeper           types.ClpKeeper
+	tokenRegistryKeeper types.TokenRegistryKeeper
+	paramstore          paramtypes.Subspace
+}
+
+// NewKeeper creates a margin keeper
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, bankKeeper types.BankKeeper, clpKeeper types.ClpKeeper, tokenRegistryKeeper types.TokenRegistryKeeper, ps paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		storeKey:            key,
+		cdc:                 cdc,
+		bankKeeper:          bankKeeper,
+		clpKeeper:           clpKeeper,
+		tokenRegistryKeeper: tokenRegistryKeeper,
+		paramstore:          ps,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSet(ctx, &params)
+	return
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramstore.SetParamSet(ctx, &params)
+}
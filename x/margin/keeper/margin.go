@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+// IsMarginEnabled checks that the registry entry of the external asset of a pool allows both clp and margin
+func (k Keeper) IsMarginEnabled(ctx sdk.Context, poolAsset string) bool {
+	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
+	entry, err := k.tokenRegistryKeeper.GetEntry(registry, poolAsset)
+	if err != nil {
+		return false
+	}
+	return k.tokenRegistryKeeper.CheckEntryPermissions(entry, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_MARGIN})
+}
+
+// OpenMTP borrows collateral amount times leverage minus one of the collateral asset from the pool and swaps it,
+// along with the collateral, into the custody asset of the position.
+func (k Keeper) OpenMTP(ctx sdk.Context, msg *types.MsgOpen) (types.MTP, error) {
+	params := k.GetParams(ctx)
+	if msg.Leverage.GT(params.LeverageMax) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrInvalidLeverage, fmt.Sprintf("leverage %s greater than max leverage %s", msg.Leverage, params.LeverageMax))
+	}
+	if !k.IsMarginEnabled(ctx, msg.PoolAsset) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrMarginNotEnabled, msg.PoolAsset)
+	}
+	pool, err := k.clpKeeper.GetPool(ctx, msg.PoolAsset)
+	if err != nil {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrPoolDoesNotExist, msg.PoolAsset)
+	}
+	collateralAsset, custodyAsset, err := types.GetPositionAssets(msg.Position, msg.PoolAsset)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	borrowed := sdk.NewUintFromBigInt(sdk.NewDecFromBigInt(msg.CollateralAmount.BigInt()).Mul(msg.Leverage.Sub(sdk.OneDec())).TruncateInt().BigInt())
+	if borrowed.IsZero() {
+		return types.MTP{}, types.ErrBorrowTooLow
+	}
+	total := msg.CollateralAmount.Add(borrowed)
+	err = k.clpKeeper.CheckSwapAllowed(ctx, pool, clptypes.NewAsset(custodyAsset), total)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, clptypes.ModuleName, sdk.NewCoins(newCoin(collateralAsset, msg.CollateralAmount)))
+	if err != nil {
+		return types.MTP{}, err
+	}
+	custodyAmount, liquidityFee, swapFee, swappedPool, err := k.swap(ctx, pool, collateralAsset, total, custodyAsset)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	// The borrowed amount never leaves the pool, only the collateral was added to it
+	if collateralAsset == clptypes.GetSettlementAsset().Symbol {
+		swappedPool.NativeAssetBalance = swappedPool.NativeAssetBalance.Sub(borrowed)
+	} else {
+		swappedPool.ExternalAssetBalance = swappedPool.ExternalAssetBalance.Sub(borrowed)
+	}
+	err = k.clpKeeper.SettleSwap(ctx, owner, &swappedPool, clptypes.NewAsset(collateralAsset), total, clptypes.NewAsset(custodyAsset), custodyAmount, liquidityFee, swapFee)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, clptypes.ModuleName, types.ModuleName, sdk.NewCoins(newCoin(custodyAsset, custodyAmount)))
+	if err != nil {
+		return types.MTP{}, err
+	}
+	id := k.GetNextMTPID(ctx)
+	k.SetNextMTPID(ctx, id+1)
+	mtp := types.MTP{
+		Id:               id,
+		Address:          msg.Signer,
+		PoolAsset:        msg.PoolAsset,
+		Position:         msg.Position,
+		CollateralAsset:  collateralAsset,
+		CollateralAmount: msg.CollateralAmount,
+		CustodyAsset:     custodyAsset,
+		CustodyAmount:    custodyAmount,
+		LiabilitiesP:     borrowed,
+		LiabilitiesI:     sdk.ZeroUint(),
+		Leverage:         msg.Leverage,
+		OpenHeight:       ctx.BlockHeight(),
+	}
+	mtp.Health, err = k.CalcMTPHealth(ctx, mtp)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	if mtp.Health.LT(params.MaintenanceRatio) {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrMTPUnhealthy, mtp.Health.String())
+	}
+	k.SetMTP(ctx, &mtp)
+	return mtp, nil
+}
+
+// CloseMTP swaps the custody of a position back into its collateral asset, repays its liabilities to the pool
+// and returns what is left to its owner. When the custody does not cover the liabilities the pool takes the loss.
+// It fails when the swaps of the pool are not allowed.
+func (k Keeper) CloseMTP(ctx sdk.Context, mtp types.MTP) (sdk.Uint, sdk.Uint, error) {
+	pool, err := k.clpKeeper.GetPool(ctx, mtp.PoolAsset)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, sdkerrors.Wrap(types.ErrPoolDoesNotExist, mtp.PoolAsset)
+	}
+	err = k.clpKeeper.CheckSwapAllowed(ctx, pool, clptypes.NewAsset(mtp.CollateralAsset), mtp.CustodyAmount)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	return k.closeMTP(ctx, mtp, pool)
+}
+
+func (k Keeper) closeMTP(ctx sdk.Context, mtp types.MTP, pool clptypes.Pool) (sdk.Uint, sdk.Uint, error) {
+	owner, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, clptypes.ModuleName, sdk.NewCoins(newCoin(mtp.CustodyAsset, mtp.CustodyAmount)))
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	swapResult, liquidityFee, swapFee, swappedPool, err := k.swap(ctx, pool, mtp.CustodyAsset, mtp.CustodyAmount, mtp.CollateralAsset)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	repaid := sdk.MinUint(swapResult, mtp.GetLiabilities())
+	// The repaid liabilities stay in the pool
+	if mtp.CollateralAsset == clptypes.GetSettlementAsset().Symbol {
+		swappedPool.NativeAssetBalance = swappedPool.NativeAssetBalance.Add(repaid)
+	} else {
+		swappedPool.ExternalAssetBalance = swappedPool.ExternalAssetBalance.Add(repaid)
+	}
+	err = k.clpKeeper.SettleSwap(ctx, owner, &swappedPool, clptypes.NewAsset(mtp.CustodyAsset), mtp.CustodyAmount, clptypes.NewAsset(mtp.CollateralAsset), swapResult, liquidityFee, swapFee)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, err
+	}
+	returned := swapResult.Sub(repaid)
+	if !returned.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, clptypes.ModuleName, owner, sdk.NewCoins(newCoin(mtp.CollateralAsset, returned)))
+		if err != nil {
+			return sdk.Uint{}, sdk.Uint{}, err
+		}
+	}
+	k.DestroyMTP(ctx, mtp)
+	return repaid, returned, nil
+}
+
+// AddCollateral swaps amount of the collateral asset of a position into its custody asset, raising the health of
+// the position without changing its liabilities
+func (k Keeper) AddCollateral(ctx sdk.Context, mtp *types.MTP, amount sdk.Uint) error {
+	pool, err := k.clpKeeper.GetPool(ctx, mtp.PoolAsset)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrPoolDoesNotExist, mtp.PoolAsset)
+	}
+	owner, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return err
+	}
+	err = k.clpKeeper.CheckSwapAllowed(ctx, pool, clptypes.NewAsset(mtp.CustodyAsset), amount)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, clptypes.ModuleName, sdk.NewCoins(newCoin(mtp.CollateralAsset, amount)))
+	if err != nil {
+		return err
+	}
+	custodyAmount, liquidityFee, swapFee, swappedPool, err := k.swap(ctx, pool, mtp.CollateralAsset, amount, mtp.CustodyAsset)
+	if err != nil {
+		return err
+	}
+	err = k.clpKeeper.SettleSwap(ctx, owner, &swappedPool, clptypes.NewAsset(mtp.CollateralAsset), amount, clptypes.NewAsset(mtp.CustodyAsset), custodyAmount, liquidityFee, swapFee)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, clptypes.ModuleName, types.ModuleName, sdk.NewCoins(newCoin(mtp.CustodyAsset, custodyAmount)))
+	if err != nil {
+		return err
+	}
+	mtp.CollateralAmount = mtp.CollateralAmount.Add(amount)
+	mtp.CustodyAmount = mtp.CustodyAmount.Add(custodyAmount)
+	mtp.Health, err = k.CalcMTPHealth(ctx, *mtp)
+	if err != nil {
+		return err
+	}
+	k.SetMTP(ctx, mtp)
+	return nil
+}
+
+// ForceCloseMTPsForPool closes every position against a pool without checking that its swaps are allowed, so that
+// the liabilities of the positions are repaid before a pool winding down is settled. A position failing to close
+// is left open.
+func (k Keeper) ForceCloseMTPsForPool(ctx sdk.Context, poolAsset string) {
+	mtps, _, err := k.GetMTPsForPool(ctx, poolAsset, &query.PageRequest{Limit: uint64(math.MaxUint64)})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to get mtps of pool %s : %s", poolAsset, err.Error()))
+		return
+	}
+	for _, mtp := range mtps {
+		pool, err := k.clpKeeper.GetPool(ctx, poolAsset)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to close mtp %d : %s", mtp.Id, err.Error()))
+			return
+		}
+		var repaid, returned sdk.Uint
+		err = clpkeeper.ApplyCached(ctx, func(ctx sdk.Context) error {
+			var err error
+			repaid, returned, err = k.closeMTP(ctx, *mtp, pool)
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to close mtp %d : %s", mtp.Id, err.Error()))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeForceClose,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyRepaid, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyReturned, returned.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+}
+
+// RefundMTPsForPool returns the custody of every position left against a decommissioned pool to its owner,
+// the liabilities of the positions can no longer be repaid and are written off
+func (k Keeper) RefundMTPsForPool(ctx sdk.Context, poolAsset string) {
+	mtps, _, err := k.GetMTPsForPool(ctx, poolAsset, &query.PageRequest{Limit: uint64(math.MaxUint64)})
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to get mtps of pool %s : %s", poolAsset, err.Error()))
+		return
+	}
+	for _, mtp := range mtps {
+		err := clpkeeper.ApplyCached(ctx, func(ctx sdk.Context) error {
+			return k.refundMTP(ctx, *mtp)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to refund mtp %d : %s", mtp.Id, err.Error()))
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRefund,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyReturned, mtp.CustodyAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+}
+
+func (k Keeper) refundMTP(ctx sdk.Context, mtp types.MTP) error {
+	owner, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(newCoin(mtp.CustodyAsset, mtp.CustodyAmount)))
+	if err != nil {
+		return err
+	}
+	k.DestroyMTP(ctx, mtp)
+	return nil
+}
+
+// CalcMTPHealth returns the value of the custody of a position in its collateral asset over its liabilities
+func (k Keeper) CalcMTPHealth(ctx sdk.Context, mtp types.MTP) (sdk.Dec, error) {
+	pool, err := k.clpKeeper.GetPool(ctx, mtp.PoolAsset)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrPoolDoesNotExist, mtp.PoolAsset)
+	}
+	value, _, _, _, err := k.swap(ctx, pool, mtp.CustodyAsset, mtp.CustodyAmount, mtp.CollateralAsset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	liabilities := mtp.GetLiabilities()
+	if liabilities.IsZero() {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrMTPInvalid, "no liabilities")
+	}
+	return sdk.NewDecFromBigInt(value.BigInt()).Quo(sdk.NewDecFromBigInt(liabilities.BigInt())), nil
+}
+
+// UpdateMTPs accrues the interest of every position and liquidates the positions whose health fell below the
+// maintenance ratio
+func (k Keeper) UpdateMTPs(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, mtp := range k.GetMTPs(ctx) {
+		interest := sdk.NewDecFromBigInt(mtp.LiabilitiesP.BigInt()).Mul(params.InterestRate).Ceil().TruncateInt()
+		mtp.LiabilitiesI = mtp.LiabilitiesI.Add(sdk.NewUintFromBigInt(interest.BigInt()))
+		health, err := k.CalcMTPHealth(ctx, *mtp)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to update health of mtp %d : %s", mtp.Id, err.Error()))
+			k.SetMTP(ctx, mtp)
+			continue
+		}
+		mtp.Health = health
+		if health.GTE(params.MaintenanceRatio) {
+			k.SetMTP(ctx, mtp)
+			continue
+		}
+		var repaid, returned sdk.Uint
+		err = clpkeeper.ApplyCached(ctx, func(ctx sdk.Context) error {
+			var err error
+			repaid, returned, err = k.CloseMTP(ctx, *mtp)
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to liquidate mtp %d : %s", mtp.Id, err.Error()))
+			k.SetMTP(ctx, mtp)
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLiquidate,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyRepaid, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyReturned, returned.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	}
+}
+
+func (k Keeper) swap(ctx sdk.Context, pool clptypes.Pool, sentAsset string, sentAmount sdk.Uint, receivedAsset string) (sdk.Uint, sdk.Uint, sdk.Uint, clptypes.Pool, error) {
+	normalizationFactor, adjustExternalToken := k.clpKeeper.GetNormalizationFactorFromAsset(ctx, *pool.ExternalAsset)
+	pmtpCurrentRunningRate := k.clpKeeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	swapResult, liquidityFee, swapFee, _, swappedPool, err := clpkeeper.SwapOneWithSwapFee(clptypes.NewAsset(sentAsset), sentAmount, clptypes.NewAsset(receivedAsset),
+		pool, normalizationFactor, adjustExternalToken, pmtpCurrentRunningRate)
+	if err != nil {
+		return sdk.Uint{}, sdk.Uint{}, sdk.Uint{}, clptypes.Pool{}, err
+	}
+	return swapResult, liquidityFee, swapFee, swappedPool, nil
+}
+
+func newCoin(denom string, amount sdk.Uint) sdk.Coin {
+	return sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount.BigInt()))
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	marginkeeper "github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createMarginTestApp(t *testing.T, address string) (sdk.Context, *sifapp.SifchainApp) {
+	return test.CreateTestAppWithPool(address,
+		sdk.NewCoins(sdk.NewCoin("ceth", sdk.NewInt(10000000)), sdk.NewCoin("rowan", sdk.NewInt(10000000))),
+		[]*tokenregistrytypes.RegistryEntry{
+			{Denom: "ceth", BaseDenom: "ceth", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_MARGIN}},
+			{Denom: "cusdc", BaseDenom: "cusdc", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+			{Denom: "rowan", BaseDenom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+		})
+}
+
+func TestKeeper_MarginPositions(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createMarginTestApp(t, address)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	querier := marginkeeper.Querier{Keeper: app.MarginKeeper}
+	signer, _ := sdk.AccAddressFromBech32(address)
+
+	// Margin has to be enabled on the registry entry and leverage is capped
+	usdcMsg := types.NewMsgOpen(signer, "cusdc", types.Position_LONG, "rowan", sdk.NewUint(1000000), sdk.NewDec(2))
+	_, err := msgServer.Open(sdk.WrapSDKContext(ctx), &usdcMsg)
+	require.ErrorIs(t, err, types.ErrMarginNotEnabled)
+	highMsg := types.NewMsgOpen(signer, "ceth", types.Position_LONG, "rowan", sdk.NewUint(1000000), sdk.NewDec(6))
+	_, err = msgServer.Open(sdk.WrapSDKContext(ctx), &highMsg)
+	require.ErrorIs(t, err, types.ErrInvalidLeverage)
+
+	longMsg := types.NewMsgOpen(signer, "ceth", types.Position_LONG, "rowan", sdk.NewUint(1000000), sdk.NewDec(2))
+	res, err := msgServer.Open(sdk.WrapSDKContext(ctx), &longMsg)
+	require.NoError(t, err)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+	mtp, err := app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, "ceth", mtp.CustodyAsset)
+	require.Equal(t, "1000000", mtp.LiabilitiesP.String())
+	require.True(t, mtp.Health.GT(sdk.NewDecWithPrec(19, 1)))
+	custody := app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "ceth")
+	require.Equal(t, mtp.CustodyAmount.String(), custody.Amount.String())
+
+	byAddress, err := querier.GetPositionsForAddress(sdk.WrapSDKContext(ctx), &types.PositionsForAddressReq{Address: address})
+	require.NoError(t, err)
+	require.Len(t, byAddress.Mtps, 1)
+	byPool, err := querier.GetPositionsForPool(sdk.WrapSDKContext(ctx), &types.PositionsForPoolReq{Asset: "ceth"})
+	require.NoError(t, err)
+	require.Len(t, byPool.Mtps, 1)
+
+	// Interest accrues on the principal every block
+	params := app.MarginKeeper.GetParams(ctx)
+	params.InterestRate = sdk.NewDecWithPrec(1, 3)
+	app.MarginKeeper.SetParams(ctx, params)
+	app.MarginKeeper.UpdateMTPs(ctx)
+	mtp, _ = app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.Equal(t, "1000", mtp.LiabilitiesI.String())
+
+	// Added collateral is swapped into the custody, the liabilities are unchanged
+	_, err = msgServer.AddCollateral(sdk.WrapSDKContext(ctx), &types.MsgAddCollateral{Signer: address, Id: res.Id, CollateralAmount: sdk.NewUint(100000)})
+	require.NoError(t, err)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+	added, _ := app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.Equal(t, "1000", added.LiabilitiesI.String())
+	require.Equal(t, "1000000", added.LiabilitiesP.String())
+	require.Equal(t, "1100000", added.CollateralAmount.String())
+	require.True(t, added.CustodyAmount.GT(mtp.CustodyAmount))
+	require.True(t, added.Health.GT(mtp.Health))
+	custody = app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "ceth")
+	require.Equal(t, added.CustodyAmount.String(), custody.Amount.String())
+
+	// Positions cannot be closed while the swaps of their pool are paused
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	pool.SwapsPaused = true
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, err = msgServer.Close(sdk.WrapSDKContext(ctx), &types.MsgClose{Signer: address, Id: res.Id})
+	require.ErrorIs(t, err, clptypes.ErrPoolPaused)
+	pool.SwapsPaused = false
+	// Nor while the pool clears its swaps in batch mode
+	pool.SwapMode = clptypes.SwapMode_SWAP_MODE_BATCH
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, err = msgServer.Close(sdk.WrapSDKContext(ctx), &types.MsgClose{Signer: address, Id: res.Id})
+	require.ErrorIs(t, err, clptypes.ErrPoolInBatchMode)
+	failCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Open(sdk.WrapSDKContext(failCtx), &longMsg)
+	require.ErrorIs(t, err, clptypes.ErrPoolInBatchMode)
+	pool.SwapMode = clptypes.SwapMode_SWAP_MODE_SEQUENTIAL
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+
+	_, err = msgServer.Close(sdk.WrapSDKContext(ctx), &types.MsgClose{Signer: "sif1l7hypmqk2yc334vc6vmdwzp5sdefygj2ad93p5", Id: res.Id})
+	require.ErrorIs(t, err, types.ErrNotMTPOwner)
+	rowanBefore := app.BankKeeper.GetBalance(ctx, signer, "rowan")
+	closeRes, err := msgServer.Close(sdk.WrapSDKContext(ctx), &types.MsgClose{Signer: address, Id: res.Id})
+	require.NoError(t, err)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+	require.True(t, closeRes.ReturnedAmount.GT(sdk.NewUint(1000000)))
+	require.Equal(t, rowanBefore.Amount.Add(sdk.NewIntFromBigInt(closeRes.ReturnedAmount.BigInt())), app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount)
+	_, err = app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	require.True(t, app.BankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), "ceth").IsZero())
+
+	// Positions whose health falls below the maintenance ratio are liquidated
+	shortMsg := types.NewMsgOpen(signer, "ceth", types.Position_SHORT, "ceth", sdk.NewUint(1000000), sdk.NewDec(5))
+	res, err = msgServer.Open(sdk.WrapSDKContext(ctx), &shortMsg)
+	require.NoError(t, err)
+	mtp, _ = app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.Equal(t, "rowan", mtp.CustodyAsset)
+	params.MaintenanceRatio = sdk.NewDec(2)
+	app.MarginKeeper.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.MarginKeeper.UpdateMTPs(ctx)
+	_, err = app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+	liquidated, transferred := false, false
+	for _, event := range ctx.EventManager().Events() {
+		liquidated = liquidated || event.Type == types.EventTypeLiquidate
+		transferred = transferred || event.Type == banktypes.EventTypeTransfer
+	}
+	require.True(t, liquidated)
+	require.True(t, transferred)
+}
+
+func TestKeeper_MarginPoolDecommissioned(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createMarginTestApp(t, address)
+	msgServer := marginkeeper.NewMsgServerImpl(app.MarginKeeper)
+	clpMsgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer, _ := sdk.AccAddressFromBech32(address)
+	marginAddress := authtypes.NewModuleAddress(types.ModuleName)
+	dai := clptypes.NewAsset("cdai")
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:               dai.Symbol,
+		BaseDenom:           dai.Symbol,
+		Decimals:            18,
+		Permissions:         []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_MARGIN},
+		MinPoolNativeAmount: "1",
+	})
+	creator := sdk.AccAddress("creator_____________")
+	funds := sdk.NewCoins(sdk.NewCoin("rowan", sdk.NewInt(100000000000)), sdk.NewCoin(dai.Symbol, sdk.NewInt(100000000000)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, clptypes.ModuleName, funds))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, clptypes.ModuleName, creator, funds))
+	createMsg := clptypes.NewMsgCreatePool(creator, dai, sdk.NewUint(10000000000), sdk.NewUint(10000000000))
+	_, err := clpMsgServer.CreatePool(sdk.WrapSDKContext(ctx), &createMsg)
+	require.NoError(t, err)
+
+	var ids []uint64
+	for i := 0; i < 2; i++ {
+		openMsg := types.NewMsgOpen(signer, dai.Symbol, types.Position_LONG, "rowan", sdk.NewUint(1000000), sdk.NewDec(2))
+		res, err := msgServer.Open(sdk.WrapSDKContext(ctx), &openMsg)
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+
+	// Positions cannot be closed while their pool winds down, they are closed when it is settled
+	decommissionMsg := clptypes.NewMsgDecommissionPool(signer, dai.Symbol, 10, "")
+	_, err = clpMsgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &decommissionMsg)
+	require.NoError(t, err)
+	_, err = msgServer.Close(sdk.WrapSDKContext(ctx), &types.MsgClose{Signer: address, Id: ids[0]})
+	require.ErrorIs(t, err, clptypes.ErrPoolWindingDown)
+	rowanBefore := app.BankKeeper.GetBalance(ctx, signer, "rowan")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.SettleWoundDownPools(ctx)
+	_, err = app.ClpKeeper.GetPool(ctx, dai.Symbol)
+	require.ErrorIs(t, err, clptypes.ErrPoolDoesNotExist)
+	for _, id := range ids {
+		_, err = app.MarginKeeper.GetMTP(ctx, id)
+		require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	}
+	closed, transfers := 0, 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeForceClose {
+			closed++
+		}
+		if event.Type == banktypes.EventTypeTransfer {
+			transfers++
+		}
+	}
+	require.Equal(t, 2, closed)
+	require.Greater(t, transfers, 2)
+	require.True(t, app.BankKeeper.GetBalance(ctx, signer, "rowan").Amount.GT(rowanBefore.Amount))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, marginAddress).IsZero())
+	test.RequireInvariants(t, ctx, app.ClpKeeper)
+
+	// Positions left against a decommissioned pool get their custody back
+	openMsg := types.NewMsgOpen(signer, "ceth", types.Position_LONG, "rowan", sdk.NewUint(1000000), sdk.NewDec(2))
+	res, err := msgServer.Open(sdk.WrapSDKContext(ctx), &openMsg)
+	require.NoError(t, err)
+	mtp, err := app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, "ceth")
+	require.NoError(t, err)
+	ethBefore := app.BankKeeper.GetBalance(ctx, signer, "ceth")
+	app.MarginKeeper.Hooks().AfterPoolDecommissioned(ctx, pool)
+	_, err = app.MarginKeeper.GetMTP(ctx, res.Id)
+	require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+	require.Equal(t, ethBefore.Amount.Add(sdk.NewIntFromBigInt(mtp.CustodyAmount.BigInt())), app.BankKeeper.GetBalance(ctx, signer, "ceth").Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, marginAddress).IsZero())
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the margin MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) Open(goCtx context.Context, msg *types.MsgOpen) (*types.MsgOpenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtp, err := k.Keeper.OpenMTP(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOpen,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgOpenResponse{Id: mtp.Id}, nil
+}
+
+func (k msgServer) Close(goCtx context.Context, msg *types.MsgClose) (*types.MsgCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtp, err := k.getOwnedMTP(ctx, msg.Id, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	repaid, returned, err := k.Keeper.CloseMTP(ctx, mtp)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClose,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyRepaid, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyReturned, returned.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgCloseResponse{ReturnedAmount: returned}, nil
+}
+
+func (k msgServer) AddCollateral(goCtx context.Context, msg *types.MsgAddCollateral) (*types.MsgAddCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	mtp, err := k.getOwnedMTP(ctx, msg.Id, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.AddCollateral(ctx, &mtp, msg.CollateralAmount)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddCollateral,
+			sdk.NewAttribute(types.AttributeKeyMTP, mtp.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateral, msg.CollateralAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+	return &types.MsgAddCollateralResponse{}, nil
+}
+
+func (k msgServer) getOwnedMTP(ctx sdk.Context, id uint64, signer string) (types.MTP, error) {
+	mtp, err := k.Keeper.GetMTP(ctx, id)
+	if err != nil {
+		return types.MTP{}, err
+	}
+	if mtp.Address != signer {
+		return types.MTP{}, sdkerrors.Wrap(types.ErrNotMTPOwner, signer)
+	}
+	return mtp, nil
+}
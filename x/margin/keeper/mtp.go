@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/margin/types"
+)
+
+func (k Keeper) GetNextMTPID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MTPNextIDPrefix)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextMTPID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MTPNextIDPrefix, sdk.Uint64ToBigEndian(id))
+}
+
+// SetMTP stores a position along with its owner and pool indexes
+func (k Keeper) SetMTP(ctx sdk.Context, mtp *types.MTP) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMTPKey(mtp.Id), k.cdc.MustMarshal(mtp))
+	store.Set(types.GetMTPAddressKey(mtp.Address, mtp.Id), []byte{})
+	store.Set(types.GetMTPPoolKey(mtp.PoolAsset, mtp.Id), []byte{})
+}
+
+func (k Keeper) GetMTP(ctx sdk.Context, id uint64) (types.MTP, error) {
+	var mtp types.MTP
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMTPKey(id))
+	if bz == nil {
+		return mtp, types.ErrMTPDoesNotExist
+	}
+	k.cdc.MustUnmarshal(bz, &mtp)
+	return mtp, nil
+}
+
+// DestroyMTP removes a position along with its indexes
+func (k Keeper) DestroyMTP(ctx sdk.Context, mtp types.MTP) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMTPKey(mtp.Id))
+	store.Delete(types.GetMTPAddressKey(mtp.Address, mtp.Id))
+	store.Delete(types.GetMTPPoolKey(mtp.PoolAsset, mtp.Id))
+}
+
+// GetMTPs returns every open position in ascending id
+func (k Keeper) GetMTPs(ctx sdk.Context) []*types.MTP {
+	var mtps []*types.MTP
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MTPPrefix)
+	defer func(iterator sdk.Iterator) {
+		err := iterator.Close()
+		if err != nil {
+			panic(err)
+		}
+	}(iterator)
+	for ; iterator.Valid(); iterator.Next() {
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(iterator.Value(), &mtp)
+		mtps = append(mtps, &mtp)
+	}
+	return mtps
+}
+
+// GetMTPsForAddress returns a page of the positions of an owner
+func (k Keeper) GetMTPsForAddress(ctx sdk.Context, address string, pagination *query.PageRequest) ([]*types.MTP, *query.PageResponse, error) {
+	return k.getIndexedMTPs(ctx, types.GetMTPAddressPrefix(address), pagination)
+}
+
+// GetMTPsForPool returns a page of the positions against a pool
+func (k Keeper) GetMTPsForPool(ctx sdk.Context, asset string, pagination *query.PageRequest) ([]*types.MTP, *query.PageResponse, error) {
+	return k.getIndexedMTPs(ctx, types.GetMTPPoolPrefix(asset), pagination)
+}
+
+func (k Keeper) getIndexedMTPs(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]*types.MTP, *query.PageResponse, error) {
+	var mtps []*types.MTP
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, _ []byte) error {
+		mtp, err := k.GetMTP(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		mtps = append(mtps, &mtp)
+		return nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return mtps, pageRes, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrMTPDoesNotExist   = sdkerrors.Register(ModuleName, 1, "mtp not found")
+	ErrMTPInvalid        = sdkerrors.Register(ModuleName, 2, "mtp invalid")
+	ErrMTPUnhealthy      = sdkerrors.Register(ModuleName, 3, "mtp health would be too low for safety factor")
+	ErrInvalidPosition   = sdkerrors.Register(ModuleName, 4, "position invalid")
+	ErrInvalidLeverage   = sdkerrors.Register(ModuleName, 5, "leverage invalid")
+	ErrInvalidCollateral = sdkerrors.Register(ModuleName, 6, "collateral invalid")
+	ErrMarginNotEnabled  = sdkerrors.Register(ModuleName, 7, "margin not enabled for pool")
+	ErrNotMTPOwner       = sdkerrors.Register(ModuleName, 8, "signer does not own the mtp")
+	ErrPoolDoesNotExist  = sdkerrors.Register(ModuleName, 9, "pool does not exist")
+	ErrBorrowTooLow      = sdkerrors.Register(ModuleName, 10, "borrowed amount is zero")
+)
@@ -0,0 +1,21 @@
+package types
+
+// margin module event types
+
+const (
+	EventTypeOpen          = "margin_mtp_open"
+	EventTypeClose         = "margin_mtp_close"
+	EventTypeAddCollateral = "margin_mtp_add_collateral"
+	EventTypeLiquidate     = "margin_mtp_liquidate"
+	EventTypeForceClose    = "margin_mtp_force_close"
+	EventTypeRefund        = "margin_mtp_refund"
+	AttributeKeyMTP        = "mtp"
+	AttributeKeyCollateral = "collateral"
+	AttributeKeyRepaid     = "repaid"
+	AttributeKeyReturned   = "returned"
+	AttributeKeyHeight     = "height"
+)
+
+const (
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool
+}
+
+// ClpKeeper is the part of the clp keeper positions are borrowed from and swapped through
+type ClpKeeper interface {
+	GetPool(ctx sdk.Context, symbol string) (clptypes.Pool, error)
+	SetPool(ctx sdk.Context, pool *clptypes.Pool) error
+	GetNormalizationFactorFromAsset(ctx sdk.Context, asset clptypes.Asset) (sdk.Dec, bool)
+	GetPmtpRateParams(ctx sdk.Context) clptypes.PmtpRateParams
+	CheckSwapAllowed(ctx sdk.Context, pool clptypes.Pool, receivedAsset clptypes.Asset, sentAmount sdk.Uint) error
+	SettleSwap(ctx sdk.Context, swapper sdk.AccAddress, swappedPool *clptypes.Pool, sentAsset clptypes.Asset, sentAmount sdk.Uint,
+		receivedAsset clptypes.Asset, swapResult sdk.Uint, liquidityFee sdk.Uint, swapFee sdk.Uint) error
+}
+
+type TokenRegistryKeeper interface {
+	GetEntry(registry tokenregistrytypes.Registry, denom string) (*tokenregistrytypes.RegistryEntry, error)
+	CheckEntryPermissions(entry *tokenregistrytypes.RegistryEntry, permissions []tokenregistrytypes.Permission) bool
+	GetRegistry(ctx sdk.Context) tokenregistrytypes.Registry
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState gets the raw genesis raw message for testing
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		NextMtpId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation
+func (gs GenesisState) Validate() error {
+	err := gs.Params.Validate()
+	if err != nil {
+		return err
+	}
+	for _, mtp := range gs.MtpList {
+		if mtp == nil || mtp.Id == 0 || mtp.Id >= gs.NextMtpId {
+			return fmt.Errorf("mtp id must be between 1 and the next mtp id %d: %v", gs.NextMtpId, mtp)
+		}
+		err = mtp.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "margin"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+)
+
+var (
+	MTPPrefix        = []byte{0x01} // Key to store positions by id
+	MTPAddressPrefix = []byte{0x02} // Key to index positions by owner
+	MTPPoolPrefix    = []byte{0x03} // Key to index positions by pool
+	MTPNextIDPrefix  = []byte{0x04} // Key to store the id of the next position
+)
+
+// Generate key to store a position
+// The key is the big endian encoded position id
+func GetMTPKey(id uint64) []byte {
+	return append(MTPPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate the prefix for all positions of an owner
+// The prefix is of the format address_
+func GetMTPAddressPrefix(address string) []byte {
+	key := []byte(fmt.Sprintf("%s_", address))
+	return append(MTPAddressPrefix, key...)
+}
+
+// Generate key to index a position by owner, positions of an owner iterate in ascending id
+func GetMTPAddressKey(address string, id uint64) []byte {
+	return append(GetMTPAddressPrefix(address), sdk.Uint64ToBigEndian(id)...)
+}
+
+// Generate the prefix for all positions against a pool
+// The prefix is of the format externalticker_
+func GetMTPPoolPrefix(externalTicker string) []byte {
+	key := []byte(fmt.Sprintf("%s_", externalTicker))
+	return append(MTPPoolPrefix, key...)
+}
+
+// Generate key to index a position by pool, positions against a pool iterate in ascending id
+func GetMTPPoolKey(externalTicker string, id uint64) []byte {
+	return append(GetMTPPoolPrefix(externalTicker), sdk.Uint64ToBigEndian(id)...)
+}
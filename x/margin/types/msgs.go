@@ -0,0 +1,139 @@
+package types
+
+import (
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgOpen{}
+	_ sdk.Msg = &MsgClose{}
+	_ sdk.Msg = &MsgAddCollateral{}
+)
+
+func NewMsgOpen(signer sdk.AccAddress, poolAsset string, position Position, collateralAsset string, collateralAmount sdk.Uint, leverage sdk.Dec) MsgOpen {
+	return MsgOpen{
+		Signer:           signer.String(),
+		PoolAsset:        poolAsset,
+		Position:         position,
+		CollateralAsset:  collateralAsset,
+		CollateralAmount: collateralAmount,
+		Leverage:         leverage,
+	}
+}
+
+func (m MsgOpen) Route() string {
+	return RouterKey
+}
+
+func (m MsgOpen) Type() string {
+	return "open"
+}
+
+func (m MsgOpen) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	pool := clptypes.NewAsset(m.PoolAsset)
+	if !pool.Validate() || pool.Equals(clptypes.GetSettlementAsset()) {
+		return sdkerrors.Wrap(clptypes.ErrInValidAsset, m.PoolAsset)
+	}
+	collateralAsset, _, err := GetPositionAssets(m.Position, m.PoolAsset)
+	if err != nil {
+		return err
+	}
+	if m.CollateralAsset != collateralAsset {
+		return sdkerrors.Wrapf(ErrInvalidCollateral, "%s position must be opened with %s collateral", m.Position, collateralAsset)
+	}
+	if m.CollateralAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidCollateral, m.CollateralAmount.String())
+	}
+	if m.Leverage.IsNil() || m.Leverage.LTE(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalidLeverage, "leverage must be greater than 1")
+	}
+	return nil
+}
+
+func (m MsgOpen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgOpen) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgClose(signer sdk.AccAddress, id uint64) MsgClose {
+	return MsgClose{Signer: signer.String(), Id: id}
+}
+
+func (m MsgClose) Route() string {
+	return RouterKey
+}
+
+func (m MsgClose) Type() string {
+	return "close"
+}
+
+func (m MsgClose) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "id must be positive")
+	}
+	return nil
+}
+
+func (m MsgClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClose) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgAddCollateral(signer sdk.AccAddress, id uint64, collateralAmount sdk.Uint) MsgAddCollateral {
+	return MsgAddCollateral{Signer: signer.String(), Id: id, CollateralAmount: collateralAmount}
+}
+
+func (m MsgAddCollateral) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddCollateral) Type() string {
+	return "add_collateral"
+}
+
+func (m MsgAddCollateral) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "id must be positive")
+	}
+	if m.CollateralAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidCollateral, m.CollateralAmount.String())
+	}
+	return nil
+}
+
+func (m MsgAddCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddCollateral) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyLeverageMax      = []byte("LeverageMax")
+	KeyInterestRate     = []byte("InterestRate")
+	KeyMaintenanceRatio = []byte("MaintenanceRatio")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable for margin module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyLeverageMax, &p.LeverageMax, validateLeverageMax),
+		paramtypes.NewParamSetPair(KeyInterestRate, &p.InterestRate, validateInterestRate),
+		paramtypes.NewParamSetPair(KeyMaintenanceRatio, &p.MaintenanceRatio, validateMaintenanceRatio),
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return Params{
+		LeverageMax:      sdk.NewDec(5),
+		InterestRate:     sdk.NewDecWithPrec(1, 8),
+		MaintenanceRatio: sdk.NewDecWithPrec(11, 1),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateLeverageMax(p.LeverageMax); err != nil {
+		return err
+	}
+	if err := validateInterestRate(p.InterestRate); err != nil {
+		return err
+	}
+	return validateMaintenanceRatio(p.MaintenanceRatio)
+}
+
+func validateLeverageMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.LTE(sdk.OneDec()) {
+		return fmt.Errorf("leverage max must be greater than 1: %s", v)
+	}
+	return nil
+}
+
+func validateInterestRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("interest rate must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateMaintenanceRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// Below 1 liquidations could not repay the pool
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("maintenance ratio must be at least 1: %s", v)
+	}
+	return nil
+}
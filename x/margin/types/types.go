@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetPositionAssets returns the collateral and custody assets of a position against the pool of poolAsset
+func GetPositionAssets(position Position, poolAsset string) (string, string, error) {
+	native := clptypes.GetSettlementAsset().Symbol
+	switch position {
+	case Position_LONG:
+		return native, poolAsset, nil
+	case Position_SHORT:
+		return poolAsset, native, nil
+	default:
+		return "", "", sdkerrors.Wrap(ErrInvalidPosition, position.String())
+	}
+}
+
+// GetLiabilities returns the principal and the unpaid interest owed to the pool
+func (mtp MTP) GetLiabilities() sdk.Uint {
+	return mtp.LiabilitiesP.Add(mtp.LiabilitiesI)
+}
+
+func (mtp MTP) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(mtp.Address); err != nil {
+		return sdkerrors.Wrap(ErrMTPInvalid, err.Error())
+	}
+	collateralAsset, custodyAsset, err := GetPositionAssets(mtp.Position, mtp.PoolAsset)
+	if err != nil {
+		return err
+	}
+	if mtp.CollateralAsset != collateralAsset || mtp.CustodyAsset != custodyAsset {
+		return sdkerrors.Wrap(ErrMTPInvalid, fmt.Sprintf("%s position of pool %s must hold %s against %s collateral",
+			mtp.Position, mtp.PoolAsset, custodyAsset, collateralAsset))
+	}
+	return nil
+}
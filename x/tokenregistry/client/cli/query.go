@@ -70,6 +70,10 @@ func GetCmdGenerateEntry() *cobra.Command {
 	var flagNetwork = "token_network"
 	var flagAddress = "token_address"
 	var flagsPermission = []string{"token_permission_clp", "token_permission_ibc_export", "token_permission_ibc_import"}
+	var flagPermissionMargin = "token_permission_margin"
+	var flagMinPoolNativeAmount = "token_min_pool_native_amount"
+	var flagMinPoolExternalAmount = "token_min_pool_external_amount"
+	var flagPoolApprovalRequired = "token_pool_approval_required"
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "generate JSON for a token registration",
@@ -140,6 +144,18 @@ func GetCmdGenerateEntry() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			minPoolNativeAmount, err := flags.GetString(flagMinPoolNativeAmount)
+			if err != nil {
+				return err
+			}
+			minPoolExternalAmount, err := flags.GetString(flagMinPoolExternalAmount)
+			if err != nil {
+				return err
+			}
+			poolApprovalRequired, err := flags.GetBool(flagPoolApprovalRequired)
+			if err != nil {
+				return err
+			}
 			permissions := []types.Permission{}
 			permissionCLP, err := flags.GetBool("token_permission_clp")
 			if err != nil {
@@ -162,6 +178,13 @@ func GetCmdGenerateEntry() *cobra.Command {
 			if permissionIBCImport {
 				permissions = append(permissions, types.Permission_IBCIMPORT)
 			}
+			permissionMargin, err := flags.GetBool(flagPermissionMargin)
+			if err != nil {
+				return err
+			}
+			if permissionMargin {
+				permissions = append(permissions, types.Permission_MARGIN)
+			}
 			var denom string
 			var path string
 			// base_denom is required.
@@ -202,6 +225,12 @@ func GetCmdGenerateEntry() *cobra.Command {
 				ExternalSymbol:           externalSymbol,
 				TransferLimit:            transferLimit,
 				Permissions:              permissions,
+				MinPoolNativeAmount:      minPoolNativeAmount,
+				MinPoolExternalAmount:    minPoolExternalAmount,
+				PoolApprovalRequired:     poolApprovalRequired,
+			}
+			if err := entry.ValidatePoolCreationAmounts(); err != nil {
+				return err
 			}
 			return clientCtx.PrintProto(&types.Registry{Entries: []*types.RegistryEntry{&entry}})
 		},
@@ -238,6 +267,14 @@ func GetCmdGenerateEntry() *cobra.Command {
 	for _, flag := range flagsPermission {
 		cmd.Flags().Bool(flag, true, fmt.Sprintf("Flag to specify permission for %s", types.GetPermissionFromString(flag)))
 	}
+	// Margin trading is opt-in for every token
+	cmd.Flags().Bool(flagPermissionMargin, false, fmt.Sprintf("Flag to specify permission for %s", types.Permission_MARGIN))
+	cmd.Flags().String(flagMinPoolNativeAmount, "",
+		"Least rowan amount a clp pool of the token can be created with, empty for the clp default")
+	cmd.Flags().String(flagMinPoolExternalAmount, "",
+		"Least token amount a clp pool of the token can be created with, empty for the clp default")
+	cmd.Flags().Bool(flagPoolApprovalRequired, false,
+		"New clp pools of the token stay pending until a clp admin approves them")
 	_ = cmd.MarkFlagRequired(flagBaseDenom)
 	_ = cmd.MarkFlagRequired(flagDecimals)
 	flags.AddQueryFlagsToCmd(cmd)
@@ -41,7 +41,7 @@ func (m *MsgRegister) ValidateBasic() error {
 	if m.Entry.Decimals <= 0 {
 		return errors.New("Decimals cannot be zero")
 	}
-	return nil
+	return m.Entry.ValidatePoolCreationAmounts()
 }
 
 func (m *MsgRegister) GetSignBytes() []byte {
@@ -86,6 +86,9 @@ func (m *MsgSetRegistry) ValidateBasic() error {
 		if entry.Decimals <= 0 {
 			return errors.New("Decimals cannot be zero")
 		}
+		if err := entry.ValidatePoolCreationAmounts(); err != nil {
+			return err
+		}
 	}
 
 	_, err := sdk.AccAddressFromBech32(m.From)
@@ -48,6 +48,18 @@ func TestMsgUpdateWhitelist_ValidateBasic(t *testing.T) {
 			},
 			assertion: assert.Error,
 		},
+		{
+			name: "Invalid Minimum Pool Amount",
+			msg: types.MsgRegister{
+				From: admin.String(),
+				Entry: &types.RegistryEntry{
+					Denom:               "TestDenom",
+					Decimals:            18,
+					MinPoolNativeAmount: "-1",
+				},
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Empty from",
 			msg: types.MsgRegister{
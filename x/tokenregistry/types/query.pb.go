@@ -119,7 +119,7 @@ func init() {
 }
 
 var fileDescriptor_c311bc06126a6f47 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
+	// 289 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xce, 0x4c, 0xcb,
 	0xcb, 0x4f, 0x49, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x2b, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x29,
 	0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
@@ -137,8 +137,8 @@ var fileDescriptor_c311bc06126a6f47 = []byte{
 	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
 	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5,
 	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc1, 0x30, 0xc3, 0x60, 0x01, 0x5d, 0x81, 0x66, 0x2c, 0x38,
-	0x9c, 0x93, 0xd8, 0xc0, 0x01, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x06, 0x74, 0x5a, 0x30,
-	0x04, 0x02, 0x00, 0x00,
+	0x9c, 0x93, 0xd8, 0xc0, 0x01, 0x6d, 0x0c, 0x18, 0x00, 0x06, 0x74, 0x5a, 0x30, 0x04, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -304,7 +304,7 @@ func init() {
 func init() { proto.RegisterFile("sifnode/tokenregistry/v1/tx.proto", fileDescriptor_d09312f3deb69cfe) }
 
 var fileDescriptor_d09312f3deb69cfe = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
+	// 353 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0xc2, 0x40,
 	0x14, 0xc7, 0x5b, 0x14, 0x03, 0x8f, 0xe8, 0x50, 0x41, 0x9b, 0x0e, 0x0d, 0x36, 0x1a, 0x58, 0xec,
 	0x09, 0x4e, 0x0e, 0x3a, 0x18, 0x9d, 0x4c, 0x97, 0x63, 0x73, 0x52, 0xe0, 0x38, 0x1a, 0x42, 0x8f,
@@ -326,8 +326,8 @@ var fileDescriptor_d09312f3deb69cfe = []byte{
 	0x5a, 0x76, 0xff, 0xf4, 0x39, 0x77, 0xcd, 0xd9, 0xdc, 0x35, 0xbf, 0xe7, 0xae, 0xf9, 0xbe, 0x70,
 	0x8d, 0xd9, 0xc2, 0x35, 0xbe, 0x16, 0xae, 0xf1, 0xdc, 0xa0, 0xa1, 0xec, 0xbf, 0xb5, 0xfd, 0x0e,
 	0x1b, 0xa2, 0x56, 0xd8, 0xeb, 0xf4, 0x5f, 0xc3, 0x08, 0x25, 0x37, 0x3e, 0xf9, 0x75, 0xe5, 0xea,
-	0xc4, 0xdb, 0x07, 0xea, 0xc6, 0xaf, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x0b, 0x39, 0x49,
-	0x5e, 0x03, 0x00, 0x00,
+	0xc4, 0xdb, 0x07, 0xea, 0xc6, 0xaf, 0x7f, 0x06, 0x00, 0xfa, 0x0b, 0x39, 0x49, 0x5e, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the whitelist module
 	ModuleName = "tokenregistry"
@@ -22,7 +28,22 @@ func GetPermissionFromString(s string) Permission {
 		return Permission_IBCEXPORT
 	case "permission_ibc_import":
 		return Permission_IBCIMPORT
+	case "permission_margin":
+		return Permission_MARGIN
 	default:
 		return Permission_UNSPECIFIED
 	}
 }
+
+// ValidatePoolCreationAmounts checks that the minimum pool creation amounts of the entry are empty or unsigned integers
+func (e RegistryEntry) ValidatePoolCreationAmounts() error {
+	for _, amount := range []string{e.MinPoolNativeAmount, e.MinPoolExternalAmount} {
+		if amount == "" {
+			continue
+		}
+		if _, err := sdk.ParseUint(amount); err != nil {
+			return fmt.Errorf("invalid minimum pool amount %s: %s", amount, err.Error())
+		}
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ const (
 	Permission_CLP         Permission = 1
 	Permission_IBCEXPORT   Permission = 2
 	Permission_IBCIMPORT   Permission = 3
+	Permission_MARGIN      Permission = 4
 )
 
 var Permission_name = map[int32]string{
@@ -37,6 +38,7 @@ var Permission_name = map[int32]string{
 	1: "CLP",
 	2: "IBCEXPORT",
 	3: "IBCIMPORT",
+	4: "MARGIN",
 }
 
 var Permission_value = map[string]int32{
@@ -44,6 +46,7 @@ var Permission_value = map[string]int32{
 	"CLP":         1,
 	"IBCEXPORT":   2,
 	"IBCIMPORT":   3,
+	"MARGIN":      4,
 }
 
 func (x Permission) String() string {
@@ -213,6 +216,14 @@ type RegistryEntry struct {
 	// the packet level. i.e rowan -> microrowan i.e microrowan -> microrowan
 	IbcCounterpartyDenom   string `protobuf:"bytes,17,opt,name=ibc_counterparty_denom,json=ibcCounterpartyDenom,proto3" json:"ibc_counterparty_denom,omitempty"`
 	IbcCounterpartyChainId string `protobuf:"bytes,18,opt,name=ibc_counterparty_chain_id,json=ibcCounterpartyChainId,proto3" json:"ibc_counterparty_chain_id,omitempty"`
+	// min_pool_native_amount and min_pool_external_amount are the least amounts
+	// a clp pool of this token can be created with, the clp defaults apply when
+	// they are empty
+	MinPoolNativeAmount   string `protobuf:"bytes,19,opt,name=min_pool_native_amount,json=minPoolNativeAmount,proto3" json:"min_pool_native_amount,omitempty"`
+	MinPoolExternalAmount string `protobuf:"bytes,20,opt,name=min_pool_external_amount,json=minPoolExternalAmount,proto3" json:"min_pool_external_amount,omitempty"`
+	// pools of this token stay pending until a clp admin approves them when
+	// pool_approval_required is set, pending pools cannot be swapped against
+	PoolApprovalRequired bool `protobuf:"varint,21,opt,name=pool_approval_required,json=poolApprovalRequired,proto3" json:"pool_approval_required,omitempty"`
 }
 
 func (m *RegistryEntry) Reset()         { *m = RegistryEntry{} }
@@ -360,6 +371,27 @@ func (m *RegistryEntry) GetIbcCounterpartyChainId() string {
 	return ""
 }
 
+func (m *RegistryEntry) GetMinPoolNativeAmount() string {
+	if m != nil {
+		return m.MinPoolNativeAmount
+	}
+	return ""
+}
+
+func (m *RegistryEntry) GetMinPoolExternalAmount() string {
+	if m != nil {
+		return m.MinPoolExternalAmount
+	}
+	return ""
+}
+
+func (m *RegistryEntry) GetPoolApprovalRequired() bool {
+	if m != nil {
+		return m.PoolApprovalRequired
+	}
+	return false
+}
+
 type AdminAccount struct {
 	AdminType    AdminType `protobuf:"varint,1,opt,name=admin_type,json=adminType,proto3,enum=sifnode.tokenregistry.v1.AdminType" json:"admin_type,omitempty"`
 	AdminAddress string    `protobuf:"bytes,2,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
@@ -471,53 +503,59 @@ func init() {
 }
 
 var fileDescriptor_d08afdaf425e66ea = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0x22, 0x49,
-	0x18, 0xa6, 0x41, 0x05, 0x5e, 0x68, 0x6c, 0x2b, 0xc6, 0xf4, 0xba, 0x59, 0xe2, 0xa2, 0xae, 0xc6,
-	0x03, 0x44, 0x77, 0x2f, 0x7b, 0xd8, 0x4d, 0xf8, 0x68, 0xdd, 0x56, 0x41, 0xd2, 0xb0, 0x19, 0x67,
-	0x0e, 0x43, 0x0a, 0xba, 0xc4, 0x8a, 0x74, 0x35, 0xe9, 0x2a, 0x3f, 0xf8, 0x01, 0x73, 0x9f, 0x5f,
-	0x30, 0xbf, 0x67, 0x8e, 0x1e, 0xe7, 0x38, 0xd1, 0x3f, 0x32, 0xe9, 0xaa, 0x6e, 0xc4, 0xaf, 0x99,
-	0xb9, 0xd5, 0xfb, 0x7c, 0xbc, 0x29, 0xfa, 0xe1, 0x29, 0xd8, 0xe0, 0xf4, 0x8c, 0xf9, 0x2e, 0xa9,
-	0x08, 0xff, 0x82, 0xb0, 0x80, 0x0c, 0x29, 0x17, 0xc1, 0xa4, 0x72, 0xb5, 0x5b, 0x11, 0x93, 0x31,
-	0xe1, 0xe5, 0x71, 0xe0, 0x0b, 0x1f, 0x99, 0x91, 0xaa, 0xfc, 0x48, 0x55, 0xbe, 0xda, 0x5d, 0x5d,
-	0x1e, 0xfa, 0x43, 0x5f, 0x8a, 0x2a, 0xe1, 0x49, 0xe9, 0x4b, 0x9f, 0x34, 0xc8, 0x1f, 0x10, 0x46,
-	0x38, 0xe5, 0x1d, 0x81, 0x05, 0x41, 0x2d, 0x28, 0x60, 0xd7, 0xa3, 0xac, 0x87, 0x07, 0x03, 0xff,
-	0x92, 0x09, 0x6e, 0x6a, 0x6b, 0xda, 0x76, 0x6e, 0x6f, 0xab, 0xfc, 0xda, 0xe6, 0x72, 0x35, 0xd4,
-	0x57, 0x23, 0xb9, 0xa3, 0xe3, 0xd9, 0x11, 0xfd, 0x0b, 0x99, 0x58, 0x6b, 0x26, 0xe5, 0xa6, 0xd2,
-	0xeb, 0x9b, 0x9c, 0xe8, 0xec, 0x4c, 0x3d, 0xa5, 0x26, 0x64, 0x62, 0x14, 0x55, 0x21, 0x4d, 0x98,
-	0x08, 0x28, 0x09, 0x2f, 0x95, 0xfa, 0xfe, 0xa5, 0x62, 0x93, 0xc5, 0xc2, 0x7d, 0xb1, 0xaf, 0xf4,
-	0x61, 0x1e, 0xf4, 0x47, 0x14, 0x5a, 0x85, 0x8c, 0x4b, 0x06, 0xd4, 0xc3, 0x23, 0x2e, 0x2f, 0x98,
-	0x72, 0xa6, 0x33, 0x5a, 0x86, 0x79, 0x97, 0x30, 0xdf, 0x33, 0x53, 0x6b, 0xda, 0x76, 0xd6, 0x51,
-	0x03, 0xfa, 0x0d, 0xa0, 0x8f, 0x39, 0xe9, 0x29, 0x6a, 0x4e, 0x52, 0xd9, 0x10, 0x69, 0x48, 0x1a,
-	0xc1, 0xdc, 0x18, 0x8b, 0x73, 0x73, 0x5e, 0x12, 0xf2, 0x8c, 0x36, 0xa0, 0x40, 0xfb, 0x83, 0xde,
-	0xe0, 0x1c, 0x33, 0x46, 0x46, 0x3d, 0xea, 0x9a, 0x0b, 0x92, 0xcd, 0xd3, 0xfe, 0xa0, 0xae, 0x40,
-	0xdb, 0x45, 0xff, 0xc0, 0xaf, 0x52, 0x15, 0x7e, 0x39, 0x12, 0x8c, 0x71, 0x20, 0x26, 0xb3, 0x96,
-	0xb4, 0xb4, 0x98, 0xa1, 0x65, 0x46, 0xf1, 0x60, 0xff, 0x1d, 0xf2, 0x2e, 0xe5, 0xe3, 0x11, 0x9e,
-	0xf4, 0x18, 0xf6, 0x88, 0x99, 0x91, 0xfa, 0x5c, 0x84, 0xb5, 0xb0, 0x47, 0xd0, 0x26, 0x14, 0x62,
-	0x09, 0x9f, 0x78, 0x7d, 0x7f, 0x64, 0x66, 0xa5, 0x48, 0x8f, 0xd0, 0x8e, 0x04, 0x91, 0x09, 0x69,
-	0x46, 0xc4, 0xb5, 0x1f, 0x5c, 0x98, 0x20, 0xf9, 0x78, 0x0c, 0x19, 0xec, 0xba, 0x01, 0xe1, 0xdc,
-	0xcc, 0x29, 0x26, 0x1a, 0xd1, 0x16, 0x2c, 0x92, 0x1b, 0x41, 0x02, 0x86, 0x47, 0xf1, 0xee, 0xbc,
-	0x54, 0x14, 0x62, 0x38, 0x5a, 0xbe, 0x09, 0x05, 0x11, 0x60, 0xc6, 0xcf, 0x48, 0xd0, 0x1b, 0x51,
-	0x8f, 0x0a, 0x53, 0x57, 0x77, 0x88, 0xd1, 0xe3, 0x10, 0x44, 0xfb, 0x90, 0x1b, 0x93, 0xc0, 0xa3,
-	0x9c, 0x53, 0x9f, 0x71, 0x73, 0x71, 0x2d, 0xb5, 0x5d, 0xd8, 0xdb, 0x78, 0x3d, 0xf0, 0xf6, 0x54,
-	0xec, 0xcc, 0x1a, 0xc3, 0xb4, 0x2e, 0x19, 0x15, 0x51, 0x5a, 0x86, 0x4a, 0x2b, 0x44, 0x54, 0x5a,
-	0x7f, 0xc1, 0xca, 0xb3, 0x6f, 0xae, 0xa4, 0x4b, 0x52, 0xba, 0xfc, 0xe4, 0x73, 0x2b, 0xd7, 0xdf,
-	0xf0, 0xcb, 0x4b, 0x49, 0x51, 0x16, 0xe6, 0x84, 0xa4, 0x71, 0xe5, 0x79, 0x4e, 0x94, 0xd9, 0xee,
-	0xe1, 0x5c, 0x46, 0x33, 0x92, 0xa5, 0x6b, 0xc8, 0xcf, 0xd6, 0x06, 0xd5, 0x00, 0x54, 0xed, 0xc2,
-	0x32, 0xcb, 0xca, 0x15, 0xf6, 0xd6, 0x7f, 0x50, 0xb9, 0xee, 0x64, 0x4c, 0x9c, 0x2c, 0x8e, 0x8f,
-	0x68, 0x1d, 0xf4, 0xa8, 0xba, 0x51, 0x42, 0x49, 0xf5, 0x1f, 0x53, 0x85, 0x54, 0x58, 0xe9, 0x3d,
-	0xe8, 0x8f, 0xfa, 0x8a, 0x9a, 0x2f, 0x14, 0x3e, 0xec, 0xd6, 0x1f, 0x3f, 0x57, 0xf8, 0x27, 0x7d,
-	0xdf, 0x69, 0x00, 0x3c, 0x24, 0x81, 0x16, 0x21, 0xf7, 0x7f, 0xab, 0xd3, 0xb6, 0xea, 0xf6, 0xbe,
-	0x6d, 0x35, 0x8c, 0x04, 0x4a, 0x43, 0xaa, 0x7e, 0xdc, 0x36, 0x34, 0xa4, 0x43, 0xd6, 0xae, 0xd5,
-	0xad, 0xd3, 0xf6, 0x89, 0xd3, 0x35, 0x92, 0xd1, 0x68, 0x37, 0xe5, 0x98, 0xda, 0x39, 0x84, 0xec,
-	0xf4, 0x27, 0x22, 0x80, 0x85, 0xfa, 0x71, 0xbb, 0x61, 0x9d, 0x1a, 0x89, 0x70, 0x61, 0xbb, 0xd9,
-	0x6d, 0x3b, 0xd6, 0x9b, 0xaa, 0xd3, 0xe8, 0x18, 0x1a, 0x5a, 0x02, 0xbd, 0x7b, 0x72, 0x64, 0xb5,
-	0x1c, 0xeb, 0xc0, 0xee, 0x74, 0x9d, 0xb7, 0x6a, 0x97, 0xd5, 0xfd, 0xaf, 0xe6, 0xd8, 0x8d, 0x03,
-	0xcb, 0x48, 0xd5, 0x8e, 0x3e, 0xdf, 0x15, 0xb5, 0xdb, 0xbb, 0xa2, 0xf6, 0xf5, 0xae, 0xa8, 0x7d,
-	0xbc, 0x2f, 0x26, 0x6e, 0xef, 0x8b, 0x89, 0x2f, 0xf7, 0xc5, 0xc4, 0xbb, 0xdd, 0x21, 0x15, 0xe7,
-	0x97, 0xfd, 0xf2, 0xc0, 0xf7, 0x2a, 0x1d, 0x7a, 0x26, 0x03, 0xac, 0xc4, 0xcf, 0xec, 0xcd, 0x93,
-	0x87, 0x56, 0xbe, 0xb2, 0xfd, 0x05, 0xf9, 0x6c, 0xfe, 0xf9, 0x2d, 0x00, 0x00, 0xff, 0xff, 0x42,
-	0x85, 0x03, 0x29, 0x8e, 0x05, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6e, 0xdb, 0x36,
+	0x18, 0xb7, 0xe2, 0x34, 0xb1, 0x3f, 0xff, 0x89, 0xca, 0xba, 0x01, 0xd7, 0x61, 0x46, 0xe6, 0xa6,
+	0x6b, 0xd0, 0x83, 0x8d, 0xa4, 0x05, 0x86, 0x1d, 0x36, 0xc0, 0xb1, 0xd5, 0x4c, 0x6d, 0xec, 0x0a,
+	0xb2, 0x87, 0x75, 0x3b, 0x4c, 0xa0, 0x2d, 0x26, 0x21, 0x2a, 0x91, 0x9a, 0xc4, 0xa4, 0xf5, 0x5b,
+	0xec, 0x09, 0x76, 0xdf, 0x9b, 0xec, 0xd8, 0xe3, 0x8e, 0x43, 0xf2, 0x22, 0x03, 0x29, 0xca, 0x75,
+	0xd2, 0x66, 0xdb, 0x8d, 0xdf, 0xef, 0xcf, 0x07, 0x8a, 0x9f, 0xf8, 0x23, 0xec, 0x66, 0xec, 0x84,
+	0x8b, 0x90, 0xf6, 0xa4, 0x78, 0x43, 0x79, 0x4a, 0x4f, 0x59, 0x26, 0xd3, 0x45, 0xef, 0x62, 0xbf,
+	0x27, 0x17, 0x09, 0xcd, 0xba, 0x49, 0x2a, 0xa4, 0x40, 0xd8, 0xa8, 0xba, 0xd7, 0x54, 0xdd, 0x8b,
+	0xfd, 0x07, 0xad, 0x53, 0x71, 0x2a, 0xb4, 0xa8, 0xa7, 0x56, 0xb9, 0xbe, 0xf3, 0xbb, 0x05, 0xf5,
+	0x23, 0xca, 0x69, 0xc6, 0xb2, 0x89, 0x24, 0x92, 0xa2, 0x31, 0x34, 0x49, 0x18, 0x33, 0x1e, 0x90,
+	0xf9, 0x5c, 0x9c, 0x73, 0x99, 0x61, 0x6b, 0xc7, 0xda, 0xab, 0x1d, 0x3c, 0xee, 0xde, 0xd6, 0xb9,
+	0xdb, 0x57, 0xfa, 0xbe, 0x91, 0xfb, 0x0d, 0xb2, 0x5a, 0xa2, 0xef, 0xa0, 0x52, 0x68, 0xf1, 0x9a,
+	0xee, 0xd4, 0xb9, 0xbd, 0x93, 0x6f, 0xd6, 0xfe, 0xd2, 0xd3, 0x19, 0x41, 0xa5, 0x40, 0x51, 0x1f,
+	0x36, 0x29, 0x97, 0x29, 0xa3, 0x6a, 0x53, 0xe5, 0x7f, 0xdf, 0x54, 0x61, 0x72, 0xb8, 0xea, 0x57,
+	0xf8, 0x3a, 0x7f, 0x6c, 0x40, 0xe3, 0x1a, 0x85, 0x1e, 0x40, 0x25, 0xa4, 0x73, 0x16, 0x93, 0x28,
+	0xd3, 0x1b, 0x2c, 0xfb, 0xcb, 0x1a, 0xb5, 0xe0, 0x4e, 0x48, 0xb9, 0x88, 0x71, 0x79, 0xc7, 0xda,
+	0xab, 0xfa, 0x79, 0x81, 0xbe, 0x00, 0x98, 0x91, 0x8c, 0x06, 0x39, 0xb5, 0xae, 0xa9, 0xaa, 0x42,
+	0x86, 0x9a, 0x46, 0xb0, 0x9e, 0x10, 0x79, 0x86, 0xef, 0x68, 0x42, 0xaf, 0xd1, 0x2e, 0x34, 0xd9,
+	0x6c, 0x1e, 0xcc, 0xcf, 0x08, 0xe7, 0x34, 0x0a, 0x58, 0x88, 0x37, 0x34, 0x5b, 0x67, 0xb3, 0xf9,
+	0x20, 0x07, 0xdd, 0x10, 0x7d, 0x0b, 0x9f, 0x6b, 0x95, 0x3a, 0x39, 0x9a, 0x26, 0x24, 0x95, 0x8b,
+	0x55, 0xcb, 0xa6, 0xb6, 0x60, 0x65, 0x59, 0x51, 0x7c, 0xb0, 0x7f, 0x09, 0xf5, 0x90, 0x65, 0x49,
+	0x44, 0x16, 0x01, 0x27, 0x31, 0xc5, 0x15, 0xad, 0xaf, 0x19, 0x6c, 0x4c, 0x62, 0x8a, 0x1e, 0x41,
+	0xb3, 0x90, 0x64, 0x8b, 0x78, 0x26, 0x22, 0x5c, 0xd5, 0xa2, 0x86, 0x41, 0x27, 0x1a, 0x44, 0x18,
+	0x36, 0x39, 0x95, 0x6f, 0x45, 0xfa, 0x06, 0x83, 0xe6, 0x8b, 0x52, 0x31, 0x24, 0x0c, 0x53, 0x9a,
+	0x65, 0xb8, 0x96, 0x33, 0xa6, 0x44, 0x8f, 0x61, 0x8b, 0xbe, 0x93, 0x34, 0xe5, 0x24, 0x2a, 0x7a,
+	0xd7, 0xb5, 0xa2, 0x59, 0xc0, 0xa6, 0xf9, 0x23, 0x68, 0xca, 0x94, 0xf0, 0xec, 0x84, 0xa6, 0x41,
+	0xc4, 0x62, 0x26, 0x71, 0x23, 0xdf, 0x43, 0x81, 0x1e, 0x2b, 0x10, 0x3d, 0x87, 0x5a, 0x42, 0xd3,
+	0x98, 0x65, 0x19, 0x13, 0x3c, 0xc3, 0x5b, 0x3b, 0xe5, 0xbd, 0xe6, 0xc1, 0xee, 0xed, 0x03, 0xf7,
+	0x96, 0x62, 0x7f, 0xd5, 0xa8, 0xa6, 0x75, 0xce, 0x99, 0x34, 0xd3, 0xb2, 0xf3, 0x69, 0x29, 0x24,
+	0x9f, 0xd6, 0x33, 0xd8, 0xfe, 0xe8, 0xcc, 0x73, 0xe9, 0x5d, 0x2d, 0x6d, 0xdd, 0x38, 0xee, 0xdc,
+	0xf5, 0x0d, 0x7c, 0xf6, 0xa9, 0x49, 0x31, 0xae, 0xe6, 0x84, 0xb4, 0x71, 0xfb, 0xe3, 0x39, 0x31,
+	0xee, 0x86, 0xe8, 0x29, 0x6c, 0xab, 0xeb, 0x95, 0x08, 0x11, 0x05, 0x9c, 0x48, 0x76, 0x41, 0x03,
+	0x12, 0x2b, 0x1d, 0xbe, 0xa7, 0x7d, 0xf7, 0x62, 0xc6, 0x3d, 0x21, 0xa2, 0xb1, 0xe6, 0xfa, 0x9a,
+	0x42, 0x5f, 0x03, 0x5e, 0x9a, 0x96, 0xa7, 0x6c, 0x6c, 0x2d, 0x6d, 0xbb, 0x6f, 0x6c, 0x8e, 0x61,
+	0x8d, 0xf1, 0x19, 0x6c, 0x6b, 0x13, 0x49, 0x92, 0x54, 0x5c, 0x90, 0x28, 0x48, 0xe9, 0xaf, 0xe7,
+	0x2c, 0xa5, 0x21, 0xbe, 0xbf, 0x63, 0xed, 0x55, 0xfc, 0x96, 0x62, 0xfb, 0x86, 0xf4, 0x0d, 0xf7,
+	0x62, 0xbd, 0x62, 0xd9, 0x6b, 0x9d, 0xb7, 0x50, 0x5f, 0xbd, 0xda, 0xe8, 0x10, 0x20, 0x8f, 0x06,
+	0x15, 0x38, 0x3a, 0x16, 0x9a, 0x07, 0x0f, 0xff, 0x23, 0x16, 0xa6, 0x8b, 0x84, 0xfa, 0x55, 0x52,
+	0x2c, 0xd1, 0x43, 0x68, 0x98, 0x78, 0x31, 0x7f, 0xd1, 0x5a, 0x7e, 0x0f, 0xf2, 0xd0, 0xc8, 0xb1,
+	0xce, 0x2f, 0xd0, 0xb8, 0x96, 0x29, 0x68, 0xf4, 0x89, 0x50, 0x52, 0xf7, 0xff, 0xab, 0xff, 0x17,
+	0x4a, 0x37, 0x32, 0xe9, 0x89, 0x07, 0xf0, 0xe1, 0x6f, 0x41, 0x5b, 0x50, 0xfb, 0x61, 0x3c, 0xf1,
+	0x9c, 0x81, 0xfb, 0xdc, 0x75, 0x86, 0x76, 0x09, 0x6d, 0x42, 0x79, 0x70, 0xec, 0xd9, 0x16, 0x6a,
+	0x40, 0xd5, 0x3d, 0x1c, 0x38, 0xaf, 0xbd, 0x57, 0xfe, 0xd4, 0x5e, 0x33, 0xa5, 0x3b, 0xd2, 0x65,
+	0x19, 0x01, 0x6c, 0x8c, 0xfa, 0xfe, 0x91, 0x3b, 0xb6, 0xd7, 0x9f, 0xbc, 0x80, 0xea, 0xf2, 0x73,
+	0x15, 0x31, 0x38, 0xf6, 0x86, 0xce, 0x6b, 0xbb, 0xa4, 0x9a, 0x7b, 0xa3, 0xa9, 0xe7, 0x3b, 0x3f,
+	0xf6, 0xfd, 0xe1, 0xc4, 0xb6, 0xd0, 0x5d, 0x68, 0x4c, 0x5f, 0xbd, 0x74, 0xc6, 0xbe, 0x73, 0xe4,
+	0x4e, 0xa6, 0xfe, 0x4f, 0x79, 0x5f, 0x67, 0xfa, 0xfd, 0xa1, 0xef, 0x0e, 0x8f, 0x1c, 0xbb, 0x7c,
+	0xf8, 0xf2, 0xcf, 0xcb, 0xb6, 0xf5, 0xfe, 0xb2, 0x6d, 0xfd, 0x7d, 0xd9, 0xb6, 0x7e, 0xbb, 0x6a,
+	0x97, 0xde, 0x5f, 0xb5, 0x4b, 0x7f, 0x5d, 0xb5, 0x4b, 0x3f, 0xef, 0x9f, 0x32, 0x79, 0x76, 0x3e,
+	0xeb, 0xce, 0x45, 0xdc, 0x9b, 0xb0, 0x13, 0xfd, 0xc3, 0xf5, 0x8a, 0x67, 0xe1, 0xdd, 0x8d, 0x87,
+	0x41, 0xbf, 0x0a, 0xb3, 0x0d, 0x1d, 0xf3, 0x4f, 0xff, 0x19, 0x00, 0x8b, 0x5c, 0xaa, 0x35, 0x3e,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -624,6 +662,36 @@ func (m *RegistryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolApprovalRequired {
+		i--
+		if m.PoolApprovalRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.MinPoolExternalAmount) > 0 {
+		i -= len(m.MinPoolExternalAmount)
+		copy(dAtA[i:], m.MinPoolExternalAmount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MinPoolExternalAmount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.MinPoolNativeAmount) > 0 {
+		i -= len(m.MinPoolNativeAmount)
+		copy(dAtA[i:], m.MinPoolNativeAmount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MinPoolNativeAmount)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.IbcCounterpartyChainId) > 0 {
 		i -= len(m.IbcCounterpartyChainId)
 		copy(dAtA[i:], m.IbcCounterpartyChainId)
@@ -941,6 +1009,17 @@ func (m *RegistryEntry) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovTypes(uint64(l))
 	}
+	l = len(m.MinPoolNativeAmount)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MinPoolExternalAmount)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.PoolApprovalRequired {
+		n += 3
+	}
 	return n
 }
 
@@ -1752,6 +1831,90 @@ func (m *RegistryEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.IbcCounterpartyChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolNativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPoolNativeAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolExternalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinPoolExternalAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolApprovalRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PoolApprovalRequired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])